@@ -1,19 +1,28 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 	"github.com/rusystem/crm-warehouse/internal/config"
 	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/internal/repository/cache"
 	grpcServer "github.com/rusystem/crm-warehouse/internal/server/grpc"
+	natsServer "github.com/rusystem/crm-warehouse/internal/server/nats"
 	"github.com/rusystem/crm-warehouse/internal/service"
 	"github.com/rusystem/crm-warehouse/internal/transport"
+	"github.com/rusystem/crm-warehouse/internal/transport/consumer"
+	"github.com/rusystem/crm-warehouse/internal/worker"
 	"github.com/rusystem/crm-warehouse/pkg/database"
 	"github.com/rusystem/crm-warehouse/pkg/logger"
 	"github.com/rusystem/crm-warehouse/pkg/mq"
+	"github.com/rusystem/crm-warehouse/pkg/telegram"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
 )
 
 // init logger
@@ -28,18 +37,17 @@ func main() {
 		logger.Fatal(fmt.Sprintf("failed to initialize config, err: %v", err))
 	}
 
-	// init telegram bot
-	//tg, err := telegram.NewTelegram(cfg)
-	//if err != nil {
-	//	logger.Fatal(fmt.Sprintf("failed to initialize telegram bot, err - %v", err))
-	//} //todo make telegram alert logic
-
-	// init nats
-	nc, err := mq.NewNats(cfg)
-	if err != nil {
-		logger.Fatal(fmt.Sprintf("an error occurred when try to connect to nats, err - %v\n", err))
+	// init telegram bot, без токена уведомления в телеграм не отправляются
+	var tg service.TelegramSender
+	if cfg.Telegram.BotToken != "" {
+		bot, err := telegram.NewTelegram(cfg)
+		if err != nil {
+			logger.Fatal(fmt.Sprintf("failed to initialize telegram bot, err - %v", err))
+		}
+		tg = bot
+	} else {
+		logger.Info("telegram bot token is not set, telegram alerts are disabled")
 	}
-	defer nc.Close()
 
 	// init postgres connection
 	pc, err := database.NewPostgresConnection(database.PostgresConfig{
@@ -56,13 +64,74 @@ func main() {
 		}
 	}(pc)
 
+	// migrate subcommand: crm-warehouse migrate [up|down [n]|status]
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err = migrate(pc, os.Args[2:]); err != nil {
+			logger.Fatal(fmt.Sprintf("migrations: %v", err))
+		}
+		return
+	}
+
+	if cfg.Migrations.Auto {
+		if err = migrate(pc, []string{"up"}); err != nil {
+			logger.Fatal(fmt.Sprintf("failed to apply migrations, err: %v", err))
+		}
+	}
+
+	// init nats
+	nc, err := mq.NewNats(cfg)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("an error occurred when try to connect to nats, err - %v\n", err))
+	}
+	defer nc.Close()
+
+	// init clickhouse, без аналитики отчеты недоступны
+	var ch driver.Conn
+	if cfg.Analytics.Enabled {
+		ch, err = database.NewClickHouseConnection(database.ClickHouseConfig{
+			Addr:     cfg.ClickHouse.Addr,
+			Database: cfg.ClickHouse.Database,
+			Username: cfg.ClickHouse.Username,
+			Password: cfg.ClickHouse.Password,
+		})
+		if err != nil {
+			logger.Fatal(fmt.Sprintf("failed to connect to clickhouse, err: %v", err))
+		}
+		defer func(ch driver.Conn) {
+			if err = ch.Close(); err != nil {
+				logger.Error(fmt.Sprintf("clickhouse: failed to close connection, err: %v", err.Error()))
+			}
+		}(ch)
+	} else {
+		logger.Info("analytics is disabled")
+	}
+
+	// init cache, без адресов memcached значения хранятся в памяти процесса
+	var c *cache.Cache
+	if cfg.Cache.Enabled {
+		c = cache.New(cfg.Cache)
+		if len(cfg.Cache.Servers) == 0 {
+			logger.Info("memcached servers are not set, repository cache is in-memory only")
+		}
+	} else {
+		logger.Info("repository cache is disabled")
+	}
+
 	// init dep-s
-	r := repository.New(cfg, pc)
-	s := service.New(r, nc)
+	r := repository.New(cfg, pc, ch, c)
+	s := service.New(cfg, r, nc, tg)
 	h := transport.New(s)
 
+	var auth service.Auth
+	if cfg.Auth.Enabled {
+		auth = s.Auth
+	} else {
+		logger.Info("grpc authentication is disabled")
+	}
+
 	//init and start grpc server
-	grpcSrv := grpcServer.New(h.Warehouse, h.Supplier, h.Materials)
+	grpcSrv := grpcServer.New(auth, h.Warehouse, h.Supplier, h.Materials, h.Movements, h.Transfers, h.Reservations,
+		h.Reports, h.Stocktakes, h.Audit)
 	go func() {
 		if err := grpcSrv.Run(cfg.Grpc.Port); err != nil {
 			logger.Fatal(fmt.Sprintf("failed to start grpc server, err: %v", err))
@@ -70,6 +139,57 @@ func main() {
 	}()
 	defer grpcSrv.Stop()
 
+	//init and start nats request-reply server
+	if cfg.NatsRPC.Enabled {
+		natsSrv := natsServer.New(nc, cfg.NatsRPC, auth, h.Warehouse, h.Supplier, h.Materials)
+		if err = natsSrv.Run(); err != nil {
+			logger.Fatal(fmt.Sprintf("failed to start nats rpc server, err: %v", err))
+		}
+		defer natsSrv.Stop()
+	}
+
+	// background jobs
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if cfg.Alerts.Enabled {
+		worker.Start(ctx, worker.Job{Name: "low_stock_alerts", Interval: cfg.Alerts.Interval, Run: s.Alerts.CheckLowStock})
+	}
+
+	if cfg.Events.Enabled {
+		worker.Start(ctx, worker.Job{Name: "event_outbox_relay", Interval: cfg.Events.RelayInterval, Run: s.Events.PublishPending})
+	}
+
+	if cfg.Expiration.Enabled && cfg.Expiration.AutoQuarantine {
+		worker.Start(ctx, worker.Job{Name: "expiration_quarantine", Interval: cfg.Expiration.Interval, Run: func(ctx context.Context) error {
+			n, err := s.Material.QuarantineExpired(ctx, 0)
+			if n > 0 {
+				logger.Info(fmt.Sprintf("expiration: %d expired lots moved to quarantine", n))
+			}
+			return err
+		}})
+	}
+
+	if cfg.Analytics.Enabled {
+		worker.Start(ctx, worker.Job{Name: "analytics_export", Interval: cfg.Analytics.ExportInterval, Run: s.Reports.Export})
+	}
+
+	if cfg.Purge.Enabled {
+		worker.Start(ctx, worker.Job{Name: "soft_delete_purge", Interval: cfg.Purge.Interval, Run: s.Purge.PurgeDeleted})
+	}
+
+	if cfg.OutboxCleanup.Enabled {
+		worker.Start(ctx, worker.Job{Name: "event_outbox_cleanup", Interval: cfg.OutboxCleanup.Interval, Run: s.Events.CleanupOutbox})
+	}
+
+	if cfg.PurchaseRequests.Enabled {
+		purchaseRequests := consumer.NewPurchaseRequestConsumer(nc, cfg.PurchaseRequests, s, tg)
+		if err = purchaseRequests.Start(ctx); err != nil {
+			logger.Fatal(fmt.Sprintf("failed to start purchase requests consumer, err: %v", err))
+		}
+		defer purchaseRequests.Stop()
+	}
+
 	logger.Info("crm-warehouse started")
 
 	// graceful shutdown
@@ -79,3 +199,56 @@ func main() {
 
 	logger.Info(fmt.Sprintf("program shutdown... call_type: %v", osSignal))
 }
+
+func migrate(db *sql.DB, args []string) error {
+	m, err := database.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	cmd := "up"
+	if len(args) > 0 {
+		cmd = args[0]
+	}
+
+	switch cmd {
+	case "up":
+		n, err := m.Up(ctx)
+		if err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("migrations: applied %d migration(s)", n))
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps <= 0 {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+		}
+
+		n, err := m.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("migrations: reverted %d migration(s)", n))
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, st := range statuses {
+			state := "pending"
+			if st.Applied {
+				state = fmt.Sprintf("applied at %s", st.AppliedAt.Format(time.RFC3339))
+			}
+			logger.Info(fmt.Sprintf("migrations: %06d_%s - %s", st.Version, st.Name, state))
+		}
+	default:
+		return fmt.Errorf("unknown command %q, expected up, down or status", cmd)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type userKey struct{}
+
+type methodKey struct{}
+
+// WithUser кладет в контекст пользователя, от имени которого выполняется вызов
+func WithUser(ctx context.Context, user domain.User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext возвращает пользователя вызова, false - если вызов не прошел аутентификацию
+func UserFromContext(ctx context.Context) (domain.User, bool) {
+	user, ok := ctx.Value(userKey{}).(domain.User)
+	return user, ok
+}
+
+// WithMethod кладет в контекст вызванный RPC метод или другой источник вызова, например subject NATS
+func WithMethod(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, methodKey{}, method)
+}
+
+// MethodFromContext возвращает метод вызова, пустая строка - вызов не из транспорта
+func MethodFromContext(ctx context.Context) string {
+	method, _ := ctx.Value(methodKey{}).(string)
+	return method
+}
+
+// HasAnySection проверяет, что у пользователя есть хотя бы одна из секций
+func HasAnySection(user domain.User, sections ...string) bool {
+	for _, have := range user.Sections {
+		for _, want := range sections {
+			if have == want {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// IsSuperuser - доступ ко всем компаниям без ограничений
+func IsSuperuser(user domain.User) bool {
+	return HasAnySection(user, domain.SectionFullAllAccess)
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/spf13/viper"
 	"time"
@@ -12,22 +13,41 @@ const (
 	CONFIG_DEV_FILE  = "dev"
 )
 
+// Политика удаления поставщика или склада, на которые ссылаются материалы
+const (
+	DeletePolicyBlock   = "block"   // Удаление отклоняется
+	DeletePolicyCascade = "cascade" // Материалы в планировании и купленные удаляются вместе с ним
+)
+
 type Config struct {
-	Postgres Postgres
-	Nats     Nats
-	IsProd   bool
+	Postgres         Postgres
+	ClickHouse       ClickHouse
+	Nats             Nats
+	Auth             Auth             `mapstructure:"auth"`
+	Telegram         Telegram         `mapstructure:"telegram"`
+	Alerts           Alerts           `mapstructure:"alerts"`
+	Expiration       Expiration       `mapstructure:"expiration"`
+	Events           Events           `mapstructure:"events"`
+	NatsRPC          NatsRPC          `mapstructure:"nats_rpc"`
+	PurchaseRequests PurchaseRequests `mapstructure:"purchase_requests"`
+	Cache            Cache            `mapstructure:"cache"`
+	Analytics        Analytics        `mapstructure:"analytics"`
+	Purge            Purge            `mapstructure:"purge"`
+	OutboxCleanup    OutboxCleanup    `mapstructure:"outbox_cleanup"`
+	References       References       `mapstructure:"references"`
+	IsProd           bool
 
 	Grpc struct {
 		Port int64 `mapstructure:"port"`
 	} `mapstructure:"grpc"`
 
-	Cache struct {
-		Ttl int64 `mapstructure:"ttl"`
-	} `mapstructure:"cache"`
-
 	Ctx struct {
 		Ttl time.Duration `mapstructure:"ttl"`
 	} `mapstructure:"ctx"`
+
+	Migrations struct {
+		Auto bool `mapstructure:"auto"` // применять миграции при старте сервиса
+	} `mapstructure:"migrations"`
 }
 
 type Postgres struct {
@@ -39,6 +59,13 @@ type Postgres struct {
 	SSLMode  string
 }
 
+type ClickHouse struct {
+	Addr     []string // адреса host:port нативного протокола
+	Database string
+	Username string
+	Password string
+}
+
 type Nats struct {
 	Address        string
 	TotalWait      time.Duration `mapstructure:"total_wait"`
@@ -46,9 +73,92 @@ type Nats struct {
 	Timeout        time.Duration `mapstructure:"timeout"`
 }
 
+type Auth struct {
+	Enabled    bool   `mapstructure:"enabled"`                   // проверять токен на каждом вызове grpc
+	Issuer     string `mapstructure:"issuer"`                    // ожидаемый издатель токена, пустой - не проверяется
+	SigningKey string `mapstructure:"-" envconfig:"signing_key"` // ключ подписи HS256, только из окружения
+}
+
 type Telegram struct {
-	BotToken string `vault:"telegram_bot_token"`
-	ChatId   string `vault:"telegram_chat_id"`
+	BotToken     string          `mapstructure:"-" vault:"telegram_bot_token" envconfig:"bot_token"`
+	ChatId       string          `mapstructure:"-" vault:"telegram_chat_id" envconfig:"chat_id"` // общий чат уведомлений
+	APIEndpoint  string          `mapstructure:"api_endpoint" envconfig:"api_endpoint"`          // формат tgbotapi.APIEndpoint, пустой - api.telegram.org
+	CompanyChats map[int64]int64 `mapstructure:"company_chats" ignored:"true"`                   // чат уведомлений компании по ее id
+	Rate         time.Duration   `mapstructure:"rate" ignored:"true"`                            // минимальный интервал между сообщениями
+	MaxRetries   int             `mapstructure:"max_retries" ignored:"true"`                     // повторов отправки после ошибки
+	RetryDelay   time.Duration   `mapstructure:"retry_delay" ignored:"true"`                     // пауза перед повтором, если Bot API не вернул retry_after
+}
+
+type Alerts struct {
+	Enabled         bool          `mapstructure:"enabled"`
+	Interval        time.Duration `mapstructure:"interval"`          // период проверки остатков
+	LowStockSubject string        `mapstructure:"low_stock_subject"` // subject NATS для уведомлений о низком остатке
+}
+
+type Expiration struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	Interval       time.Duration `mapstructure:"interval"`        // период перевода просроченных партий в карантин
+	Window         time.Duration `mapstructure:"window"`          // окно истекающих сроков по умолчанию
+	AutoQuarantine bool          `mapstructure:"auto_quarantine"` // переводить просроченные партии в карантин автоматически
+}
+
+type Events struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	SubjectPrefix  string        `mapstructure:"subject_prefix"`  // subject события - <prefix>.<тип события>
+	RelayInterval  time.Duration `mapstructure:"relay_interval"`  // период публикации событий из outbox
+	BatchSize      int64         `mapstructure:"batch_size"`      // событий за одну транзакцию outbox
+	PublishTimeout time.Duration `mapstructure:"publish_timeout"` // ожидание подтверждения доставки пачки в NATS
+}
+
+type NatsRPC struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	SubjectPrefix string        `mapstructure:"subject_prefix"` // subject метода - <prefix>.<пакет>.<сервис>.<метод>
+	QueueGroup    string        `mapstructure:"queue_group"`    // группа очереди, запрос получает один экземпляр сервиса
+	Timeout       time.Duration `mapstructure:"timeout"`        // время обработки одного запроса
+	Concurrency   int           `mapstructure:"concurrency"`    // одновременно обрабатываемых запросов
+}
+
+type PurchaseRequests struct {
+	Enabled           bool          `mapstructure:"enabled"`
+	Stream            string        `mapstructure:"stream"`              // поток JetStream, создается при отсутствии
+	Subject           string        `mapstructure:"subject"`             // subject заявок на закупку
+	Durable           string        `mapstructure:"durable"`             // имя durable потребителя
+	DeadLetterSubject string        `mapstructure:"dead_letter_subject"` // subject для необрабатываемых сообщений
+	MaxDeliver        int           `mapstructure:"max_deliver"`         // попыток доставки до переноса в dead letter
+	AckWait           time.Duration `mapstructure:"ack_wait"`            // время на обработку одного сообщения
+	RetryDelay        time.Duration `mapstructure:"retry_delay"`         // задержка повторной доставки после ошибки
+}
+
+type Cache struct {
+	Enabled          bool          `mapstructure:"enabled" ignored:"true"`
+	Ttl              int64         `mapstructure:"ttl" ignored:"true"`                // время жизни значения, секунды
+	Servers          []string      `mapstructure:"servers" envconfig:"servers"`       // адреса memcached host:port, пустой - только память процесса
+	Timeout          time.Duration `mapstructure:"timeout" ignored:"true"`            // таймаут операции memcached
+	MaxIdleConns     int           `mapstructure:"max_idle_conns" ignored:"true"`     // простаивающих соединений на сервер
+	RetryInterval    time.Duration `mapstructure:"retry_interval" ignored:"true"`     // пауза перед повторным обращением к недоступному memcached
+	MemoryMaxEntries int           `mapstructure:"memory_max_entries" ignored:"true"` // размер кэша в памяти процесса, пока memcached недоступен
+}
+
+type Analytics struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	ExportInterval time.Duration `mapstructure:"export_interval"` // период выгрузки изменений в ClickHouse
+	BatchSize      int64         `mapstructure:"batch_size"`      // записей за одну вставку в ClickHouse
+}
+
+type Purge struct {
+	Enabled   bool          `mapstructure:"enabled"`
+	Interval  time.Duration `mapstructure:"interval"`  // период очистки удаленных записей
+	Retention time.Duration `mapstructure:"retention"` // срок хранения мягко удаленных записей до окончательного удаления
+}
+
+type OutboxCleanup struct {
+	Enabled   bool          `mapstructure:"enabled"`
+	Interval  time.Duration `mapstructure:"interval"`  // период очистки outbox
+	Retention time.Duration `mapstructure:"retention"` // срок хранения опубликованных и выгруженных событий
+}
+
+type References struct {
+	DeletePolicy string `mapstructure:"delete_policy"` // block или cascade, пустая - block
 }
 
 func New(isProd bool) (*Config, error) {
@@ -74,9 +184,91 @@ func New(isProd bool) (*Config, error) {
 		return nil, err
 	}
 
+	if err := envconfig.Process("clickhouse", &cfg.ClickHouse); err != nil {
+		return nil, err
+	}
+
 	if err := envconfig.Process("nats", &cfg.Nats); err != nil {
 		return nil, err
 	}
 
+	if err := envconfig.Process("auth", &cfg.Auth); err != nil {
+		return nil, err
+	}
+
+	if err := envconfig.Process("telegram", &cfg.Telegram); err != nil {
+		return nil, err
+	}
+
+	if err := envconfig.Process("memcached", &cfg.Cache); err != nil {
+		return nil, err
+	}
+
+	if c := cfg.Cache; c.Enabled && (c.Ttl <= 0 || c.RetryInterval <= 0 || c.MemoryMaxEntries <= 0) {
+		return nil, errors.New("cache is enabled but cache.ttl, retry_interval or memory_max_entries is not set")
+	}
+
+	// кэш в памяти процесса не видит инвалидаций других реплик, в prod допустим только memcached
+	if cfg.IsProd && cfg.Cache.Enabled && len(cfg.Cache.Servers) == 0 {
+		return nil, errors.New("cache is enabled but MEMCACHED_SERVERS is not set")
+	}
+
+	if t := cfg.Telegram; t.MaxRetries > 0 && t.RetryDelay <= 0 {
+		return nil, errors.New("telegram.max_retries is set but telegram.retry_delay is not set")
+	}
+
+	if cfg.Alerts.Enabled && cfg.Alerts.Interval <= 0 {
+		return nil, errors.New("alerts are enabled but alerts.interval is not set")
+	}
+
+	if cfg.Expiration.Enabled && cfg.Expiration.AutoQuarantine && cfg.Expiration.Interval <= 0 {
+		return nil, errors.New("expiration quarantine is enabled but expiration.interval is not set")
+	}
+
+	if cfg.Events.Enabled && (cfg.Events.SubjectPrefix == "" || cfg.Events.RelayInterval <= 0 ||
+		cfg.Events.BatchSize <= 0 || cfg.Events.PublishTimeout <= 0) {
+		return nil, errors.New("events are enabled but events.subject_prefix, relay_interval, batch_size or publish_timeout is not set")
+	}
+
+	if cfg.NatsRPC.Enabled && (cfg.NatsRPC.SubjectPrefix == "" || cfg.NatsRPC.QueueGroup == "" ||
+		cfg.NatsRPC.Timeout <= 0 || cfg.NatsRPC.Concurrency <= 0) {
+		return nil, errors.New("nats rpc is enabled but nats_rpc.subject_prefix, queue_group, timeout or concurrency is not set")
+	}
+
+	if p := cfg.PurchaseRequests; p.Enabled && (p.Stream == "" || p.Subject == "" || p.Durable == "" ||
+		p.DeadLetterSubject == "" || p.MaxDeliver <= 0 || p.AckWait <= 0) {
+		return nil, errors.New("purchase requests are enabled but purchase_requests.stream, subject, durable, " +
+			"dead_letter_subject, max_deliver or ack_wait is not set")
+	}
+
+	if a := cfg.Analytics; a.Enabled && (a.ExportInterval <= 0 || a.BatchSize <= 0) {
+		return nil, errors.New("analytics is enabled but analytics.export_interval or batch_size is not set")
+	}
+
+	if p := cfg.Purge; p.Enabled && (p.Interval <= 0 || p.Retention <= 0) {
+		return nil, errors.New("purge is enabled but purge.interval or retention is not set")
+	}
+
+	if o := cfg.OutboxCleanup; o.Enabled && (o.Interval <= 0 || o.Retention <= 0) {
+		return nil, errors.New("outbox cleanup is enabled but outbox_cleanup.interval or retention is not set")
+	}
+
+	if p := cfg.References.DeletePolicy; p != "" && p != DeletePolicyBlock && p != DeletePolicyCascade {
+		return nil, errors.New("references.delete_policy must be block or cascade")
+	}
+
+	// события материалов попадают в аналитику через outbox
+	if cfg.Analytics.Enabled && !cfg.Events.Enabled {
+		return nil, errors.New("analytics requires events to be enabled")
+	}
+
+	if cfg.Analytics.Enabled && len(cfg.ClickHouse.Addr) == 0 {
+		return nil, errors.New("analytics is enabled but CLICKHOUSE_ADDR is not set")
+	}
+
+	if cfg.Auth.Enabled && cfg.Auth.SigningKey == "" {
+		return nil, errors.New("auth is enabled but AUTH_SIGNING_KEY is not set")
+	}
+
 	return cfg, nil
 }
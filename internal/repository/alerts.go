@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Alerts interface {
+	RaiseLowStock(ctx context.Context) (int64, error)
+	ResolveLowStock(ctx context.Context) (int64, error)
+	GetUndelivered(ctx context.Context) ([]domain.LowStockAlert, error)
+	MarkChannelNotified(ctx context.Context, id int64, channel string) error
+	MarkNotified(ctx context.Context, id int64) error
+}
+
+type AlertsRepository struct {
+	cfg  *config.Config
+	psql postgres.Alerts
+}
+
+func NewAlertsRepository(cfg *config.Config, db *sql.DB) *AlertsRepository {
+	return &AlertsRepository{
+		cfg:  cfg,
+		psql: postgres.NewAlertsPostgresRepository(db),
+	}
+}
+
+func (ar *AlertsRepository) RaiseLowStock(ctx context.Context) (int64, error) {
+	return ar.psql.RaiseLowStock(ctx)
+}
+
+func (ar *AlertsRepository) ResolveLowStock(ctx context.Context) (int64, error) {
+	return ar.psql.ResolveLowStock(ctx)
+}
+
+func (ar *AlertsRepository) GetUndelivered(ctx context.Context) ([]domain.LowStockAlert, error) {
+	return ar.psql.GetUndelivered(ctx)
+}
+
+func (ar *AlertsRepository) MarkChannelNotified(ctx context.Context, id int64, channel string) error {
+	return ar.psql.MarkChannelNotified(ctx, id, channel)
+}
+
+func (ar *AlertsRepository) MarkNotified(ctx context.Context, id int64) error {
+	return ar.psql.MarkNotified(ctx, id)
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/clickhouse"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Analytics interface {
+	ExportMaterialEvents(ctx context.Context, limit int64, export func(events []domain.Event) error) (int, error)
+	ExportMovements(ctx context.Context, limit int64, export func(movements []domain.MovementFact) error) (int, error)
+
+	InsertMaterialFacts(ctx context.Context, facts []domain.MaterialFact) error
+	InsertMovementFacts(ctx context.Context, facts []domain.MovementFact) error
+
+	GetStockOnDate(ctx context.Context, params domain.StockOnDateParams) ([]domain.StockBalance, error)
+	GetCategoryTurnover(ctx context.Context, params domain.PeriodParams) ([]domain.CategoryTurnover, error)
+	GetSupplierSpend(ctx context.Context, params domain.PeriodParams) ([]domain.SupplierSpend, error)
+}
+
+// AnalyticsRepository читает изменения из Postgres и хранит аналитику в ClickHouse
+type AnalyticsRepository struct {
+	cfg  *config.Config
+	psql postgres.AnalyticsFeed
+	ch   clickhouse.Analytics
+}
+
+// NewAnalyticsRepository без подключения к ClickHouse возвращает nil, аналитика отключена
+func NewAnalyticsRepository(cfg *config.Config, db *sql.DB, ch driver.Conn) *AnalyticsRepository {
+	if ch == nil {
+		return nil
+	}
+
+	return &AnalyticsRepository{
+		cfg:  cfg,
+		psql: postgres.NewAnalyticsFeedPostgresRepository(db),
+		ch:   clickhouse.NewAnalyticsClickHouseRepository(ch),
+	}
+}
+
+func (ar *AnalyticsRepository) ExportMaterialEvents(ctx context.Context, limit int64, export func(events []domain.Event) error) (int, error) {
+	return ar.psql.ExportMaterialEvents(ctx, limit, export)
+}
+
+func (ar *AnalyticsRepository) ExportMovements(ctx context.Context, limit int64, export func(movements []domain.MovementFact) error) (int, error) {
+	return ar.psql.ExportMovements(ctx, limit, export)
+}
+
+func (ar *AnalyticsRepository) InsertMaterialFacts(ctx context.Context, facts []domain.MaterialFact) error {
+	return ar.ch.InsertMaterialFacts(ctx, facts)
+}
+
+func (ar *AnalyticsRepository) InsertMovementFacts(ctx context.Context, facts []domain.MovementFact) error {
+	return ar.ch.InsertMovementFacts(ctx, facts)
+}
+
+func (ar *AnalyticsRepository) GetStockOnDate(ctx context.Context, params domain.StockOnDateParams) ([]domain.StockBalance, error) {
+	return ar.ch.GetStockOnDate(ctx, params)
+}
+
+func (ar *AnalyticsRepository) GetCategoryTurnover(ctx context.Context, params domain.PeriodParams) ([]domain.CategoryTurnover, error) {
+	return ar.ch.GetCategoryTurnover(ctx, params)
+}
+
+func (ar *AnalyticsRepository) GetSupplierSpend(ctx context.Context, params domain.PeriodParams) ([]domain.SupplierSpend, error) {
+	return ar.ch.GetSupplierSpend(ctx, params)
+}
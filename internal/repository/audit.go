@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/audit"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Audit interface {
+	GetList(ctx context.Context, params domain.AuditParams) ([]domain.AuditEntry, error)
+}
+
+type AuditRepository struct {
+	cfg  *config.Config
+	psql postgres.Audit
+}
+
+func NewAuditRepository(cfg *config.Config, db *sql.DB) *AuditRepository {
+	return &AuditRepository{
+		cfg:  cfg,
+		psql: postgres.NewAuditPostgresRepository(db),
+	}
+}
+
+func (ar *AuditRepository) GetList(ctx context.Context, params domain.AuditParams) ([]domain.AuditEntry, error) {
+	return ar.psql.GetList(ctx, params)
+}
+
+// newAuditLog журнал аудита для декораторов репозиториев, пишет в транзакции изменения
+func newAuditLog(db *sql.DB) *audit.Log {
+	return audit.NewLog(postgres.NewTransactorPostgres(db), postgres.NewAuditPostgresRepository(db))
+}
@@ -0,0 +1,209 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/rusystem/crm-warehouse/internal/auth"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+// Log - журнал аудита изменений. Декораторы репозиториев читают сущность до и после изменения
+// и сохраняют разницу в той же транзакции, поэтому откат изменения откатывает и запись журнала.
+// Пользователь и метод берутся из контекста вызова.
+type Log struct {
+	tx   postgres.Transactor
+	repo postgres.Audit
+}
+
+func NewLog(tx postgres.Transactor, repo postgres.Audit) *Log {
+	return &Log{
+		tx:   tx,
+		repo: repo,
+	}
+}
+
+// getter чтение сущности по id в компании
+type getter[T any] func(ctx context.Context, id, companyId int64) (T, error)
+
+// create создает сущность и журналирует ее снимок после создания
+func create[T any](ctx context.Context, l *Log, entityType string, companyId int64, get getter[T],
+	fn func(ctx context.Context) (int64, error)) (int64, error) {
+	var id int64
+	if err := l.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if id, err = fn(ctx); err != nil {
+			return err
+		}
+
+		after, err := get(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		return l.record(ctx, domain.AuditActionCreate, entityType, companyId, id, nil, after)
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// update изменяет сущность и журналирует изменившиеся поля
+func update[T any](ctx context.Context, l *Log, entityType string, id, companyId int64, get getter[T],
+	fn func(ctx context.Context) error) error {
+	return l.tx.WithinTx(ctx, func(ctx context.Context) error {
+		before, err := get(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		if err = fn(ctx); err != nil {
+			return err
+		}
+
+		after, err := get(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		return l.record(ctx, domain.AuditActionUpdate, entityType, companyId, id, before, after)
+	})
+}
+
+// remove удаляет сущность и журналирует ее снимок до удаления
+func remove[T any](ctx context.Context, l *Log, entityType string, id, companyId int64, get getter[T],
+	fn func(ctx context.Context) error) error {
+	return l.tx.WithinTx(ctx, func(ctx context.Context) error {
+		before, err := get(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		if err = fn(ctx); err != nil {
+			return err
+		}
+
+		return l.record(ctx, domain.AuditActionDelete, entityType, companyId, id, before, nil)
+	})
+}
+
+// restore восстанавливает удаленную сущность, до - снимок удаленной записи, после - восстановленной
+func restore[T any](ctx context.Context, l *Log, entityType string, id, companyId int64, deleted, get getter[T],
+	fn func(ctx context.Context) error) error {
+	return l.tx.WithinTx(ctx, func(ctx context.Context) error {
+		before, err := deleted(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		if err = fn(ctx); err != nil {
+			return err
+		}
+
+		after, err := get(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		return l.record(ctx, domain.AuditActionRestore, entityType, companyId, id, before, after)
+	})
+}
+
+// move переносит сущность в другой раздел. Запись относится к сущности исходного раздела,
+// после - снимок в новом разделе, поэтому новый id виден в изменении поля id.
+// Без target (id в новом разделе неизвестен) журналируется только снимок до переноса.
+func move[T any](ctx context.Context, l *Log, entityType string, id, companyId int64, get getter[T], target getter[T],
+	fn func(ctx context.Context) (int64, error)) error {
+	return l.tx.WithinTx(ctx, func(ctx context.Context) error {
+		before, err := get(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		newId, err := fn(ctx)
+		if err != nil {
+			return err
+		}
+
+		var after interface{}
+		if target != nil {
+			if after, err = target(ctx, newId, companyId); err != nil {
+				return err
+			}
+		}
+
+		return l.record(ctx, domain.AuditActionMove, entityType, companyId, id, before, after)
+	})
+}
+
+func (l *Log) record(ctx context.Context, action, entityType string, companyId, entityId int64, before, after interface{}) error {
+	changes, err := diff(before, after)
+	if err != nil {
+		return err
+	}
+
+	var actorId int64
+	if user, ok := auth.UserFromContext(ctx); ok {
+		actorId = user.ID
+	}
+
+	_, err = l.repo.Add(ctx, domain.AuditEntry{
+		CompanyID:  companyId,
+		ActorID:    actorId,
+		Method:     auth.MethodFromContext(ctx),
+		EntityType: entityType,
+		EntityID:   entityId,
+		Action:     action,
+		Changes:    changes,
+	})
+
+	return err
+}
+
+// diff сравнивает JSON снимки по полям верхнего уровня, nil - снимка нет
+func diff(before, after interface{}) (map[string]domain.AuditChange, error) {
+	b, err := fields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := fields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]domain.AuditChange)
+	for name, value := range b {
+		if next, ok := a[name]; !ok || !bytes.Equal(value, next) {
+			changes[name] = domain.AuditChange{Before: value, After: a[name]}
+		}
+	}
+
+	for name, value := range a {
+		if _, ok := b[name]; !ok {
+			changes[name] = domain.AuditChange{After: value}
+		}
+	}
+
+	return changes, nil
+}
+
+func fields(snapshot interface{}) (map[string]json.RawMessage, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]json.RawMessage
+	if err = json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"encoding/json"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	type entity struct {
+		ID     int64             `json:"id"`
+		Name   string            `json:"name"`
+		Tags   []string          `json:"tags,omitempty"`
+		Fields map[string]string `json:"fields,omitempty"`
+	}
+
+	raw := func(s string) json.RawMessage { return json.RawMessage(s) }
+
+	tests := []struct {
+		name   string
+		before interface{}
+		after  interface{}
+		want   map[string]domain.AuditChange
+	}{
+		{
+			name:   "no snapshots",
+			before: nil,
+			after:  nil,
+			want:   map[string]domain.AuditChange{},
+		},
+		{
+			name:   "create",
+			before: nil,
+			after:  entity{ID: 1, Name: "Болт"},
+			want: map[string]domain.AuditChange{
+				"id":   {After: raw(`1`)},
+				"name": {After: raw(`"Болт"`)},
+			},
+		},
+		{
+			name:   "delete",
+			before: entity{ID: 1, Name: "Болт"},
+			after:  nil,
+			want: map[string]domain.AuditChange{
+				"id":   {Before: raw(`1`)},
+				"name": {Before: raw(`"Болт"`)},
+			},
+		},
+		{
+			name:   "unchanged",
+			before: entity{ID: 1, Name: "Болт", Tags: []string{"a"}},
+			after:  entity{ID: 1, Name: "Болт", Tags: []string{"a"}},
+			want:   map[string]domain.AuditChange{},
+		},
+		{
+			name:   "changed field",
+			before: entity{ID: 1, Name: "Болт"},
+			after:  entity{ID: 1, Name: "Гайка"},
+			want: map[string]domain.AuditChange{
+				"name": {Before: raw(`"Болт"`), After: raw(`"Гайка"`)},
+			},
+		},
+		{
+			name:   "nested value compared as a whole",
+			before: entity{ID: 1, Fields: map[string]string{"color": "red", "size": "M"}},
+			after:  entity{ID: 1, Fields: map[string]string{"color": "blue", "size": "M"}},
+			want: map[string]domain.AuditChange{
+				"fields": {Before: raw(`{"color":"red","size":"M"}`), After: raw(`{"color":"blue","size":"M"}`)},
+			},
+		},
+		{
+			name:   "field added and removed",
+			before: entity{ID: 1, Tags: []string{"a"}},
+			after:  entity{ID: 1, Fields: map[string]string{"color": "red"}},
+			want: map[string]domain.AuditChange{
+				"tags":   {Before: raw(`["a"]`)},
+				"fields": {After: raw(`{"color":"red"}`)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := diff(tt.before, tt.after)
+			if err != nil {
+				t.Fatalf("diff: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diff() = %s, want %s", dump(t, got), dump(t, tt.want))
+			}
+		})
+	}
+}
+
+func dump(t *testing.T, changes map[string]domain.AuditChange) string {
+	t.Helper()
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		t.Fatalf("marshal changes: %v", err)
+	}
+
+	return string(data)
+}
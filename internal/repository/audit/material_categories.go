@@ -0,0 +1,131 @@
+package audit
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+// Category журналирует создание, изменение, перенос, удаление и восстановление категорий материалов.
+// При переносе журналируется каждая категория перенесенного поддерева: у вложенных меняются путь и глубина.
+type Category struct {
+	postgres.Category
+	log *Log
+}
+
+func NewCategory(repo postgres.Category, log *Log) postgres.Category {
+	return &Category{
+		Category: repo,
+		log:      log,
+	}
+}
+
+func (c *Category) Create(ctx context.Context, category domain.MaterialCategory) (int64, error) {
+	return create(ctx, c.log, domain.AuditEntityCategory, category.CompanyID, c.Category.GetById,
+		func(ctx context.Context) (int64, error) {
+			return c.Category.Create(ctx, category)
+		})
+}
+
+func (c *Category) Update(ctx context.Context, category domain.MaterialCategory) error {
+	return update(ctx, c.log, domain.AuditEntityCategory, category.ID, category.CompanyID, c.Category.GetById,
+		func(ctx context.Context) error {
+			return c.Category.Update(ctx, category)
+		})
+}
+
+func (c *Category) Delete(ctx context.Context, id, companyId int64) error {
+	return remove(ctx, c.log, domain.AuditEntityCategory, id, companyId, c.Category.GetById,
+		func(ctx context.Context) error {
+			return c.Category.Delete(ctx, id, companyId)
+		})
+}
+
+func (c *Category) Restore(ctx context.Context, id, companyId int64) error {
+	return restore(ctx, c.log, domain.AuditEntityCategory, id, companyId, c.Category.GetDeletedById, c.Category.GetById,
+		func(ctx context.Context) error {
+			return c.Category.Restore(ctx, id, companyId)
+		})
+}
+
+// Move журналирует перенос как изменение родителя, пути и глубины категории и ее вложенных категорий
+func (c *Category) Move(ctx context.Context, id, companyId, parentId int64) error {
+	return c.log.tx.WithinTx(ctx, func(ctx context.Context) error {
+		return c.moveSubtrees(ctx, companyId, []int64{id}, func(ctx context.Context) error {
+			return c.Category.Move(ctx, id, companyId, parentId)
+		})
+	})
+}
+
+// Reparent журналирует отдельной записью каждую перенесенную категорию вместе с вложенными
+func (c *Category) Reparent(ctx context.Context, fromId, companyId, parentId int64) (int64, error) {
+	var n int64
+	if err := c.log.tx.WithinTx(ctx, func(ctx context.Context) error {
+		subtree, err := c.Category.GetSubtree(ctx, fromId, companyId)
+		if err != nil {
+			return err
+		}
+
+		var children []int64
+		for _, category := range subtree {
+			if category.ParentID == fromId {
+				children = append(children, category.ID)
+			}
+		}
+
+		return c.moveSubtrees(ctx, companyId, children, func(ctx context.Context) error {
+			n, err = c.Category.Reparent(ctx, fromId, companyId, parentId)
+			return err
+		})
+	}); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// moveSubtrees выполняет перенос поддеревьев с корнями roots и журналирует изменение каждой их категории.
+// Поддерево переносится целиком, поэтому после переноса оно читается по тем же корням.
+func (c *Category) moveSubtrees(ctx context.Context, companyId int64, roots []int64, fn func(ctx context.Context) error) error {
+	snapshot := func() ([]domain.MaterialCategory, error) {
+		var categories []domain.MaterialCategory
+		for _, id := range roots {
+			subtree, err := c.Category.GetSubtree(ctx, id, companyId)
+			if err != nil {
+				return nil, err
+			}
+
+			categories = append(categories, subtree...)
+		}
+
+		return categories, nil
+	}
+
+	before, err := snapshot()
+	if err != nil {
+		return err
+	}
+
+	if err = fn(ctx); err != nil {
+		return err
+	}
+
+	after, err := snapshot()
+	if err != nil {
+		return err
+	}
+
+	moved := make(map[int64]domain.MaterialCategory, len(after))
+	for _, category := range after {
+		moved[category.ID] = category
+	}
+
+	for _, category := range before {
+		if err = c.log.record(ctx, domain.AuditActionUpdate, domain.AuditEntityCategory, companyId, category.ID,
+			category, moved[category.ID]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
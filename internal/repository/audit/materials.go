@@ -0,0 +1,149 @@
+package audit
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+// Materials журналирует изменения материалов во всех разделах. Перевод просроченных партий
+// в карантин журналируется отдельной записью по каждой партии.
+type Materials struct {
+	postgres.Materials
+	log *Log
+}
+
+func NewMaterials(repo postgres.Materials, log *Log) postgres.Materials {
+	return &Materials{
+		Materials: repo,
+		log:       log,
+	}
+}
+
+func (m *Materials) CreatePlanning(ctx context.Context, material domain.Material) (int64, error) {
+	return create(ctx, m.log, domain.AuditEntityPlanningMaterial, material.CompanyID, m.Materials.GetPlanningById,
+		func(ctx context.Context) (int64, error) {
+			return m.Materials.CreatePlanning(ctx, material)
+		})
+}
+
+func (m *Materials) UpdatePlanning(ctx context.Context, material domain.Material) error {
+	return update(ctx, m.log, domain.AuditEntityPlanningMaterial, material.ID, material.CompanyID, m.Materials.GetPlanningById,
+		func(ctx context.Context) error {
+			return m.Materials.UpdatePlanning(ctx, material)
+		})
+}
+
+func (m *Materials) DeletePlanning(ctx context.Context, id, companyId int64) error {
+	return remove(ctx, m.log, domain.AuditEntityPlanningMaterial, id, companyId, m.Materials.GetPlanningById,
+		func(ctx context.Context) error {
+			return m.Materials.DeletePlanning(ctx, id, companyId)
+		})
+}
+
+func (m *Materials) MovePlanningToPurchased(ctx context.Context, id, companyId int64) (int64, int64, error) {
+	var newId, itemId int64
+	if err := move(ctx, m.log, domain.AuditEntityPlanningMaterial, id, companyId, m.Materials.GetPlanningById,
+		m.Materials.GetPurchasedById, func(ctx context.Context) (int64, error) {
+			var err error
+			newId, itemId, err = m.Materials.MovePlanningToPurchased(ctx, id, companyId)
+			return newId, err
+		}); err != nil {
+		return 0, 0, err
+	}
+
+	return newId, itemId, nil
+}
+
+func (m *Materials) CreatePurchased(ctx context.Context, material domain.Material) (int64, int64, error) {
+	var itemId int64
+	id, err := create(ctx, m.log, domain.AuditEntityPurchasedMaterial, material.CompanyID, m.Materials.GetPurchasedById,
+		func(ctx context.Context) (int64, error) {
+			var (
+				id  int64
+				err error
+			)
+			id, itemId, err = m.Materials.CreatePurchased(ctx, material)
+			return id, err
+		})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return id, itemId, nil
+}
+
+func (m *Materials) UpdatePurchased(ctx context.Context, material domain.Material) error {
+	return update(ctx, m.log, domain.AuditEntityPurchasedMaterial, material.ID, material.CompanyID, m.Materials.GetPurchasedById,
+		func(ctx context.Context) error {
+			return m.Materials.UpdatePurchased(ctx, material)
+		})
+}
+
+func (m *Materials) DeletePurchased(ctx context.Context, id, companyId int64) error {
+	return remove(ctx, m.log, domain.AuditEntityPurchasedMaterial, id, companyId, m.Materials.GetPurchasedById,
+		func(ctx context.Context) error {
+			return m.Materials.DeletePurchased(ctx, id, companyId)
+		})
+}
+
+// MovePurchasedToArchive журналирует только снимок до переноса, id записи в архиве репозиторий не возвращает
+func (m *Materials) MovePurchasedToArchive(ctx context.Context, id, companyId int64) error {
+	return move(ctx, m.log, domain.AuditEntityPurchasedMaterial, id, companyId, m.Materials.GetPurchasedById, nil,
+		func(ctx context.Context) (int64, error) {
+			return 0, m.Materials.MovePurchasedToArchive(ctx, id, companyId)
+		})
+}
+
+func (m *Materials) PutAway(ctx context.Context, id, companyId, binId int64, section, location string) error {
+	return update(ctx, m.log, domain.AuditEntityPurchasedMaterial, id, companyId, m.Materials.GetPurchasedById,
+		func(ctx context.Context) error {
+			return m.Materials.PutAway(ctx, id, companyId, binId, section, location)
+		})
+}
+
+func (m *Materials) DeletePlanningArchive(ctx context.Context, id, companyId int64) error {
+	return remove(ctx, m.log, domain.AuditEntityPlanningArchive, id, companyId, m.Materials.GetPlanningArchiveById,
+		func(ctx context.Context) error {
+			return m.Materials.DeletePlanningArchive(ctx, id, companyId)
+		})
+}
+
+func (m *Materials) DeletePurchasedArchive(ctx context.Context, id, companyId int64) error {
+	return remove(ctx, m.log, domain.AuditEntityPurchasedArchive, id, companyId, m.Materials.GetPurchasedArchiveById,
+		func(ctx context.Context) error {
+			return m.Materials.DeletePurchasedArchive(ctx, id, companyId)
+		})
+}
+
+func (m *Materials) QuarantineExpired(ctx context.Context, companyId int64) (int64, error) {
+	var n int64
+	if err := m.log.tx.WithinTx(ctx, func(ctx context.Context) error {
+		lots, err := m.Materials.GetExpired(ctx, companyId)
+		if err != nil {
+			return err
+		}
+
+		if n, err = m.Materials.QuarantineExpired(ctx, companyId); err != nil {
+			return err
+		}
+
+		for _, before := range lots {
+			after, err := m.Materials.GetPurchasedById(ctx, before.ID, before.CompanyID)
+			if err != nil {
+				return err
+			}
+
+			if err = m.log.record(ctx, domain.AuditActionUpdate, domain.AuditEntityPurchasedMaterial, before.CompanyID,
+				before.ID, before, after); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
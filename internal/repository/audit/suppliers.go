@@ -0,0 +1,48 @@
+package audit
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+// Suppliers журналирует создание, изменение, удаление и восстановление поставщиков
+type Suppliers struct {
+	postgres.Suppliers
+	log *Log
+}
+
+func NewSuppliers(repo postgres.Suppliers, log *Log) postgres.Suppliers {
+	return &Suppliers{
+		Suppliers: repo,
+		log:       log,
+	}
+}
+
+func (s *Suppliers) Create(ctx context.Context, supplier domain.Supplier) (int64, error) {
+	return create(ctx, s.log, domain.AuditEntitySupplier, supplier.CompanyID, s.Suppliers.GetById,
+		func(ctx context.Context) (int64, error) {
+			return s.Suppliers.Create(ctx, supplier)
+		})
+}
+
+func (s *Suppliers) Update(ctx context.Context, supplier domain.Supplier) error {
+	return update(ctx, s.log, domain.AuditEntitySupplier, supplier.ID, supplier.CompanyID, s.Suppliers.GetById,
+		func(ctx context.Context) error {
+			return s.Suppliers.Update(ctx, supplier)
+		})
+}
+
+func (s *Suppliers) Delete(ctx context.Context, id, companyId int64) error {
+	return remove(ctx, s.log, domain.AuditEntitySupplier, id, companyId, s.Suppliers.GetById,
+		func(ctx context.Context) error {
+			return s.Suppliers.Delete(ctx, id, companyId)
+		})
+}
+
+func (s *Suppliers) Restore(ctx context.Context, id, companyId int64) error {
+	return restore(ctx, s.log, domain.AuditEntitySupplier, id, companyId, s.Suppliers.GetDeletedById, s.Suppliers.GetById,
+		func(ctx context.Context) error {
+			return s.Suppliers.Restore(ctx, id, companyId)
+		})
+}
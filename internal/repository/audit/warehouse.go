@@ -0,0 +1,49 @@
+package audit
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+// Warehouse журналирует создание, изменение, удаление и восстановление складов. Пересчет заполненности
+// не журналируется: заполненность выводится из купленных партий, изменения которых уже в журнале.
+type Warehouse struct {
+	postgres.Warehouse
+	log *Log
+}
+
+func NewWarehouse(repo postgres.Warehouse, log *Log) postgres.Warehouse {
+	return &Warehouse{
+		Warehouse: repo,
+		log:       log,
+	}
+}
+
+func (w *Warehouse) Create(ctx context.Context, warehouse domain.Warehouse) (int64, error) {
+	return create(ctx, w.log, domain.AuditEntityWarehouse, warehouse.CompanyID, w.Warehouse.GetById,
+		func(ctx context.Context) (int64, error) {
+			return w.Warehouse.Create(ctx, warehouse)
+		})
+}
+
+func (w *Warehouse) Update(ctx context.Context, warehouse domain.Warehouse) error {
+	return update(ctx, w.log, domain.AuditEntityWarehouse, warehouse.ID, warehouse.CompanyID, w.Warehouse.GetById,
+		func(ctx context.Context) error {
+			return w.Warehouse.Update(ctx, warehouse)
+		})
+}
+
+func (w *Warehouse) Delete(ctx context.Context, id, companyId int64) error {
+	return remove(ctx, w.log, domain.AuditEntityWarehouse, id, companyId, w.Warehouse.GetById,
+		func(ctx context.Context) error {
+			return w.Warehouse.Delete(ctx, id, companyId)
+		})
+}
+
+func (w *Warehouse) Restore(ctx context.Context, id, companyId int64) error {
+	return restore(ctx, w.log, domain.AuditEntityWarehouse, id, companyId, w.Warehouse.GetDeletedById, w.Warehouse.GetById,
+		func(ctx context.Context) error {
+			return w.Warehouse.Restore(ctx, id, companyId)
+		})
+}
@@ -0,0 +1,187 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"go.uber.org/zap"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const keyPrefix = "crm-warehouse:v1:"
+
+// Cache - read-through кэш репозиториев поверх memcached. Значение хранится под ключом,
+// в который входят текущие версии его тегов, поэтому инвалидация тега - это увеличение
+// его версии, старые значения перестают читаться и вытесняются по ttl.
+type Cache struct {
+	store store
+	ttl   time.Duration
+}
+
+func New(cfg config.Cache) *Cache {
+	memory := newMemoryStore(cfg.MemoryMaxEntries)
+
+	var s store = memory
+	if len(cfg.Servers) > 0 {
+		s = newFallbackStore(newMemcachedStore(cfg), memory, cfg.RetryInterval)
+	}
+
+	return &Cache{
+		store: s,
+		ttl:   time.Duration(cfg.Ttl) * time.Second,
+	}
+}
+
+// Tag сущности в компании, инвалидация тега с нулевой компанией сбрасывает сущность во всех компаниях
+type Tag struct {
+	Entity    string
+	CompanyID int64
+}
+
+// keys ключи версий тега, значение зависит от версии сущности и версии сущности в компании
+func (t Tag) keys() []string {
+	global := keyPrefix + "tag:" + t.Entity
+	if t.CompanyID == 0 {
+		return []string{global}
+	}
+
+	return []string{global, global + ":" + strconv.FormatInt(t.CompanyID, 10)}
+}
+
+// Fetch возвращает значение из кэша, при промахе загружает его через load и сохраняет.
+// Внутри транзакции кэш не используется: транзакция может видеть еще не зафиксированные изменения.
+func Fetch[T any](ctx context.Context, c *Cache, name string, tags []Tag, load func(ctx context.Context) (T, error)) (T, error) {
+	if postgres.InTx(ctx) {
+		return load(ctx)
+	}
+
+	key, err := c.key(name, tags)
+	if err != nil {
+		logger.Warn("cache: failed to read tag versions", zap.String("key", name), zap.Error(err))
+		return load(ctx)
+	}
+
+	var v T
+	if data, ok := c.get(key); ok {
+		if err = json.Unmarshal(data, &v); err == nil {
+			return v, nil
+		}
+	}
+
+	if v, err = load(ctx); err != nil {
+		return v, err
+	}
+
+	if data, err := json.Marshal(v); err == nil {
+		if err = c.store.Set(key, data, c.ttl); err != nil {
+			logger.Warn("cache: failed to store value", zap.String("key", name), zap.Error(err))
+		}
+	}
+
+	return v, nil
+}
+
+// Invalidate сбрасывает значения с тегами после фиксации транзакции записи.
+// Вне транзакции сброс выполняется сразу.
+func (c *Cache) Invalidate(ctx context.Context, tags ...Tag) {
+	postgres.AfterCommit(ctx, func() {
+		for _, t := range tags {
+			keys := t.keys()
+
+			// увеличивается версия самого узкого тега: компании, а без компании - сущности
+			if err := c.store.Increment(keys[len(keys)-1]); err != nil {
+				logger.Warn("cache: failed to invalidate tag", zap.String("entity", t.Entity),
+					zap.Int64("company_id", t.CompanyID), zap.Error(err))
+			}
+		}
+	})
+}
+
+func (c *Cache) get(key string) ([]byte, bool) {
+	items, err := c.store.GetMulti([]string{key})
+	if err != nil {
+		return nil, false
+	}
+
+	data, ok := items[key]
+	return data, ok
+}
+
+// key ключ значения для текущих версий тегов. Отсутствующая версия создается из текущего времени,
+// чтобы после вытеснения версии из кэша не прочитать значения, сохраненные под прежними версиями.
+func (c *Cache) key(name string, tags []Tag) (string, error) {
+	var keys []string
+	for _, t := range tags {
+		keys = append(keys, t.keys()...)
+	}
+
+	versions, err := c.store.GetMulti(keys)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha1.New()
+	h.Write([]byte(name))
+
+	for _, k := range keys {
+		version, ok := versions[k]
+		if !ok {
+			if version, err = c.initVersion(k); err != nil {
+				return "", err
+			}
+		}
+
+		h.Write([]byte{0})
+		h.Write(version)
+	}
+
+	return keyPrefix + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (c *Cache) initVersion(key string) ([]byte, error) {
+	version := []byte(strconv.FormatInt(time.Now().UnixNano(), 10))
+
+	stored, err := c.store.Add(key, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if stored {
+		return version, nil
+	}
+
+	// версию успел создать параллельный запрос
+	versions, err := c.store.GetMulti([]string{key})
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := versions[key]; ok {
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("tag version %s is not stored", key)
+}
+
+// Key имя значения из метода и его параметров
+func Key(method string, args ...interface{}) string {
+	parts := make([]string, 0, len(args)+1)
+	parts = append(parts, method)
+
+	for _, arg := range args {
+		data, err := json.Marshal(arg)
+		if err != nil {
+			data = []byte(fmt.Sprintf("%v", arg))
+		}
+		parts = append(parts, string(data))
+	}
+
+	return strings.Join(parts, ":")
+}
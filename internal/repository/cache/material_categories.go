@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"time"
+)
+
+const entityCategory = "material_category"
+
+type Category struct {
+	postgres.Category
+	cache *Cache
+}
+
+// NewCategory оборачивает репозиторий кэшем, без кэша возвращает репозиторий как есть
+func NewCategory(repo postgres.Category, cache *Cache) postgres.Category {
+	if cache == nil {
+		return repo
+	}
+
+	return &Category{
+		Category: repo,
+		cache:    cache,
+	}
+}
+
+func (c *Category) Create(ctx context.Context, category domain.MaterialCategory) (int64, error) {
+	id, err := c.Category.Create(ctx, category)
+	if err == nil {
+		c.cache.Invalidate(ctx, Tag{entityCategory, category.CompanyID})
+	}
+
+	return id, err
+}
+
+func (c *Category) GetById(ctx context.Context, id, companyId int64) (domain.MaterialCategory, error) {
+	return Fetch(ctx, c.cache, Key("category.GetById", id, companyId), []Tag{{entityCategory, companyId}},
+		func(ctx context.Context) (domain.MaterialCategory, error) {
+			return c.Category.GetById(ctx, id, companyId)
+		})
+}
+
+func (c *Category) GetByName(ctx context.Context, name string, companyId int64) (domain.MaterialCategory, error) {
+	return Fetch(ctx, c.cache, Key("category.GetByName", name, companyId), []Tag{{entityCategory, companyId}},
+		func(ctx context.Context) (domain.MaterialCategory, error) {
+			return c.Category.GetByName(ctx, name, companyId)
+		})
+}
+
+// Update переносит название категории в материалы, поэтому сбрасывает и материалы компании
+func (c *Category) Update(ctx context.Context, category domain.MaterialCategory) error {
+	err := c.Category.Update(ctx, category)
+	if err == nil {
+		c.cache.Invalidate(ctx, append(materialTags(category.CompanyID), Tag{entityCategory, category.CompanyID})...)
+	}
+
+	return err
+}
+
+// Move меняет поддерево категории, списки материалов по категории помечены тегом категорий
+func (c *Category) Move(ctx context.Context, id, companyId, parentId int64) error {
+	err := c.Category.Move(ctx, id, companyId, parentId)
+	if err == nil {
+		c.cache.Invalidate(ctx, Tag{entityCategory, companyId})
+	}
+
+	return err
+}
+
+func (c *Category) Reparent(ctx context.Context, fromId, companyId, parentId int64) (int64, error) {
+	n, err := c.Category.Reparent(ctx, fromId, companyId, parentId)
+	if err == nil && n > 0 {
+		c.cache.Invalidate(ctx, Tag{entityCategory, companyId})
+	}
+
+	return n, err
+}
+
+func (c *Category) Delete(ctx context.Context, id, companyId int64) error {
+	err := c.Category.Delete(ctx, id, companyId)
+	if err == nil {
+		c.cache.Invalidate(ctx, Tag{entityCategory, companyId})
+	}
+
+	return err
+}
+
+func (c *Category) List(ctx context.Context, param domain.Param) ([]domain.MaterialCategory, error) {
+	return Fetch(ctx, c.cache, Key("category.List", param), []Tag{{entityCategory, param.CompanyId}},
+		func(ctx context.Context) ([]domain.MaterialCategory, error) {
+			return c.Category.List(ctx, param)
+		})
+}
+
+func (c *Category) Search(ctx context.Context, param domain.Param) ([]domain.MaterialCategory, error) {
+	return Fetch(ctx, c.cache, Key("category.Search", param), []Tag{{entityCategory, param.CompanyId}},
+		func(ctx context.Context) ([]domain.MaterialCategory, error) {
+			return c.Category.Search(ctx, param)
+		})
+}
+
+func (c *Category) GetDeletedById(ctx context.Context, id, companyId int64) (domain.MaterialCategory, error) {
+	return c.Category.GetDeletedById(ctx, id, companyId)
+}
+
+func (c *Category) Restore(ctx context.Context, id, companyId int64) error {
+	err := c.Category.Restore(ctx, id, companyId)
+	if err == nil {
+		c.cache.Invalidate(ctx, Tag{entityCategory, companyId})
+	}
+
+	return err
+}
+
+// Purge удаляет записи разных компаний, поэтому сбрасывает кэш всех компаний
+func (c *Category) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	n, err := c.Category.Purge(ctx, deletedBefore)
+	if err == nil && n > 0 {
+		c.cache.Invalidate(ctx, Tag{entityCategory, 0})
+	}
+
+	return n, err
+}
@@ -0,0 +1,229 @@
+package cache
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+const (
+	entityPlanning         = "materials.planning"
+	entityPurchased        = "materials.purchased"
+	entityPlanningArchive  = "materials.planning_archive"
+	entityPurchasedArchive = "materials.purchased_archive"
+)
+
+// Materials кэширует материалы по таблицам: запись в таблицу сбрасывает только ее значения.
+// Сроки годности и подбор партий по FEFO читаются из базы.
+type Materials struct {
+	postgres.Materials
+	cache *Cache
+}
+
+// NewMaterials оборачивает репозиторий кэшем, без кэша возвращает репозиторий как есть
+func NewMaterials(repo postgres.Materials, cache *Cache) postgres.Materials {
+	if cache == nil {
+		return repo
+	}
+
+	return &Materials{
+		Materials: repo,
+		cache:     cache,
+	}
+}
+
+func (m *Materials) CreatePlanning(ctx context.Context, material domain.Material) (int64, error) {
+	id, err := m.Materials.CreatePlanning(ctx, material)
+	if err == nil {
+		m.cache.Invalidate(ctx, Tag{entityPlanning, material.CompanyID})
+	}
+
+	return id, err
+}
+
+func (m *Materials) UpdatePlanning(ctx context.Context, material domain.Material) error {
+	err := m.Materials.UpdatePlanning(ctx, material)
+	if err == nil {
+		m.cache.Invalidate(ctx, Tag{entityPlanning, material.CompanyID})
+	}
+
+	return err
+}
+
+func (m *Materials) DeletePlanning(ctx context.Context, id, companyId int64) error {
+	err := m.Materials.DeletePlanning(ctx, id, companyId)
+	if err == nil {
+		m.cache.Invalidate(ctx, Tag{entityPlanning, companyId})
+	}
+
+	return err
+}
+
+func (m *Materials) GetPlanningById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return Fetch(ctx, m.cache, Key("materials.GetPlanningById", id, companyId), []Tag{{entityPlanning, companyId}},
+		func(ctx context.Context) (domain.Material, error) {
+			return m.Materials.GetPlanningById(ctx, id, companyId)
+		})
+}
+
+func (m *Materials) GetPlanningList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
+	return Fetch(ctx, m.cache, Key("materials.GetPlanningList", params), listTags(entityPlanning, params),
+		func(ctx context.Context) (domain.MaterialPage, error) {
+			return m.Materials.GetPlanningList(ctx, params)
+		})
+}
+
+// MovePlanningToPurchased переносит материал в купленные, а планируемый - в архив
+func (m *Materials) MovePlanningToPurchased(ctx context.Context, id, companyId int64) (int64, int64, error) {
+	newId, itemId, err := m.Materials.MovePlanningToPurchased(ctx, id, companyId)
+	if err == nil {
+		m.cache.Invalidate(ctx,
+			Tag{entityPlanning, companyId}, Tag{entityPurchased, companyId}, Tag{entityPlanningArchive, companyId})
+	}
+
+	return newId, itemId, err
+}
+
+func (m *Materials) CreatePurchased(ctx context.Context, material domain.Material) (int64, int64, error) {
+	id, itemId, err := m.Materials.CreatePurchased(ctx, material)
+	if err == nil {
+		m.cache.Invalidate(ctx, Tag{entityPurchased, material.CompanyID})
+	}
+
+	return id, itemId, err
+}
+
+func (m *Materials) UpdatePurchased(ctx context.Context, material domain.Material) error {
+	err := m.Materials.UpdatePurchased(ctx, material)
+	if err == nil {
+		m.cache.Invalidate(ctx, Tag{entityPurchased, material.CompanyID})
+	}
+
+	return err
+}
+
+func (m *Materials) DeletePurchased(ctx context.Context, id, companyId int64) error {
+	err := m.Materials.DeletePurchased(ctx, id, companyId)
+	if err == nil {
+		m.cache.Invalidate(ctx, Tag{entityPurchased, companyId})
+	}
+
+	return err
+}
+
+func (m *Materials) GetPurchasedById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return Fetch(ctx, m.cache, Key("materials.GetPurchasedById", id, companyId), []Tag{{entityPurchased, companyId}},
+		func(ctx context.Context) (domain.Material, error) {
+			return m.Materials.GetPurchasedById(ctx, id, companyId)
+		})
+}
+
+func (m *Materials) GetPurchasedList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
+	return Fetch(ctx, m.cache, Key("materials.GetPurchasedList", params), listTags(entityPurchased, params),
+		func(ctx context.Context) (domain.MaterialPage, error) {
+			return m.Materials.GetPurchasedList(ctx, params)
+		})
+}
+
+func (m *Materials) MovePurchasedToArchive(ctx context.Context, id, companyId int64) error {
+	err := m.Materials.MovePurchasedToArchive(ctx, id, companyId)
+	if err == nil {
+		m.cache.Invalidate(ctx, Tag{entityPurchased, companyId}, Tag{entityPurchasedArchive, companyId})
+	}
+
+	return err
+}
+
+func (m *Materials) PutAway(ctx context.Context, id, companyId, binId int64, section, location string) error {
+	err := m.Materials.PutAway(ctx, id, companyId, binId, section, location)
+	if err == nil {
+		m.cache.Invalidate(ctx, Tag{entityPurchased, companyId})
+	}
+
+	return err
+}
+
+func (m *Materials) GetPlanningArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return Fetch(ctx, m.cache, Key("materials.GetPlanningArchiveById", id, companyId), []Tag{{entityPlanningArchive, companyId}},
+		func(ctx context.Context) (domain.Material, error) {
+			return m.Materials.GetPlanningArchiveById(ctx, id, companyId)
+		})
+}
+
+func (m *Materials) GetPurchasedArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return Fetch(ctx, m.cache, Key("materials.GetPurchasedArchiveById", id, companyId), []Tag{{entityPurchasedArchive, companyId}},
+		func(ctx context.Context) (domain.Material, error) {
+			return m.Materials.GetPurchasedArchiveById(ctx, id, companyId)
+		})
+}
+
+func (m *Materials) GetPlanningArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
+	return Fetch(ctx, m.cache, Key("materials.GetPlanningArchiveList", params), listTags(entityPlanningArchive, params),
+		func(ctx context.Context) (domain.MaterialPage, error) {
+			return m.Materials.GetPlanningArchiveList(ctx, params)
+		})
+}
+
+func (m *Materials) GetPurchasedArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
+	return Fetch(ctx, m.cache, Key("materials.GetPurchasedArchiveList", params), listTags(entityPurchasedArchive, params),
+		func(ctx context.Context) (domain.MaterialPage, error) {
+			return m.Materials.GetPurchasedArchiveList(ctx, params)
+		})
+}
+
+func (m *Materials) DeletePlanningArchive(ctx context.Context, id, companyId int64) error {
+	err := m.Materials.DeletePlanningArchive(ctx, id, companyId)
+	if err == nil {
+		m.cache.Invalidate(ctx, Tag{entityPlanningArchive, companyId})
+	}
+
+	return err
+}
+
+func (m *Materials) DeletePurchasedArchive(ctx context.Context, id, companyId int64) error {
+	err := m.Materials.DeletePurchasedArchive(ctx, id, companyId)
+	if err == nil {
+		m.cache.Invalidate(ctx, Tag{entityPurchasedArchive, companyId})
+	}
+
+	return err
+}
+
+// Search ищет по всем таблицам материалов, поэтому сбрасывается записью в любую из них
+func (m *Materials) Search(ctx context.Context, param domain.Param) ([]domain.Material, error) {
+	return Fetch(ctx, m.cache, Key("materials.Search", param), materialTags(param.CompanyId),
+		func(ctx context.Context) ([]domain.Material, error) {
+			return m.Materials.Search(ctx, param)
+		})
+}
+
+// QuarantineExpired без компании переводит в карантин партии всех компаний
+func (m *Materials) QuarantineExpired(ctx context.Context, companyId int64) (int64, error) {
+	n, err := m.Materials.QuarantineExpired(ctx, companyId)
+	if err == nil && n > 0 {
+		m.cache.Invalidate(ctx, Tag{entityPurchased, companyId})
+	}
+
+	return n, err
+}
+
+// materialTags - теги всех таблиц материалов компании
+func materialTags(companyId int64) []Tag {
+	return []Tag{
+		{entityPlanning, companyId},
+		{entityPurchased, companyId},
+		{entityPlanningArchive, companyId},
+		{entityPurchasedArchive, companyId},
+	}
+}
+
+// listTags - теги списка материалов таблицы. Список по категории включает вложенные категории,
+// поэтому сбрасывается и изменением дерева категорий.
+func listTags(entity string, params domain.MaterialParams) []Tag {
+	tags := []Tag{{entity, params.CompanyId}}
+	if params.Filter.CategoryId != 0 {
+		tags = append(tags, Tag{entityCategory, params.CompanyId})
+	}
+
+	return tags
+}
@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+// Движения, перемещения, расход резервов и утверждение инвентаризаций меняют остатки купленных материалов и заполненность складов,
+// сами они не кэшируются
+
+// stockTags значения, которые меняет проводка по остаткам компании
+func stockTags(companyId int64) []Tag {
+	return []Tag{{entityPurchased, companyId}, {entityWarehouse, companyId}}
+}
+
+type Movements struct {
+	postgres.Movements
+	cache *Cache
+}
+
+// NewMovements сбрасывает кэш остатков после проводок, без кэша возвращает репозиторий как есть
+func NewMovements(repo postgres.Movements, cache *Cache) postgres.Movements {
+	if cache == nil {
+		return repo
+	}
+
+	return &Movements{
+		Movements: repo,
+		cache:     cache,
+	}
+}
+
+func (m *Movements) Create(ctx context.Context, req domain.MovementRequest) ([]domain.Movement, error) {
+	movements, err := m.Movements.Create(ctx, req)
+	if err == nil {
+		m.cache.Invalidate(ctx, stockTags(req.CompanyID)...)
+	}
+
+	return movements, err
+}
+
+type Transfers struct {
+	postgres.Transfers
+	cache *Cache
+}
+
+// NewTransfers сбрасывает кэш остатков после отгрузки и приемки, без кэша возвращает репозиторий как есть
+func NewTransfers(repo postgres.Transfers, cache *Cache) postgres.Transfers {
+	if cache == nil {
+		return repo
+	}
+
+	return &Transfers{
+		Transfers: repo,
+		cache:     cache,
+	}
+}
+
+func (t *Transfers) Ship(ctx context.Context, id, companyId, userId int64) error {
+	err := t.Transfers.Ship(ctx, id, companyId, userId)
+	if err == nil {
+		t.cache.Invalidate(ctx, stockTags(companyId)...)
+	}
+
+	return err
+}
+
+func (t *Transfers) Receive(ctx context.Context, id, companyId, userId int64, receipts []domain.TransferReceipt) error {
+	err := t.Transfers.Receive(ctx, id, companyId, userId, receipts)
+	if err == nil {
+		t.cache.Invalidate(ctx, stockTags(companyId)...)
+	}
+
+	return err
+}
+
+type Reservations struct {
+	postgres.Reservations
+	cache *Cache
+}
+
+// NewReservations сбрасывает кэш остатков после расхода резерва, без кэша возвращает репозиторий как есть
+func NewReservations(repo postgres.Reservations, cache *Cache) postgres.Reservations {
+	if cache == nil {
+		return repo
+	}
+
+	return &Reservations{
+		Reservations: repo,
+		cache:        cache,
+	}
+}
+
+func (r *Reservations) Consume(ctx context.Context, id, companyId, quantity, userId int64) (domain.Movement, error) {
+	m, err := r.Reservations.Consume(ctx, id, companyId, quantity, userId)
+	if err == nil {
+		r.cache.Invalidate(ctx, stockTags(companyId)...)
+	}
+
+	return m, err
+}
+
+type Stocktakes struct {
+	postgres.Stocktakes
+	cache *Cache
+}
+
+// NewStocktakes сбрасывает кэш остатков после утверждения инвентаризации, без кэша возвращает репозиторий как есть
+func NewStocktakes(repo postgres.Stocktakes, cache *Cache) postgres.Stocktakes {
+	if cache == nil {
+		return repo
+	}
+
+	return &Stocktakes{
+		Stocktakes: repo,
+		cache:      cache,
+	}
+}
+
+func (s *Stocktakes) Approve(ctx context.Context, id, companyId, userId int64) ([]domain.Movement, error) {
+	movements, err := s.Stocktakes.Approve(ctx, id, companyId, userId)
+	if err == nil {
+		s.cache.Invalidate(ctx, stockTags(companyId)...)
+	}
+
+	return movements, err
+}
@@ -0,0 +1,346 @@
+package cache
+
+import (
+	"errors"
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"go.uber.org/zap"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// store - хранилище значений и версий тегов
+type store interface {
+	GetMulti(keys []string) (map[string][]byte, error)
+	Set(key string, value []byte, ttl time.Duration) error
+	// Add сохраняет значение без срока жизни, если ключа еще нет
+	Add(key string, value []byte) (bool, error)
+	// Increment увеличивает число под ключом, отсутствующий ключ не считается ошибкой
+	Increment(key string) error
+	FlushAll() error
+}
+
+type memcachedStore struct {
+	client *memcache.Client
+}
+
+func newMemcachedStore(cfg config.Cache) *memcachedStore {
+	client := memcache.New(cfg.Servers...)
+	client.Timeout = cfg.Timeout
+	client.MaxIdleConns = cfg.MaxIdleConns
+
+	return &memcachedStore{
+		client: client,
+	}
+}
+
+func (ms *memcachedStore) GetMulti(keys []string) (map[string][]byte, error) {
+	items, err := ms.client.GetMulti(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string][]byte, len(items))
+	for k, item := range items {
+		values[k] = item.Value
+	}
+
+	return values, nil
+}
+
+func (ms *memcachedStore) Set(key string, value []byte, ttl time.Duration) error {
+	return ms.client.Set(&memcache.Item{Key: key, Value: value, Expiration: int32(ttl.Seconds())})
+}
+
+func (ms *memcachedStore) Add(key string, value []byte) (bool, error) {
+	if err := ms.client.Add(&memcache.Item{Key: key, Value: value}); err != nil {
+		if errors.Is(err, memcache.ErrNotStored) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (ms *memcachedStore) Increment(key string) error {
+	if _, err := ms.client.Increment(key, 1); err != nil && !errors.Is(err, memcache.ErrCacheMiss) {
+		return err
+	}
+
+	return nil
+}
+
+func (ms *memcachedStore) FlushAll() error {
+	return ms.client.FlushAll()
+}
+
+type memoryItem struct {
+	value   []byte
+	expires time.Time // нулевое - без срока жизни
+}
+
+// memoryStore - кэш в памяти процесса, используется без memcached и пока memcached недоступен
+type memoryStore struct {
+	mu         sync.Mutex
+	items      map[string]memoryItem
+	maxEntries int
+}
+
+func newMemoryStore(maxEntries int) *memoryStore {
+	return &memoryStore{
+		items:      make(map[string]memoryItem),
+		maxEntries: maxEntries,
+	}
+}
+
+func (ms *memoryStore) GetMulti(keys []string) (map[string][]byte, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	now := time.Now()
+
+	values := make(map[string][]byte, len(keys))
+	for _, k := range keys {
+		if item, ok := ms.lookup(k, now); ok {
+			values[k] = item.value
+		}
+	}
+
+	return values, nil
+}
+
+func (ms *memoryStore) Set(key string, value []byte, ttl time.Duration) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.put(key, memoryItem{value: value, expires: time.Now().Add(ttl)})
+
+	return nil
+}
+
+func (ms *memoryStore) Add(key string, value []byte) (bool, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, ok := ms.lookup(key, time.Now()); ok {
+		return false, nil
+	}
+
+	ms.put(key, memoryItem{value: value})
+
+	return true, nil
+}
+
+func (ms *memoryStore) Increment(key string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	item, ok := ms.lookup(key, time.Now())
+	if !ok {
+		return nil
+	}
+
+	n, err := strconv.ParseUint(string(item.value), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	item.value = []byte(strconv.FormatUint(n+1, 10))
+	ms.items[key] = item
+
+	return nil
+}
+
+func (ms *memoryStore) FlushAll() error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.items = make(map[string]memoryItem)
+
+	return nil
+}
+
+func (ms *memoryStore) lookup(key string, now time.Time) (memoryItem, bool) {
+	item, ok := ms.items[key]
+	if !ok {
+		return memoryItem{}, false
+	}
+
+	if !item.expires.IsZero() && now.After(item.expires) {
+		delete(ms.items, key)
+		return memoryItem{}, false
+	}
+
+	return item, true
+}
+
+// put сохраняет значение, при переполнении сначала удаляются истекшие значения, затем произвольные.
+// Удаленная версия тега создается заново из текущего времени, поэтому старые значения не читаются.
+func (ms *memoryStore) put(key string, item memoryItem) {
+	if _, ok := ms.items[key]; !ok && len(ms.items) >= ms.maxEntries {
+		now := time.Now()
+		for k, v := range ms.items {
+			if !v.expires.IsZero() && now.After(v.expires) {
+				delete(ms.items, k)
+			}
+		}
+
+		for k := range ms.items {
+			if len(ms.items) < ms.maxEntries {
+				break
+			}
+			delete(ms.items, k)
+		}
+	}
+
+	ms.items[key] = item
+}
+
+// fallbackStore обращается к memcached, а пока он недоступен - к памяти процесса.
+// Инвалидации, выполненные в памяти, memcached не видел, поэтому после восстановления
+// связи он очищается целиком.
+type fallbackStore struct {
+	primary  store
+	memory   store
+	interval time.Duration
+
+	mu        sync.Mutex
+	down      bool
+	downUntil time.Time
+	dirty     bool // были инвалидации, пока memcached был недоступен
+}
+
+func newFallbackStore(primary, memory store, interval time.Duration) *fallbackStore {
+	return &fallbackStore{
+		primary:  primary,
+		memory:   memory,
+		interval: interval,
+	}
+}
+
+func (fs *fallbackStore) GetMulti(keys []string) (map[string][]byte, error) {
+	var values map[string][]byte
+	err := fs.do(func(s store) (err error) {
+		values, err = s.GetMulti(keys)
+		return err
+	})
+
+	return values, err
+}
+
+func (fs *fallbackStore) Set(key string, value []byte, ttl time.Duration) error {
+	return fs.do(func(s store) error {
+		return s.Set(key, value, ttl)
+	})
+}
+
+func (fs *fallbackStore) Add(key string, value []byte) (bool, error) {
+	var stored bool
+	err := fs.do(func(s store) (err error) {
+		stored, err = s.Add(key, value)
+		return err
+	})
+
+	return stored, err
+}
+
+func (fs *fallbackStore) Increment(key string) error {
+	return fs.do(func(s store) error {
+		if s == fs.memory {
+			fs.mu.Lock()
+			fs.dirty = true
+			fs.mu.Unlock()
+		}
+
+		return s.Increment(key)
+	})
+}
+
+func (fs *fallbackStore) FlushAll() error {
+	return fs.do(func(s store) error {
+		return s.FlushAll()
+	})
+}
+
+// do выполняет операцию в memcached, при его недоступности - в памяти процесса
+func (fs *fallbackStore) do(op func(s store) error) error {
+	if !fs.available() {
+		return op(fs.memory)
+	}
+
+	err := op(fs.primary)
+	if err == nil || !unavailable(err) {
+		fs.recovered()
+		return err
+	}
+
+	fs.markDown(err)
+
+	return op(fs.memory)
+}
+
+// available сообщает, можно ли обращаться к memcached. После паузы memcached проверяется снова,
+// и если были пропущенные инвалидации, сначала очищается.
+func (fs *fallbackStore) available() bool {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if !fs.down {
+		return true
+	}
+
+	if time.Now().Before(fs.downUntil) {
+		return false
+	}
+
+	if fs.dirty {
+		if err := fs.primary.FlushAll(); err != nil {
+			fs.downUntil = time.Now().Add(fs.interval)
+			return false
+		}
+		fs.dirty = false
+	}
+
+	return true
+}
+
+func (fs *fallbackStore) recovered() {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if fs.down {
+		fs.down = false
+		logger.Info("cache: memcached is available again")
+	}
+}
+
+func (fs *fallbackStore) markDown(err error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	fs.downUntil = time.Now().Add(fs.interval)
+
+	if fs.down {
+		return
+	}
+
+	fs.down = true
+
+	// значения в памяти не получали инвалидаций, пока работал memcached
+	_ = fs.memory.FlushAll()
+
+	logger.Warn("cache: memcached is unavailable, using in-memory cache", zap.Error(err))
+}
+
+// unavailable отличает недоступность memcached от ответов, которые он вернул сам
+func unavailable(err error) bool {
+	return !errors.Is(err, memcache.ErrCacheMiss) &&
+		!errors.Is(err, memcache.ErrNotStored) &&
+		!errors.Is(err, memcache.ErrCASConflict) &&
+		!errors.Is(err, memcache.ErrMalformedKey) &&
+		!errors.Is(err, memcache.ErrServerError)
+}
@@ -0,0 +1,393 @@
+package cache
+
+import (
+	"errors"
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMain(m *testing.M) {
+	logger.ZapLoggerInit()
+	os.Exit(m.Run())
+}
+
+var errConnRefused = errors.New("dial tcp: connection refused")
+
+// fakePrimary - memcached в памяти, который можно сделать недоступным
+type fakePrimary struct {
+	*memoryStore
+	err     error // ошибка всех операций, nil - доступен
+	flushes int
+}
+
+func newFakePrimary() *fakePrimary {
+	return &fakePrimary{memoryStore: newMemoryStore(100)}
+}
+
+func (fp *fakePrimary) GetMulti(keys []string) (map[string][]byte, error) {
+	if fp.err != nil {
+		return nil, fp.err
+	}
+
+	return fp.memoryStore.GetMulti(keys)
+}
+
+func (fp *fakePrimary) Set(key string, value []byte, ttl time.Duration) error {
+	if fp.err != nil {
+		return fp.err
+	}
+
+	return fp.memoryStore.Set(key, value, ttl)
+}
+
+func (fp *fakePrimary) Add(key string, value []byte) (bool, error) {
+	if fp.err != nil {
+		return false, fp.err
+	}
+
+	return fp.memoryStore.Add(key, value)
+}
+
+func (fp *fakePrimary) Increment(key string) error {
+	if fp.err != nil {
+		return fp.err
+	}
+
+	return fp.memoryStore.Increment(key)
+}
+
+func (fp *fakePrimary) FlushAll() error {
+	if fp.err != nil {
+		return fp.err
+	}
+
+	fp.flushes++
+
+	return fp.memoryStore.FlushAll()
+}
+
+func get(t *testing.T, s store, key string) (string, bool) {
+	t.Helper()
+
+	values, err := s.GetMulti([]string{key})
+	if err != nil {
+		t.Fatalf("GetMulti: %v", err)
+	}
+
+	value, ok := values[key]
+
+	return string(value), ok
+}
+
+func TestMemoryStore(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(ms *memoryStore) error
+		key   string
+		want  string
+		found bool
+	}{
+		{
+			name:  "set and get",
+			setup: func(ms *memoryStore) error { return ms.Set("k", []byte("v"), time.Minute) },
+			key:   "k",
+			want:  "v",
+			found: true,
+		},
+		{
+			name:  "missing key",
+			setup: func(ms *memoryStore) error { return nil },
+			key:   "k",
+		},
+		{
+			name:  "expired value",
+			setup: func(ms *memoryStore) error { return ms.Set("k", []byte("v"), -time.Second) },
+			key:   "k",
+		},
+		{
+			name: "add keeps existing value",
+			setup: func(ms *memoryStore) error {
+				if _, err := ms.Add("k", []byte("1")); err != nil {
+					return err
+				}
+				_, err := ms.Add("k", []byte("2"))
+				return err
+			},
+			key:   "k",
+			want:  "1",
+			found: true,
+		},
+		{
+			name: "add replaces expired value",
+			setup: func(ms *memoryStore) error {
+				if err := ms.Set("k", []byte("1"), -time.Second); err != nil {
+					return err
+				}
+				_, err := ms.Add("k", []byte("2"))
+				return err
+			},
+			key:   "k",
+			want:  "2",
+			found: true,
+		},
+		{
+			name: "increment",
+			setup: func(ms *memoryStore) error {
+				if _, err := ms.Add("k", []byte("41")); err != nil {
+					return err
+				}
+				return ms.Increment("k")
+			},
+			key:   "k",
+			want:  "42",
+			found: true,
+		},
+		{
+			name:  "increment of missing key",
+			setup: func(ms *memoryStore) error { return ms.Increment("k") },
+			key:   "k",
+		},
+		{
+			name: "flush",
+			setup: func(ms *memoryStore) error {
+				if err := ms.Set("k", []byte("v"), time.Minute); err != nil {
+					return err
+				}
+				return ms.FlushAll()
+			},
+			key: "k",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := newMemoryStore(10)
+			if err := tt.setup(ms); err != nil {
+				t.Fatalf("setup: %v", err)
+			}
+
+			got, ok := get(t, ms, tt.key)
+			if ok != tt.found || got != tt.want {
+				t.Errorf("GetMulti(%q) = %q, %v, want %q, %v", tt.key, got, ok, tt.want, tt.found)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreIncrementNotNumber(t *testing.T) {
+	ms := newMemoryStore(10)
+	if err := ms.Set("k", []byte("v"), time.Minute); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if err := ms.Increment("k"); err == nil {
+		t.Error("Increment() error = nil, want error")
+	}
+}
+
+func TestMemoryStoreLimit(t *testing.T) {
+	ms := newMemoryStore(2)
+
+	// истекшее значение вытесняется первым
+	_ = ms.Set("expired", []byte("1"), -time.Second)
+	_ = ms.Set("a", []byte("2"), time.Minute)
+	_ = ms.Set("b", []byte("3"), time.Minute)
+
+	if len(ms.items) != 2 {
+		t.Fatalf("%d entries, want 2", len(ms.items))
+	}
+
+	if _, ok := ms.items["expired"]; ok {
+		t.Error("expired entry was not evicted")
+	}
+
+	// перезапись существующего ключа ничего не вытесняет
+	_ = ms.Set("a", []byte("4"), time.Minute)
+	if got, ok := get(t, ms, "b"); !ok || got != "3" {
+		t.Errorf("GetMulti(b) = %q, %v, want %q", got, ok, "3")
+	}
+
+	_ = ms.Set("c", []byte("5"), time.Minute)
+	if len(ms.items) != 2 {
+		t.Errorf("%d entries, want 2", len(ms.items))
+	}
+
+	if got, ok := get(t, ms, "c"); !ok || got != "5" {
+		t.Errorf("GetMulti(c) = %q, %v, want %q", got, ok, "5")
+	}
+}
+
+func TestUnavailable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"cache miss", memcache.ErrCacheMiss, false},
+		{"not stored", memcache.ErrNotStored, false},
+		{"cas conflict", memcache.ErrCASConflict, false},
+		{"malformed key", memcache.ErrMalformedKey, false},
+		{"server error", memcache.ErrServerError, false},
+		{"no servers", memcache.ErrNoServers, true},
+		{"connection error", errConnRefused, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unavailable(tt.err); got != tt.want {
+				t.Errorf("unavailable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFallbackStore(t *testing.T) {
+	tests := []struct {
+		name string
+		// run выполняет сценарий и возвращает значение ключа "k", прочитанное через fallbackStore
+		run         func(t *testing.T, fs *fallbackStore, primary *fakePrimary) (string, bool)
+		want        string
+		found       bool
+		wantFlushes int
+	}{
+		{
+			name: "primary available",
+			run: func(t *testing.T, fs *fallbackStore, primary *fakePrimary) (string, bool) {
+				_ = fs.Set("k", []byte("v"), time.Minute)
+				if got, _ := get(t, primary, "k"); got != "v" {
+					t.Errorf("primary value = %q, want %q", got, "v")
+				}
+				return get(t, fs, "k")
+			},
+			want:  "v",
+			found: true,
+		},
+		{
+			name: "primary down uses memory",
+			run: func(t *testing.T, fs *fallbackStore, primary *fakePrimary) (string, bool) {
+				primary.err = errConnRefused
+				if err := fs.Set("k", []byte("v"), time.Minute); err != nil {
+					t.Fatalf("Set: %v", err)
+				}
+				return get(t, fs, "k")
+			},
+			want:  "v",
+			found: true,
+		},
+		{
+			name: "memcached answer is not unavailability",
+			run: func(t *testing.T, fs *fallbackStore, primary *fakePrimary) (string, bool) {
+				_ = fs.Set("k", []byte("v"), time.Minute)
+				primary.err = memcache.ErrServerError
+				if err := fs.Set("k", []byte("other"), time.Minute); !errors.Is(err, memcache.ErrServerError) {
+					t.Errorf("Set() error = %v, want %v", err, memcache.ErrServerError)
+				}
+				primary.err = nil
+				return get(t, fs, "k")
+			},
+			want:  "v",
+			found: true,
+		},
+		{
+			name: "primary retried only after interval",
+			run: func(t *testing.T, fs *fallbackStore, primary *fakePrimary) (string, bool) {
+				fs.interval = time.Hour
+				primary.err = errConnRefused
+				_ = fs.Set("k", []byte("memory"), time.Minute)
+				primary.err = nil
+				_ = primary.Set("k", []byte("primary"), time.Minute)
+				return get(t, fs, "k")
+			},
+			want:  "memory",
+			found: true,
+		},
+		{
+			name: "recovered without invalidations keeps primary",
+			run: func(t *testing.T, fs *fallbackStore, primary *fakePrimary) (string, bool) {
+				_ = fs.Set("k", []byte("v"), time.Minute)
+				primary.err = errConnRefused
+				_, _ = get(t, fs, "k")
+				primary.err = nil
+				return get(t, fs, "k")
+			},
+			want:  "v",
+			found: true,
+		},
+		{
+			name: "recovered after invalidations flushes primary",
+			run: func(t *testing.T, fs *fallbackStore, primary *fakePrimary) (string, bool) {
+				_ = fs.Set("k", []byte("v"), time.Minute)
+				primary.err = errConnRefused
+				_ = fs.Increment("tag")
+				primary.err = nil
+				return get(t, fs, "k")
+			},
+			wantFlushes: 1,
+		},
+		{
+			name: "flush failure keeps memory",
+			run: func(t *testing.T, fs *fallbackStore, primary *fakePrimary) (string, bool) {
+				primary.err = errConnRefused
+				_ = fs.Increment("tag")
+				_ = fs.Set("k", []byte("memory"), time.Minute)
+				// memcached по-прежнему недоступен: FlushAll не удался, снова используется память
+				return get(t, fs, "k")
+			},
+			want:  "memory",
+			found: true,
+		},
+		{
+			name: "memory is flushed when primary goes down",
+			run: func(t *testing.T, fs *fallbackStore, primary *fakePrimary) (string, bool) {
+				primary.err = errConnRefused
+				_ = fs.Set("k", []byte("stale"), time.Minute)
+				primary.err = nil
+				_, _ = get(t, fs, "k")
+				primary.err = errConnRefused
+				return get(t, fs, "k")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := newFakePrimary()
+			fs := newFallbackStore(primary, newMemoryStore(10), 0)
+
+			got, ok := tt.run(t, fs, primary)
+			if ok != tt.found || got != tt.want {
+				t.Errorf("GetMulti(k) = %q, %v, want %q, %v", got, ok, tt.want, tt.found)
+			}
+
+			if primary.flushes != tt.wantFlushes {
+				t.Errorf("primary flushed %d times, want %d", primary.flushes, tt.wantFlushes)
+			}
+		})
+	}
+}
+
+func TestFallbackStoreResult(t *testing.T) {
+	primary := newFakePrimary()
+	fs := newFallbackStore(primary, newMemoryStore(10), 0)
+
+	if stored, err := fs.Add("k", []byte("1")); err != nil || !stored {
+		t.Fatalf("Add() = %v, %v, want true", stored, err)
+	}
+
+	if stored, err := fs.Add("k", []byte("2")); err != nil || stored {
+		t.Errorf("Add() of existing key = %v, %v, want false", stored, err)
+	}
+
+	values, err := fs.GetMulti([]string{"k", "missing"})
+	if err != nil {
+		t.Fatalf("GetMulti: %v", err)
+	}
+
+	if want := map[string][]byte{"k": []byte("1")}; !reflect.DeepEqual(values, want) {
+		t.Errorf("GetMulti() = %v, want %v", values, want)
+	}
+}
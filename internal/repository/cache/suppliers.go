@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"time"
+)
+
+const entitySupplier = "supplier"
+
+type Suppliers struct {
+	postgres.Suppliers
+	cache *Cache
+}
+
+// NewSuppliers оборачивает репозиторий кэшем, без кэша возвращает репозиторий как есть
+func NewSuppliers(repo postgres.Suppliers, cache *Cache) postgres.Suppliers {
+	if cache == nil {
+		return repo
+	}
+
+	return &Suppliers{
+		Suppliers: repo,
+		cache:     cache,
+	}
+}
+
+func (s *Suppliers) Create(ctx context.Context, supplier domain.Supplier) (int64, error) {
+	id, err := s.Suppliers.Create(ctx, supplier)
+	if err == nil {
+		s.cache.Invalidate(ctx, Tag{entitySupplier, supplier.CompanyID})
+	}
+
+	return id, err
+}
+
+func (s *Suppliers) GetById(ctx context.Context, id, companyId int64) (domain.Supplier, error) {
+	return Fetch(ctx, s.cache, Key("supplier.GetById", id, companyId), []Tag{{entitySupplier, companyId}},
+		func(ctx context.Context) (domain.Supplier, error) {
+			return s.Suppliers.GetById(ctx, id, companyId)
+		})
+}
+
+func (s *Suppliers) Update(ctx context.Context, supplier domain.Supplier) error {
+	err := s.Suppliers.Update(ctx, supplier)
+	if err == nil {
+		s.cache.Invalidate(ctx, Tag{entitySupplier, supplier.CompanyID})
+	}
+
+	return err
+}
+
+func (s *Suppliers) Delete(ctx context.Context, id, companyId int64) error {
+	err := s.Suppliers.Delete(ctx, id, companyId)
+	if err == nil {
+		s.cache.Invalidate(ctx, Tag{entitySupplier, companyId})
+	}
+
+	return err
+}
+
+func (s *Suppliers) GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Supplier, error) {
+	return Fetch(ctx, s.cache, Key("supplier.GetListByCompanyId", id, includeDeleted), []Tag{{entitySupplier, id}},
+		func(ctx context.Context) ([]domain.Supplier, error) {
+			return s.Suppliers.GetListByCompanyId(ctx, id, includeDeleted)
+		})
+}
+
+func (s *Suppliers) GetDeletedById(ctx context.Context, id, companyId int64) (domain.Supplier, error) {
+	return s.Suppliers.GetDeletedById(ctx, id, companyId)
+}
+
+func (s *Suppliers) Restore(ctx context.Context, id, companyId int64) error {
+	err := s.Suppliers.Restore(ctx, id, companyId)
+	if err == nil {
+		s.cache.Invalidate(ctx, Tag{entitySupplier, companyId})
+	}
+
+	return err
+}
+
+// Purge удаляет записи разных компаний, поэтому сбрасывает кэш всех компаний
+func (s *Suppliers) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	n, err := s.Suppliers.Purge(ctx, deletedBefore)
+	if err == nil && n > 0 {
+		s.cache.Invalidate(ctx, Tag{entitySupplier, 0})
+	}
+
+	return n, err
+}
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"time"
+)
+
+const entityWarehouse = "warehouse"
+
+// Warehouse кэширует склады компании, ответственные пользователи читаются из базы
+type Warehouse struct {
+	postgres.Warehouse
+	cache *Cache
+}
+
+// NewWarehouse оборачивает репозиторий кэшем, без кэша возвращает репозиторий как есть
+func NewWarehouse(repo postgres.Warehouse, cache *Cache) postgres.Warehouse {
+	if cache == nil {
+		return repo
+	}
+
+	return &Warehouse{
+		Warehouse: repo,
+		cache:     cache,
+	}
+}
+
+func (w *Warehouse) Create(ctx context.Context, warehouse domain.Warehouse) (int64, error) {
+	id, err := w.Warehouse.Create(ctx, warehouse)
+	if err == nil {
+		w.cache.Invalidate(ctx, Tag{entityWarehouse, warehouse.CompanyID})
+	}
+
+	return id, err
+}
+
+func (w *Warehouse) GetById(ctx context.Context, id, companyId int64) (domain.Warehouse, error) {
+	return Fetch(ctx, w.cache, Key("warehouse.GetById", id, companyId), []Tag{{entityWarehouse, companyId}},
+		func(ctx context.Context) (domain.Warehouse, error) {
+			return w.Warehouse.GetById(ctx, id, companyId)
+		})
+}
+
+func (w *Warehouse) Update(ctx context.Context, warehouse domain.Warehouse) error {
+	err := w.Warehouse.Update(ctx, warehouse)
+	if err == nil {
+		w.cache.Invalidate(ctx, Tag{entityWarehouse, warehouse.CompanyID})
+	}
+
+	return err
+}
+
+func (w *Warehouse) Delete(ctx context.Context, id, companyId int64) error {
+	err := w.Warehouse.Delete(ctx, id, companyId)
+	if err == nil {
+		w.cache.Invalidate(ctx, Tag{entityWarehouse, companyId})
+	}
+
+	return err
+}
+
+func (w *Warehouse) GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Warehouse, error) {
+	return Fetch(ctx, w.cache, Key("warehouse.GetListByCompanyId", id, includeDeleted), []Tag{{entityWarehouse, id}},
+		func(ctx context.Context) ([]domain.Warehouse, error) {
+			return w.Warehouse.GetListByCompanyId(ctx, id, includeDeleted)
+		})
+}
+
+func (w *Warehouse) RefreshOccupancy(ctx context.Context, companyId int64, ids ...int64) ([]domain.WarehouseOccupancy, error) {
+	occupancies, err := w.Warehouse.RefreshOccupancy(ctx, companyId, ids...)
+	if err == nil {
+		w.cache.Invalidate(ctx, Tag{entityWarehouse, companyId})
+	}
+
+	return occupancies, err
+}
+
+func (w *Warehouse) GetDeletedById(ctx context.Context, id, companyId int64) (domain.Warehouse, error) {
+	return w.Warehouse.GetDeletedById(ctx, id, companyId)
+}
+
+func (w *Warehouse) Restore(ctx context.Context, id, companyId int64) error {
+	err := w.Warehouse.Restore(ctx, id, companyId)
+	if err == nil {
+		w.cache.Invalidate(ctx, Tag{entityWarehouse, companyId})
+	}
+
+	return err
+}
+
+// Purge удаляет записи разных компаний, поэтому сбрасывает кэш всех компаний
+func (w *Warehouse) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	n, err := w.Warehouse.Purge(ctx, deletedBefore)
+	if err == nil && n > 0 {
+		w.cache.Invalidate(ctx, Tag{entityWarehouse, 0})
+	}
+
+	return n, err
+}
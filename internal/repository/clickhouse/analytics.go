@@ -0,0 +1,207 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	chgo "github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"strings"
+)
+
+type Analytics interface {
+	InsertMaterialFacts(ctx context.Context, facts []domain.MaterialFact) error
+	InsertMovementFacts(ctx context.Context, facts []domain.MovementFact) error
+
+	GetStockOnDate(ctx context.Context, params domain.StockOnDateParams) ([]domain.StockBalance, error)
+	GetCategoryTurnover(ctx context.Context, params domain.PeriodParams) ([]domain.CategoryTurnover, error)
+	GetSupplierSpend(ctx context.Context, params domain.PeriodParams) ([]domain.SupplierSpend, error)
+}
+
+type AnalyticsClickHouseRepository struct {
+	ch driver.Conn
+}
+
+func NewAnalyticsClickHouseRepository(ch driver.Conn) *AnalyticsClickHouseRepository {
+	return &AnalyticsClickHouseRepository{
+		ch: ch,
+	}
+}
+
+const (
+	tableMaterialEvents = "material_events"
+	tableStockMovements = "stock_movements"
+)
+
+func (ar *AnalyticsClickHouseRepository) InsertMaterialFacts(ctx context.Context, facts []domain.MaterialFact) error {
+	batch, err := ar.ch.PrepareBatch(ctx, "INSERT INTO "+tableMaterialEvents)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range facts {
+		m := f.Material
+		if err = batch.Append(
+			f.EventID, f.EventType, m.CompanyID, m.ID, m.ItemID, m.WarehouseID, m.SupplierID, m.ProductCategory,
+			m.Name, m.Unit, m.Status, m.TotalQuantity, m.PriceWithoutVAT, m.TotalWithoutVAT, string(f.Payload),
+			f.OccurredAt,
+		); err != nil {
+			return fmt.Errorf("failed to append material event: %w", err)
+		}
+	}
+
+	return batch.Send()
+}
+
+func (ar *AnalyticsClickHouseRepository) InsertMovementFacts(ctx context.Context, facts []domain.MovementFact) error {
+	batch, err := ar.ch.PrepareBatch(ctx, "INSERT INTO "+tableStockMovements)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range facts {
+		if err = batch.Append(
+			f.ID, f.CompanyID, f.MaterialID, f.ItemID, f.WarehouseID, f.Type, f.Quantity, f.BalanceAfter,
+			f.Category, f.SupplierID, f.PriceWithoutVAT, f.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to append stock movement: %w", err)
+		}
+	}
+
+	return batch.Send()
+}
+
+// GetStockOnDate остатки товаров по складам как сумма движений до даты
+func (ar *AnalyticsClickHouseRepository) GetStockOnDate(ctx context.Context, params domain.StockOnDateParams) ([]domain.StockBalance, error) {
+	where := []string{"company_id = ?", "created_at < ?"}
+	args := []interface{}{params.CompanyId, params.Date}
+
+	if params.WarehouseId != 0 {
+		where = append(where, "warehouse_id = ?")
+		args = append(args, params.WarehouseId)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT item_id, warehouse_id, any(category), sum(quantity) AS balance, sum(quantity * price_without_vat)
+		FROM %s FINAL
+		WHERE %s
+		GROUP BY item_id, warehouse_id
+		HAVING balance != 0
+		ORDER BY item_id, warehouse_id`,
+		tableStockMovements, strings.Join(where, " AND "))
+
+	rows, err := ar.ch.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows driver.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var balances []domain.StockBalance
+	for rows.Next() {
+		var b domain.StockBalance
+		if err = rows.Scan(&b.ItemID, &b.WarehouseID, &b.Category, &b.Quantity, &b.Value); err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, b)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// GetCategoryTurnover движение остатков по категориям за период. Перемещения между складами компании
+// не считаются ни поступлением, ни отпуском, корректировки меняют только остатки.
+func (ar *AnalyticsClickHouseRepository) GetCategoryTurnover(ctx context.Context, params domain.PeriodParams) ([]domain.CategoryTurnover, error) {
+	query := fmt.Sprintf(`
+		SELECT category,
+		       sumIf(quantity, created_at < @from),
+		       sumIf(quantity, created_at >= @from AND movement_type = '%s'),
+		       -sumIf(quantity, created_at >= @from AND movement_type IN ('%s', '%s')),
+		       sum(quantity)
+		FROM %s FINAL
+		WHERE company_id = @company AND created_at < @to
+		GROUP BY category
+		ORDER BY category`,
+		domain.MovementTypeReceipt, domain.MovementTypeIssue, domain.MovementTypeWriteOff, tableStockMovements)
+
+	rows, err := ar.ch.Query(ctx, query,
+		chgo.Named("company", params.CompanyId), chgo.Named("from", params.From), chgo.Named("to", params.To))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows driver.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var turnover []domain.CategoryTurnover
+	for rows.Next() {
+		var t domain.CategoryTurnover
+		if err = rows.Scan(&t.Category, &t.Opening, &t.Received, &t.Issued, &t.Closing); err != nil {
+			return nil, err
+		}
+
+		if average := float64(t.Opening+t.Closing) / 2; average > 0 {
+			t.Turnover = float64(t.Issued) / average
+		}
+
+		turnover = append(turnover, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return turnover, nil
+}
+
+// GetSupplierSpend закупки по поставщикам и месяцам: купленные партии учитываются в месяце,
+// когда они созданы или переведены из планируемых
+func (ar *AnalyticsClickHouseRepository) GetSupplierSpend(ctx context.Context, params domain.PeriodParams) ([]domain.SupplierSpend, error) {
+	query := fmt.Sprintf(`
+		SELECT supplier_id, toDateTime(toStartOfMonth(occurred_at)) AS month, count(), sum(total_quantity), sum(total_without_vat)
+		FROM %s FINAL
+		WHERE company_id = @company AND occurred_at >= @from AND occurred_at < @to
+		  AND event_type IN ('%s', '%s')
+		GROUP BY supplier_id, month
+		ORDER BY month, supplier_id`,
+		tableMaterialEvents, domain.EventMaterialPurchasedCreated, domain.EventMaterialMovedToPurchased)
+
+	rows, err := ar.ch.Query(ctx, query,
+		chgo.Named("company", params.CompanyId), chgo.Named("from", params.From), chgo.Named("to", params.To))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows driver.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var spend []domain.SupplierSpend
+	for rows.Next() {
+		var s domain.SupplierSpend
+		var lots uint64
+		if err = rows.Scan(&s.SupplierID, &s.Month, &lots, &s.Quantity, &s.Amount); err != nil {
+			return nil, err
+		}
+
+		s.Lots = int64(lots)
+		spend = append(spend, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return spend, nil
+}
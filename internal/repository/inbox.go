@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+)
+
+type Inbox interface {
+	Claim(ctx context.Context, consumer, messageId string) (bool, error)
+}
+
+type InboxRepository struct {
+	cfg  *config.Config
+	psql postgres.Inbox
+}
+
+func NewInboxRepository(cfg *config.Config, db *sql.DB) *InboxRepository {
+	return &InboxRepository{
+		cfg:  cfg,
+		psql: postgres.NewInboxPostgresRepository(db),
+	}
+}
+
+func (ir *InboxRepository) Claim(ctx context.Context, consumer, messageId string) (bool, error) {
+	return ir.psql.Claim(ctx, consumer, messageId)
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type StorageLocations interface {
+	Create(ctx context.Context, location domain.StorageLocation) (int64, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.StorageLocation, error)
+	Update(ctx context.Context, location domain.StorageLocation) error
+	Delete(ctx context.Context, id, companyId int64) error
+	GetList(ctx context.Context, params domain.StorageLocationParams) ([]domain.StorageLocation, error)
+
+	GetPutAwayTarget(ctx context.Context, binId, companyId, materialId int64) (domain.PutAwayTarget, error)
+}
+
+// StorageLocationsRepository не кэшируется: заполненность мест меняется с каждым движением партий
+type StorageLocationsRepository struct {
+	cfg  *config.Config
+	psql postgres.StorageLocations
+}
+
+func NewStorageLocationsRepository(cfg *config.Config, psql *sql.DB) *StorageLocationsRepository {
+	return &StorageLocationsRepository{
+		cfg:  cfg,
+		psql: postgres.NewStorageLocationsPostgresRepository(psql),
+	}
+}
+
+func (lr *StorageLocationsRepository) Create(ctx context.Context, location domain.StorageLocation) (int64, error) {
+	return lr.psql.Create(ctx, location)
+}
+
+func (lr *StorageLocationsRepository) GetById(ctx context.Context, id, companyId int64) (domain.StorageLocation, error) {
+	return lr.psql.GetById(ctx, id, companyId)
+}
+
+func (lr *StorageLocationsRepository) Update(ctx context.Context, location domain.StorageLocation) error {
+	return lr.psql.Update(ctx, location)
+}
+
+func (lr *StorageLocationsRepository) Delete(ctx context.Context, id, companyId int64) error {
+	return lr.psql.Delete(ctx, id, companyId)
+}
+
+func (lr *StorageLocationsRepository) GetList(ctx context.Context, params domain.StorageLocationParams) ([]domain.StorageLocation, error) {
+	return lr.psql.GetList(ctx, params)
+}
+
+func (lr *StorageLocationsRepository) GetPutAwayTarget(ctx context.Context, binId, companyId, materialId int64) (domain.PutAwayTarget, error) {
+	return lr.psql.GetPutAwayTarget(ctx, binId, companyId, materialId)
+}
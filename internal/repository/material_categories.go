@@ -4,8 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/audit"
+	"github.com/rusystem/crm-warehouse/internal/repository/cache"
 	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"time"
 )
 
 type Category interface {
@@ -15,6 +18,15 @@ type Category interface {
 	Delete(ctx context.Context, id, companyId int64) error
 	List(ctx context.Context, param domain.Param) ([]domain.MaterialCategory, error)
 	Search(ctx context.Context, param domain.Param) ([]domain.MaterialCategory, error)
+
+	GetByName(ctx context.Context, name string, companyId int64) (domain.MaterialCategory, error)
+	GetDeletedById(ctx context.Context, id, companyId int64) (domain.MaterialCategory, error)
+	Restore(ctx context.Context, id, companyId int64) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+
+	Move(ctx context.Context, id, companyId, parentId int64) error
+	Reparent(ctx context.Context, fromId, companyId, parentId int64) (int64, error)
+	SlugExists(ctx context.Context, slug string, companyId int64) (bool, error)
 }
 
 type MaterialCategoriesRepository struct {
@@ -22,10 +34,10 @@ type MaterialCategoriesRepository struct {
 	psql postgres.Category
 }
 
-func NewMaterialCategoriesRepository(cfg *config.Config, db *sql.DB) *MaterialCategoriesRepository {
+func NewMaterialCategoriesRepository(cfg *config.Config, db *sql.DB, c *cache.Cache, log *audit.Log) *MaterialCategoriesRepository {
 	return &MaterialCategoriesRepository{
 		cfg:  cfg,
-		psql: postgres.NewMaterialCategoriesPostgresRepository(db),
+		psql: cache.NewCategory(audit.NewCategory(postgres.NewMaterialCategoriesPostgresRepository(db), log), c),
 	}
 }
 
@@ -37,6 +49,10 @@ func (mc *MaterialCategoriesRepository) GetById(ctx context.Context, id, company
 	return mc.psql.GetById(ctx, id, companyId)
 }
 
+func (mc *MaterialCategoriesRepository) GetByName(ctx context.Context, name string, companyId int64) (domain.MaterialCategory, error) {
+	return mc.psql.GetByName(ctx, name, companyId)
+}
+
 func (mc *MaterialCategoriesRepository) Update(ctx context.Context, category domain.MaterialCategory) error {
 	return mc.psql.Update(ctx, category)
 }
@@ -52,3 +68,27 @@ func (mc *MaterialCategoriesRepository) List(ctx context.Context, param domain.P
 func (mc *MaterialCategoriesRepository) Search(ctx context.Context, param domain.Param) ([]domain.MaterialCategory, error) {
 	return mc.psql.Search(ctx, param)
 }
+
+func (mc *MaterialCategoriesRepository) GetDeletedById(ctx context.Context, id, companyId int64) (domain.MaterialCategory, error) {
+	return mc.psql.GetDeletedById(ctx, id, companyId)
+}
+
+func (mc *MaterialCategoriesRepository) Restore(ctx context.Context, id, companyId int64) error {
+	return mc.psql.Restore(ctx, id, companyId)
+}
+
+func (mc *MaterialCategoriesRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	return mc.psql.Purge(ctx, deletedBefore)
+}
+
+func (mc *MaterialCategoriesRepository) Move(ctx context.Context, id, companyId, parentId int64) error {
+	return mc.psql.Move(ctx, id, companyId, parentId)
+}
+
+func (mc *MaterialCategoriesRepository) Reparent(ctx context.Context, fromId, companyId, parentId int64) (int64, error) {
+	return mc.psql.Reparent(ctx, fromId, companyId, parentId)
+}
+
+func (mc *MaterialCategoriesRepository) SlugExists(ctx context.Context, slug string, companyId int64) (bool, error) {
+	return mc.psql.SlugExists(ctx, slug, companyId)
+}
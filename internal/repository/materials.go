@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/audit"
+	"github.com/rusystem/crm-warehouse/internal/repository/cache"
 	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
 )
@@ -11,26 +13,33 @@ import (
 type Materials interface {
 	CreatePlanning(ctx context.Context, material domain.Material) (int64, error)
 	UpdatePlanning(ctx context.Context, material domain.Material) error
-	DeletePlanning(ctx context.Context, id int64) error
-	GetPlanningById(ctx context.Context, id int64) (domain.Material, error)
-	GetPlanningList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error)
-	MovePlanningToPurchased(ctx context.Context, id int64) (int64, int64, error)
+	DeletePlanning(ctx context.Context, id, companyId int64) error
+	GetPlanningById(ctx context.Context, id, companyId int64) (domain.Material, error)
+	GetPlanningList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error)
+	MovePlanningToPurchased(ctx context.Context, id, companyId int64) (int64, int64, error)
 
 	CreatePurchased(ctx context.Context, material domain.Material) (int64, int64, error)
 	UpdatePurchased(ctx context.Context, material domain.Material) error
-	DeletePurchased(ctx context.Context, id int64) error
-	GetPurchasedById(ctx context.Context, id int64) (domain.Material, error)
-	GetPurchasedList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error)
-	MovePurchasedToArchive(ctx context.Context, id int64) error
-
-	GetPlanningArchiveById(ctx context.Context, id int64) (domain.Material, error)
-	GetPurchasedArchiveById(ctx context.Context, id int64) (domain.Material, error)
-	GetPlanningArchiveList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error)
-	GetPurchasedArchiveList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error)
-	DeletePlanningArchive(ctx context.Context, id int64) error
-	DeletePurchasedArchive(ctx context.Context, id int64) error
+	DeletePurchased(ctx context.Context, id, companyId int64) error
+	GetPurchasedById(ctx context.Context, id, companyId int64) (domain.Material, error)
+	GetPurchasedList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error)
+	MovePurchasedToArchive(ctx context.Context, id, companyId int64) error
+	PutAway(ctx context.Context, id, companyId, binId int64, section, location string) error
+
+	GetPlanningArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error)
+	GetPurchasedArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error)
+	GetPlanningArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error)
+	GetPurchasedArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error)
+	DeletePlanningArchive(ctx context.Context, id, companyId int64) error
+	DeletePurchasedArchive(ctx context.Context, id, companyId int64) error
 
 	Search(ctx context.Context, param domain.Param) ([]domain.Material, error)
+
+	GetExpiring(ctx context.Context, params domain.ExpirationParams) ([]domain.Material, error)
+	QuarantineExpired(ctx context.Context, companyId int64) (int64, error)
+	GetFefoLots(ctx context.Context, companyId, itemId, warehouseId int64) ([]domain.FefoPick, error)
+
+	GetReferencing(ctx context.Context, ref domain.MaterialReference) (domain.ReferencingMaterials, error)
 }
 
 type MaterialsRepository struct {
@@ -38,10 +47,10 @@ type MaterialsRepository struct {
 	psql postgres.Materials
 }
 
-func NewMaterialsRepository(cfg *config.Config, db *sql.DB) *MaterialsRepository {
+func NewMaterialsRepository(cfg *config.Config, db *sql.DB, c *cache.Cache, log *audit.Log) *MaterialsRepository {
 	return &MaterialsRepository{
 		cfg:  cfg,
-		psql: postgres.NewMaterialsPostgresRepository(db),
+		psql: cache.NewMaterials(audit.NewMaterials(postgres.NewMaterialsPostgresRepository(db), log), c),
 	}
 }
 
@@ -53,20 +62,20 @@ func (mr *MaterialsRepository) UpdatePlanning(ctx context.Context, material doma
 	return mr.psql.UpdatePlanning(ctx, material)
 }
 
-func (mr *MaterialsRepository) DeletePlanning(ctx context.Context, id int64) error {
-	return mr.psql.DeletePlanning(ctx, id)
+func (mr *MaterialsRepository) DeletePlanning(ctx context.Context, id, companyId int64) error {
+	return mr.psql.DeletePlanning(ctx, id, companyId)
 }
 
-func (mr *MaterialsRepository) GetPlanningById(ctx context.Context, id int64) (domain.Material, error) {
-	return mr.psql.GetPlanningById(ctx, id)
+func (mr *MaterialsRepository) GetPlanningById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return mr.psql.GetPlanningById(ctx, id, companyId)
 }
 
-func (mr *MaterialsRepository) GetPlanningList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error) {
+func (mr *MaterialsRepository) GetPlanningList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
 	return mr.psql.GetPlanningList(ctx, params)
 }
 
-func (mr *MaterialsRepository) MovePlanningToPurchased(ctx context.Context, id int64) (int64, int64, error) {
-	return mr.psql.MovePlanningToPurchased(ctx, id)
+func (mr *MaterialsRepository) MovePlanningToPurchased(ctx context.Context, id, companyId int64) (int64, int64, error) {
+	return mr.psql.MovePlanningToPurchased(ctx, id, companyId)
 }
 
 func (mr *MaterialsRepository) CreatePurchased(ctx context.Context, material domain.Material) (int64, int64, error) {
@@ -77,46 +86,66 @@ func (mr *MaterialsRepository) UpdatePurchased(ctx context.Context, material dom
 	return mr.psql.UpdatePurchased(ctx, material)
 }
 
-func (mr *MaterialsRepository) DeletePurchased(ctx context.Context, id int64) error {
-	return mr.psql.DeletePurchased(ctx, id)
+func (mr *MaterialsRepository) DeletePurchased(ctx context.Context, id, companyId int64) error {
+	return mr.psql.DeletePurchased(ctx, id, companyId)
 }
 
-func (mr *MaterialsRepository) GetPurchasedById(ctx context.Context, id int64) (domain.Material, error) {
-	return mr.psql.GetPurchasedById(ctx, id)
+func (mr *MaterialsRepository) GetPurchasedById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return mr.psql.GetPurchasedById(ctx, id, companyId)
 }
 
-func (mr *MaterialsRepository) GetPurchasedList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error) {
+func (mr *MaterialsRepository) GetPurchasedList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
 	return mr.psql.GetPurchasedList(ctx, params)
 }
 
-func (mr *MaterialsRepository) MovePurchasedToArchive(ctx context.Context, id int64) error {
-	return mr.psql.MovePurchasedToArchive(ctx, id)
+func (mr *MaterialsRepository) MovePurchasedToArchive(ctx context.Context, id, companyId int64) error {
+	return mr.psql.MovePurchasedToArchive(ctx, id, companyId)
+}
+
+func (mr *MaterialsRepository) PutAway(ctx context.Context, id, companyId, binId int64, section, location string) error {
+	return mr.psql.PutAway(ctx, id, companyId, binId, section, location)
 }
 
-func (mr *MaterialsRepository) GetPlanningArchiveById(ctx context.Context, id int64) (domain.Material, error) {
-	return mr.psql.GetPlanningArchiveById(ctx, id)
+func (mr *MaterialsRepository) GetPlanningArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return mr.psql.GetPlanningArchiveById(ctx, id, companyId)
 }
 
-func (mr *MaterialsRepository) GetPurchasedArchiveById(ctx context.Context, id int64) (domain.Material, error) {
-	return mr.psql.GetPurchasedArchiveById(ctx, id)
+func (mr *MaterialsRepository) GetPurchasedArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return mr.psql.GetPurchasedArchiveById(ctx, id, companyId)
 }
 
-func (mr *MaterialsRepository) GetPlanningArchiveList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error) {
+func (mr *MaterialsRepository) GetPlanningArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
 	return mr.psql.GetPlanningArchiveList(ctx, params)
 }
 
-func (mr *MaterialsRepository) GetPurchasedArchiveList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error) {
+func (mr *MaterialsRepository) GetPurchasedArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
 	return mr.psql.GetPurchasedArchiveList(ctx, params)
 }
 
-func (mr *MaterialsRepository) DeletePlanningArchive(ctx context.Context, id int64) error {
-	return mr.psql.DeletePlanningArchive(ctx, id)
+func (mr *MaterialsRepository) DeletePlanningArchive(ctx context.Context, id, companyId int64) error {
+	return mr.psql.DeletePlanningArchive(ctx, id, companyId)
 }
 
-func (mr *MaterialsRepository) DeletePurchasedArchive(ctx context.Context, id int64) error {
-	return mr.psql.DeletePurchasedArchive(ctx, id)
+func (mr *MaterialsRepository) DeletePurchasedArchive(ctx context.Context, id, companyId int64) error {
+	return mr.psql.DeletePurchasedArchive(ctx, id, companyId)
 }
 
 func (mr *MaterialsRepository) Search(ctx context.Context, param domain.Param) ([]domain.Material, error) {
 	return mr.psql.Search(ctx, param)
 }
+
+func (mr *MaterialsRepository) GetExpiring(ctx context.Context, params domain.ExpirationParams) ([]domain.Material, error) {
+	return mr.psql.GetExpiring(ctx, params)
+}
+
+func (mr *MaterialsRepository) QuarantineExpired(ctx context.Context, companyId int64) (int64, error) {
+	return mr.psql.QuarantineExpired(ctx, companyId)
+}
+
+func (mr *MaterialsRepository) GetFefoLots(ctx context.Context, companyId, itemId, warehouseId int64) ([]domain.FefoPick, error) {
+	return mr.psql.GetFefoLots(ctx, companyId, itemId, warehouseId)
+}
+
+func (mr *MaterialsRepository) GetReferencing(ctx context.Context, ref domain.MaterialReference) (domain.ReferencingMaterials, error) {
+	return mr.psql.GetReferencing(ctx, ref)
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/cache"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Movements interface {
+	Create(ctx context.Context, req domain.MovementRequest) ([]domain.Movement, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.Movement, error)
+	GetList(ctx context.Context, params domain.MovementParams) ([]domain.Movement, error)
+	GetStockOnHand(ctx context.Context, params domain.StockParams) (int64, error)
+}
+
+type MovementsRepository struct {
+	cfg  *config.Config
+	psql postgres.Movements
+}
+
+func NewMovementsRepository(cfg *config.Config, db *sql.DB, c *cache.Cache) *MovementsRepository {
+	return &MovementsRepository{
+		cfg:  cfg,
+		psql: cache.NewMovements(postgres.NewMovementsPostgresRepository(db), c),
+	}
+}
+
+func (mr *MovementsRepository) Create(ctx context.Context, req domain.MovementRequest) ([]domain.Movement, error) {
+	return mr.psql.Create(ctx, req)
+}
+
+func (mr *MovementsRepository) GetById(ctx context.Context, id, companyId int64) (domain.Movement, error) {
+	return mr.psql.GetById(ctx, id, companyId)
+}
+
+func (mr *MovementsRepository) GetList(ctx context.Context, params domain.MovementParams) ([]domain.Movement, error) {
+	return mr.psql.GetList(ctx, params)
+}
+
+func (mr *MovementsRepository) GetStockOnHand(ctx context.Context, params domain.StockParams) (int64, error) {
+	return mr.psql.GetStockOnHand(ctx, params)
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"time"
+)
+
+type Outbox interface {
+	Add(ctx context.Context, event domain.Event) (int64, error)
+	PublishPending(ctx context.Context, limit int64, publish func(events []domain.Event) error) (int, error)
+	DeleteProcessed(ctx context.Context, before time.Time, exported bool) (int64, error)
+}
+
+type OutboxRepository struct {
+	cfg  *config.Config
+	psql postgres.Outbox
+}
+
+func NewOutboxRepository(cfg *config.Config, db *sql.DB) *OutboxRepository {
+	return &OutboxRepository{
+		cfg:  cfg,
+		psql: postgres.NewOutboxPostgresRepository(db),
+	}
+}
+
+func (or *OutboxRepository) Add(ctx context.Context, event domain.Event) (int64, error) {
+	return or.psql.Add(ctx, event)
+}
+
+func (or *OutboxRepository) PublishPending(ctx context.Context, limit int64, publish func(events []domain.Event) error) (int, error) {
+	return or.psql.PublishPending(ctx, limit, publish)
+}
+
+func (or *OutboxRepository) DeleteProcessed(ctx context.Context, before time.Time, exported bool) (int64, error) {
+	return or.psql.DeleteProcessed(ctx, before, exported)
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Alerts interface {
+	RaiseLowStock(ctx context.Context) (int64, error)
+	ResolveLowStock(ctx context.Context) (int64, error)
+	GetUndelivered(ctx context.Context) ([]domain.LowStockAlert, error)
+	MarkChannelNotified(ctx context.Context, id int64, channel string) error
+	MarkNotified(ctx context.Context, id int64) error
+}
+
+type AlertsPostgresRepository struct {
+	psql *sql.DB
+}
+
+func NewAlertsPostgresRepository(psql *sql.DB) *AlertsPostgresRepository {
+	return &AlertsPostgresRepository{
+		psql: psql,
+	}
+}
+
+// stockLevels - остаток товара по складам для товаров с заданным минимальным уровнем запаса
+var stockLevels = fmt.Sprintf(`
+	SELECT company_id, warehouse_id, item_id, MAX(name) AS name,
+	       SUM(total_quantity) AS quantity, MAX(min_stock_level) AS min_stock_level
+	FROM %s
+	WHERE item_id <> 0
+	GROUP BY company_id, warehouse_id, item_id
+	HAVING MAX(min_stock_level) > 0`,
+	domain.TablePurchasedMaterials)
+
+// RaiseLowStock открывает уведомления для товаров, остаток которых ниже минимального.
+// Пока уведомление открыто, повторно оно не создается. Рассылка - по GetUndelivered.
+func (ar *AlertsPostgresRepository) RaiseLowStock(ctx context.Context) (int64, error) {
+	query := fmt.Sprintf(`
+		WITH levels AS (%s)
+		INSERT INTO %s (company_id, warehouse_id, item_id, name, quantity, min_stock_level)
+		SELECT company_id, warehouse_id, item_id, name, quantity, min_stock_level
+		FROM levels WHERE quantity < min_stock_level
+		ON CONFLICT (company_id, warehouse_id, item_id) WHERE resolved_at IS NULL DO NOTHING`,
+		stockLevels, domain.TableLowStockAlerts)
+
+	res, err := ar.psql.ExecContext(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert low stock alerts: %w", dbError(err))
+	}
+
+	return res.RowsAffected()
+}
+
+// GetUndelivered открытые уведомления, которые еще не доставлены: новые и те, чья рассылка не удалась
+func (ar *AlertsPostgresRepository) GetUndelivered(ctx context.Context) ([]domain.LowStockAlert, error) {
+	query := fmt.Sprintf(`
+		SELECT id, company_id, warehouse_id, item_id, name, quantity, min_stock_level, raised_at,
+		       telegram_notified_at IS NOT NULL, nats_notified_at IS NOT NULL
+		FROM %s WHERE resolved_at IS NULL AND notified_at IS NULL
+		ORDER BY id`,
+		domain.TableLowStockAlerts)
+
+	rows, err := ar.psql.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var alerts []domain.LowStockAlert
+	for rows.Next() {
+		var a domain.LowStockAlert
+		if err = rows.Scan(
+			&a.ID, &a.CompanyID, &a.WarehouseID, &a.ItemID, &a.Name, &a.Quantity, &a.MinStockLevel, &a.RaisedAt,
+			&a.TelegramNotified, &a.NatsNotified,
+		); err != nil {
+			return nil, err
+		}
+
+		alerts = append(alerts, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+// alertChannelColumns - время доставки уведомления по каналу рассылки
+var alertChannelColumns = map[string]string{
+	domain.AlertChannelTelegram: "telegram_notified_at",
+	domain.AlertChannelNats:     "nats_notified_at",
+}
+
+// MarkChannelNotified отмечает доставку уведомления по каналу, повторная рассылка его пропускает
+func (ar *AlertsPostgresRepository) MarkChannelNotified(ctx context.Context, id int64, channel string) error {
+	column, ok := alertChannelColumns[channel]
+	if !ok {
+		return fmt.Errorf("unknown alert channel %q", channel)
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET %s = CURRENT_TIMESTAMP WHERE id = $1", domain.TableLowStockAlerts, column)
+
+	if _, err := ar.psql.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to update low stock alert: %w", dbError(err))
+	}
+
+	return nil
+}
+
+// MarkNotified отмечает уведомление доставленным по всем каналам, повторно оно не рассылается
+func (ar *AlertsPostgresRepository) MarkNotified(ctx context.Context, id int64) error {
+	query := fmt.Sprintf("UPDATE %s SET notified_at = CURRENT_TIMESTAMP WHERE id = $1", domain.TableLowStockAlerts)
+
+	if _, err := ar.psql.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to update low stock alert: %w", dbError(err))
+	}
+
+	return nil
+}
+
+// ResolveLowStock закрывает уведомления, остаток по которым восстановился или минимальный уровень снят
+func (ar *AlertsPostgresRepository) ResolveLowStock(ctx context.Context) (int64, error) {
+	query := fmt.Sprintf(`
+		WITH levels AS (%s)
+		UPDATE %s a SET resolved_at = CURRENT_TIMESTAMP
+		WHERE a.resolved_at IS NULL
+		  AND NOT EXISTS (
+		      SELECT 1 FROM levels l
+		      WHERE l.company_id = a.company_id AND l.warehouse_id = a.warehouse_id AND l.item_id = a.item_id
+		        AND l.quantity < l.min_stock_level)`,
+		stockLevels, domain.TableLowStockAlerts)
+
+	res, err := ar.psql.ExecContext(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update low stock alerts: %w", dbError(err))
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type AnalyticsFeed interface {
+	ExportMaterialEvents(ctx context.Context, limit int64, export func(events []domain.Event) error) (int, error)
+	ExportMovements(ctx context.Context, limit int64, export func(movements []domain.MovementFact) error) (int, error)
+}
+
+type AnalyticsFeedPostgresRepository struct {
+	psql *sql.DB
+}
+
+func NewAnalyticsFeedPostgresRepository(psql *sql.DB) *AnalyticsFeedPostgresRepository {
+	return &AnalyticsFeedPostgresRepository{
+		psql: psql,
+	}
+}
+
+const feedMovements = "stock_movements"
+
+// ExportMaterialEvents передает export пачку еще не выгруженных событий материалов и отмечает их выгруженными.
+// Как и при публикации, пачка блокируется до конца транзакции, при ошибке export события выгрузятся позже.
+func (ar *AnalyticsFeedPostgresRepository) ExportMaterialEvents(ctx context.Context, limit int64, export func(events []domain.Event) error) (int, error) {
+	tx, err := ar.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func(tx *sql.Tx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	query := fmt.Sprintf(`
+		SELECT id, event_type, version, company_id, entity_id, payload, occurred_at
+		FROM %s WHERE exported_at IS NULL AND event_type LIKE 'material.%%'
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`,
+		domain.TableEventOutbox)
+
+	rows, err := tx.QueryContext(ctx, query, limit)
+	if err != nil {
+		return 0, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var events []domain.Event
+	var ids []int64
+	for rows.Next() {
+		var e domain.Event
+		if err = rows.Scan(&e.ID, &e.Type, &e.Version, &e.CompanyID, &e.EntityID, &e.Data, &e.OccurredAt); err != nil {
+			return 0, err
+		}
+
+		events = append(events, e)
+		ids = append(ids, e.ID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	if err = export(events); err != nil {
+		return 0, err
+	}
+
+	query = fmt.Sprintf("UPDATE %s SET exported_at = CURRENT_TIMESTAMP WHERE id = ANY($1)", domain.TableEventOutbox)
+
+	if _, err = tx.ExecContext(ctx, query, pq.Array(ids)); err != nil {
+		return 0, fmt.Errorf("failed to update outbox events: %w", dbError(err))
+	}
+
+	return len(events), tx.Commit()
+}
+
+// ExportMovements передает export пачку записей журнала движений после курсора и сдвигает курсор.
+// Записи журнала не изменяются, поэтому выгрузка ведется по id: выгружаются только записи не выше
+// границы, до которой все транзакции с движениями уже зафиксированы.
+func (ar *AnalyticsFeedPostgresRepository) ExportMovements(ctx context.Context, limit int64, export func(movements []domain.MovementFact) error) (int, error) {
+	watermark, err := ar.movementsWatermark(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := ar.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func(tx *sql.Tx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	// курсор блокируется, поэтому несколько экземпляров сервиса не выгружают одну пачку
+	query := fmt.Sprintf(`
+		INSERT INTO %s (feed) VALUES ($1) ON CONFLICT (feed) DO NOTHING`,
+		domain.TableAnalyticsCursors)
+
+	if _, err = tx.ExecContext(ctx, query, feedMovements); err != nil {
+		return 0, fmt.Errorf("failed to insert analytics cursor: %w", dbError(err))
+	}
+
+	var lastId int64
+	query = fmt.Sprintf("SELECT last_id FROM %s WHERE feed = $1 FOR UPDATE", domain.TableAnalyticsCursors)
+	if err = tx.QueryRowContext(ctx, query, feedMovements).Scan(&lastId); err != nil {
+		return 0, err
+	}
+
+	query = fmt.Sprintf(`
+		SELECT s.id, s.company_id, s.material_id, s.item_id, s.warehouse_id, s.movement_type, s.quantity, s.balance_after,
+		       COALESCE(s.related_movement_id, 0), s.reference, s.comment, s.created_by, s.created_at,
+		       COALESCE(p.product_category, ''), COALESCE(p.supplier_id, 0), COALESCE(p.price_without_vat, 0)
+		FROM %s s
+		LEFT JOIN %s p ON p.id = s.material_id
+		WHERE s.id > $1 AND s.id <= $2
+		ORDER BY s.id
+		LIMIT $3`,
+		domain.TableStockMovements, domain.TablePurchasedMaterials)
+
+	rows, err := tx.QueryContext(ctx, query, lastId, watermark, limit)
+	if err != nil {
+		return 0, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var movements []domain.MovementFact
+	for rows.Next() {
+		var m domain.MovementFact
+		if err = rows.Scan(
+			&m.ID, &m.CompanyID, &m.MaterialID, &m.ItemID, &m.WarehouseID, &m.Type, &m.Quantity, &m.BalanceAfter,
+			&m.RelatedMovementID, &m.Reference, &m.Comment, &m.CreatedBy, &m.CreatedAt,
+			&m.Category, &m.SupplierID, &m.PriceWithoutVAT,
+		); err != nil {
+			return 0, err
+		}
+
+		movements = append(movements, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	if len(movements) == 0 {
+		return 0, nil
+	}
+
+	if err = export(movements); err != nil {
+		return 0, err
+	}
+
+	query = fmt.Sprintf("UPDATE %s SET last_id = $1, updated_at = CURRENT_TIMESTAMP WHERE feed = $2",
+		domain.TableAnalyticsCursors)
+
+	if _, err = tx.ExecContext(ctx, query, movements[len(movements)-1].ID, feedMovements); err != nil {
+		return 0, fmt.Errorf("failed to update analytics cursor: %w", dbError(err))
+	}
+
+	return len(movements), tx.Commit()
+}
+
+// movementsWatermark - наибольший id журнала, ниже которого нет незафиксированных записей.
+// Блокировка SHARE дожидается завершения транзакций, пишущих в журнал, и сразу снимается,
+// следующие записи получат id больше границы.
+func (ar *AnalyticsFeedPostgresRepository) movementsWatermark(ctx context.Context) (int64, error) {
+	tx, err := ar.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func(tx *sql.Tx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	// долгая транзакция с движениями не должна останавливать запись через очередь блокировок
+	if _, err = tx.ExecContext(ctx, "SET LOCAL lock_timeout = '1s'"); err != nil {
+		return 0, err
+	}
+
+	if _, err = tx.ExecContext(ctx, fmt.Sprintf("LOCK TABLE %s IN SHARE MODE", domain.TableStockMovements)); err != nil {
+		return 0, err
+	}
+
+	var watermark int64
+	query := fmt.Sprintf("SELECT COALESCE(MAX(id), 0) FROM %s", domain.TableStockMovements)
+	if err = tx.QueryRowContext(ctx, query).Scan(&watermark); err != nil {
+		return 0, err
+	}
+
+	return watermark, tx.Commit()
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"strings"
+)
+
+type Audit interface {
+	Add(ctx context.Context, entry domain.AuditEntry) (int64, error)
+	GetList(ctx context.Context, params domain.AuditParams) ([]domain.AuditEntry, error)
+}
+
+type AuditPostgresRepository struct {
+	psql *sql.DB
+}
+
+func NewAuditPostgresRepository(psql *sql.DB) *AuditPostgresRepository {
+	return &AuditPostgresRepository{
+		psql: psql,
+	}
+}
+
+// Add сохраняет запись журнала. Вызывается внутри WithinTx, чтобы запись фиксировалась вместе с изменением.
+func (ar *AuditPostgresRepository) Add(ctx context.Context, entry domain.AuditEntry) (int64, error) {
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal audit changes to JSON: %v", err)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (company_id, actor_id, method, entity_type, entity_id, action, changes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`,
+		domain.TableAuditLog)
+
+	var id int64
+	if err = conn(ctx, ar.psql).QueryRowContext(ctx, query,
+		entry.CompanyID, entry.ActorID, entry.Method, entry.EntityType, entry.EntityID, entry.Action, changes,
+	).Scan(&id); err != nil {
+		return 0, fmt.Errorf("failed to insert audit entry: %w", dbError(err))
+	}
+
+	return id, nil
+}
+
+// GetList возвращает записи журнала компании от новых к старым
+func (ar *AuditPostgresRepository) GetList(ctx context.Context, params domain.AuditParams) ([]domain.AuditEntry, error) {
+	where := []string{"company_id = $1"}
+	args := []interface{}{params.CompanyId}
+
+	if params.ActorId != 0 {
+		args = append(args, params.ActorId)
+		where = append(where, fmt.Sprintf("actor_id = $%d", len(args)))
+	}
+
+	if params.EntityType != "" {
+		args = append(args, params.EntityType)
+		where = append(where, fmt.Sprintf("entity_type = $%d", len(args)))
+	}
+
+	if params.EntityId != 0 {
+		args = append(args, params.EntityId)
+		where = append(where, fmt.Sprintf("entity_id = $%d", len(args)))
+	}
+
+	if params.Method != "" {
+		args = append(args, params.Method)
+		where = append(where, fmt.Sprintf("method = $%d", len(args)))
+	}
+
+	if params.Action != "" {
+		args = append(args, params.Action)
+		where = append(where, fmt.Sprintf("action = $%d", len(args)))
+	}
+
+	if !params.From.IsZero() {
+		args = append(args, params.From)
+		where = append(where, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+
+	if !params.To.IsZero() {
+		args = append(args, params.To)
+		where = append(where, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+
+	args = append(args, params.Limit, params.Offset)
+
+	query := fmt.Sprintf(`
+		SELECT id, company_id, actor_id, method, entity_type, entity_id, action, changes, created_at
+		FROM %s WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d`,
+		domain.TableAuditLog, strings.Join(where, " AND "), len(args)-1, len(args))
+
+	rows, err := conn(ctx, ar.psql).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var entries []domain.AuditEntry
+	for rows.Next() {
+		var e domain.AuditEntry
+		var changes []byte
+
+		if err = rows.Scan(&e.ID, &e.CompanyID, &e.ActorID, &e.Method, &e.EntityType, &e.EntityID, &e.Action,
+			&changes, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		if err = json.Unmarshal(changes, &e.Changes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal audit changes: %v", err)
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"strings"
+)
+
+// Коды ошибок postgres, которые переводятся в доменные ошибки
+const (
+	pqUniqueViolation     = "23505"
+	pqForeignKeyViolation = "23503"
+	pqNotNullViolation    = "23502"
+	pqCheckViolation      = "23514"
+	pqInvalidText         = "22P02"
+	pqNumericOutOfRange   = "22003"
+)
+
+// dbError переводит нарушение ограничений БД в доменную ошибку, остальные ошибки возвращает как есть
+func dbError(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch pqErr.Code {
+	case pqUniqueViolation:
+		return fmt.Errorf("%w: %s", domain.ErrAlreadyExists, pqErr.Message)
+	case pqForeignKeyViolation:
+		// при удалении - на запись ссылаются, при вставке - ссылка на несуществующую запись
+		if strings.Contains(pqErr.Detail, "is still referenced") {
+			return fmt.Errorf("%w: %s", domain.ErrReferenced, pqErr.Message)
+		}
+
+		return fmt.Errorf("%w: %s", domain.ErrInvalidArgument, pqErr.Message)
+	case pqNotNullViolation, pqCheckViolation, pqInvalidText, pqNumericOutOfRange:
+		return fmt.Errorf("%w: %s", domain.ErrInvalidArgument, pqErr.Message)
+	}
+
+	return err
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"time"
+)
+
+// hasExpiration - срок годности задан, незаполненные даты сохранялись как начало эпохи или нулевое время
+const hasExpiration = "expiration_date > TIMESTAMP 'epoch'"
+
+// GetExpiring возвращает партии с остатком, срок годности которых истекает в пределах окна, включая уже просроченные
+func (mr *MaterialsPostgresRepository) GetExpiring(ctx context.Context, params domain.ExpirationParams) ([]domain.Material, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE company_id = $1 AND ($2::BIGINT = 0 OR warehouse_id = $2)
+		  AND %s AND expiration_date <= $3 AND status <> $4 AND total_quantity > 0
+		ORDER BY expiration_date, id
+		LIMIT $5 OFFSET $6`,
+		purchasedColumns, domain.TablePurchasedMaterials, hasExpiration)
+
+	rows, err := conn(ctx, mr.psql).QueryContext(ctx, query, params.CompanyId, params.WarehouseId, time.Now().Add(params.Within),
+		domain.MaterialStatusQuarantine, params.Limit, params.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var materials []domain.Material
+
+	for rows.Next() {
+		material, err := scanPurchased(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		materials = append(materials, material)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return materials, nil
+}
+
+// expiredLots - просроченные партии вне карантина, $1 - статус карантина, $2 - компания или 0
+var expiredLots = fmt.Sprintf("($2::BIGINT = 0 OR company_id = $2) AND %s AND expiration_date <= CURRENT_TIMESTAMP AND status <> $1",
+	hasExpiration)
+
+// GetExpired возвращает партии, которые переведет в карантин QuarantineExpired, и блокирует их до конца транзакции
+func (mr *MaterialsPostgresRepository) GetExpired(ctx context.Context, companyId int64) ([]domain.Material, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s ORDER BY id FOR UPDATE",
+		purchasedColumns, domain.TablePurchasedMaterials, expiredLots)
+
+	rows, err := conn(ctx, mr.psql).QueryContext(ctx, query, domain.MaterialStatusQuarantine, companyId)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var materials []domain.Material
+
+	for rows.Next() {
+		material, err := scanPurchased(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		materials = append(materials, material)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return materials, nil
+}
+
+// QuarantineExpired переводит просроченные партии в карантин, companyId = 0 - по всем компаниям
+func (mr *MaterialsPostgresRepository) QuarantineExpired(ctx context.Context, companyId int64) (int64, error) {
+	query := fmt.Sprintf("UPDATE %s SET status = $1, last_updated = CURRENT_TIMESTAMP WHERE %s",
+		domain.TablePurchasedMaterials, expiredLots)
+
+	res, err := conn(ctx, mr.psql).ExecContext(ctx, query, domain.MaterialStatusQuarantine, companyId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update purchased material: %w", dbError(err))
+	}
+
+	return res.RowsAffected()
+}
+
+// GetFefoLots возвращает пригодные к отпуску партии товара в порядке FEFO:
+// сначала с ближайшим сроком годности, партии без срока - последними
+func (mr *MaterialsPostgresRepository) GetFefoLots(ctx context.Context, companyId, itemId, warehouseId int64) ([]domain.FefoPick, error) {
+	query := fmt.Sprintf(`
+		SELECT m.id, m.warehouse_id, m.expiration_date,
+		       m.total_quantity - COALESCE((SELECT SUM(quantity - consumed_quantity) FROM %s WHERE material_id = m.id AND %s), 0)
+		FROM %s m
+		WHERE m.company_id = $1 AND m.item_id = $2 AND ($3::BIGINT = 0 OR m.warehouse_id = $3)
+		  AND m.status <> $4 AND m.total_quantity > 0
+		  AND NOT (m.%s AND m.expiration_date <= CURRENT_TIMESTAMP)
+		ORDER BY CASE WHEN m.%s THEN 0 ELSE 1 END, m.expiration_date, m.received_date, m.id`,
+		domain.TableReservations, activeReservation, domain.TablePurchasedMaterials, hasExpiration, hasExpiration)
+
+	rows, err := conn(ctx, mr.psql).QueryContext(ctx, query, companyId, itemId, warehouseId, domain.MaterialStatusQuarantine)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var lots []domain.FefoPick
+	for rows.Next() {
+		var lot domain.FefoPick
+		if err = rows.Scan(&lot.MaterialID, &lot.WarehouseID, &lot.ExpirationDate, &lot.Available); err != nil {
+			return nil, err
+		}
+
+		if lot.ExpirationDate.Unix() <= 0 {
+			lot.ExpirationDate = time.Time{}
+		}
+
+		lots = append(lots, lot)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return lots, nil
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Inbox interface {
+	Claim(ctx context.Context, consumer, messageId string) (bool, error)
+}
+
+type InboxPostgresRepository struct {
+	psql *sql.DB
+}
+
+func NewInboxPostgresRepository(psql *sql.DB) *InboxPostgresRepository {
+	return &InboxPostgresRepository{
+		psql: psql,
+	}
+}
+
+// Claim отмечает сообщение обработанным, false - сообщение уже обработано ранее.
+// Вызывается внутри WithinTx: при откате обработки отметка тоже откатывается.
+func (ir *InboxPostgresRepository) Claim(ctx context.Context, consumer, messageId string) (bool, error) {
+	query := fmt.Sprintf("INSERT INTO %s (consumer, message_id) VALUES ($1, $2) ON CONFLICT DO NOTHING",
+		domain.TableInboxMessages)
+
+	res, err := conn(ctx, ir.psql).ExecContext(ctx, query, consumer, messageId)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert inbox message: %w", dbError(err))
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n == 1, nil
+}
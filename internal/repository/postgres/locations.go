@@ -0,0 +1,304 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"strings"
+)
+
+type StorageLocations interface {
+	Create(ctx context.Context, location domain.StorageLocation) (int64, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.StorageLocation, error)
+	Update(ctx context.Context, location domain.StorageLocation) error
+	Delete(ctx context.Context, id, companyId int64) error
+	GetList(ctx context.Context, params domain.StorageLocationParams) ([]domain.StorageLocation, error)
+
+	GetPutAwayTarget(ctx context.Context, binId, companyId, materialId int64) (domain.PutAwayTarget, error)
+}
+
+type StorageLocationsPostgresRepository struct {
+	psql *sql.DB
+}
+
+func NewStorageLocationsPostgresRepository(psql *sql.DB) *StorageLocationsPostgresRepository {
+	return &StorageLocationsPostgresRepository{
+		psql: psql,
+	}
+}
+
+// locationColumns - колонки места l, заполненность считается по купленным партиям во всех вложенных ячейках,
+// адрес собирается из кодов мест, путь которых является началом пути l
+var locationColumns = fmt.Sprintf(`
+	l.id, l.company_id, l.warehouse_id, COALESCE(l.parent_id, 0), l.kind, l.code, l.name, l.capacity,
+	(SELECT COALESCE(SUM(m.volume), 0) FROM %[1]s m JOIN %[2]s b ON b.id = m.bin_id WHERE b.path LIKE l.path || '%%'),
+	(SELECT string_agg(a.code, '-' ORDER BY length(a.path)) FROM %[2]s a WHERE l.path LIKE a.path || '%%'),
+	l.created_at, l.updated_at`,
+	domain.TablePurchasedMaterials, domain.TableStorageLocations)
+
+func (lr *StorageLocationsPostgresRepository) Create(ctx context.Context, location domain.StorageLocation) (int64, error) {
+	tx, err := beginTx(ctx, lr.psql)
+	if err != nil {
+		return 0, err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	var parentId sql.NullInt64
+	if location.ParentID != 0 {
+		parentId = sql.NullInt64{Int64: location.ParentID, Valid: true}
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (company_id, warehouse_id, parent_id, kind, code, name, capacity)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`,
+		domain.TableStorageLocations)
+
+	var id int64
+	if err = tx.QueryRowContext(ctx, query,
+		location.CompanyID, location.WarehouseID, parentId, location.Kind, location.Code, location.Name, location.Capacity,
+	).Scan(&id); err != nil {
+		return 0, fmt.Errorf("failed to insert storage location: %w", dbError(err))
+	}
+
+	// путь строится из пути родителя и известного только после вставки id
+	query = fmt.Sprintf(`
+		UPDATE %[1]s l
+		SET path = COALESCE((SELECT p.path FROM %[1]s p WHERE p.id = l.parent_id), '/') || l.id || '/'
+		WHERE l.id = $1`,
+		domain.TableStorageLocations)
+
+	if _, err = tx.ExecContext(ctx, query, id); err != nil {
+		return 0, fmt.Errorf("failed to update storage location path: %w", dbError(err))
+	}
+
+	return id, tx.Commit()
+}
+
+func (lr *StorageLocationsPostgresRepository) GetById(ctx context.Context, id, companyId int64) (domain.StorageLocation, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s l WHERE l.id = $1 AND l.company_id = $2",
+		locationColumns, domain.TableStorageLocations)
+
+	location, err := scanLocation(conn(ctx, lr.psql).QueryRowContext(ctx, query, id, companyId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.StorageLocation{}, domain.ErrLocationNotFound
+		}
+
+		return domain.StorageLocation{}, err
+	}
+
+	return location, nil
+}
+
+// Update меняет код, название и вместимость, склад и положение места в иерархии не меняются
+func (lr *StorageLocationsPostgresRepository) Update(ctx context.Context, location domain.StorageLocation) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET code = $1, name = $2, capacity = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4 AND company_id = $5`,
+		domain.TableStorageLocations)
+
+	res, err := conn(ctx, lr.psql).ExecContext(ctx, query,
+		location.Code, location.Name, location.Capacity, location.ID, location.CompanyID)
+	if err != nil {
+		return fmt.Errorf("failed to update storage location: %w", dbError(err))
+	}
+
+	return checkAffected(res, domain.ErrLocationNotFound)
+}
+
+// Delete удаляет место без вложенных мест и размещенных партий
+func (lr *StorageLocationsPostgresRepository) Delete(ctx context.Context, id, companyId int64) error {
+	tx, err := beginTx(ctx, lr.psql)
+	if err != nil {
+		return err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	// блокировка места не дает параллельно разместить в нем партию
+	query := fmt.Sprintf("SELECT id FROM %s WHERE id = $1 AND company_id = $2 FOR UPDATE", domain.TableStorageLocations)
+	if err = tx.QueryRowContext(ctx, query, id, companyId).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrLocationNotFound
+		}
+
+		return err
+	}
+
+	var used bool
+	query = fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE bin_id = $1)", domain.TablePurchasedMaterials)
+	if err = tx.QueryRowContext(ctx, query, id).Scan(&used); err != nil {
+		return err
+	}
+
+	if used {
+		return fmt.Errorf("%w: storage location has materials", domain.ErrReferenced)
+	}
+
+	// вложенные места защищает внешний ключ parent_id
+	if _, err = tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1", domain.TableStorageLocations), id); err != nil {
+		return fmt.Errorf("failed to delete storage location: %w", dbError(err))
+	}
+
+	return tx.Commit()
+}
+
+func (lr *StorageLocationsPostgresRepository) GetList(ctx context.Context, params domain.StorageLocationParams) ([]domain.StorageLocation, error) {
+	where := []string{"l.company_id = $1", "l.warehouse_id = $2"}
+	args := []interface{}{params.CompanyId, params.WarehouseId}
+
+	if params.ParentId != 0 {
+		args = append(args, params.ParentId)
+		where = append(where, fmt.Sprintf("l.parent_id = $%d", len(args)))
+	}
+
+	if params.Kind != "" {
+		args = append(args, params.Kind)
+		where = append(where, fmt.Sprintf("l.kind = $%d", len(args)))
+	}
+
+	args = append(args, params.Limit, params.Offset)
+	query := fmt.Sprintf("SELECT %s FROM %s l WHERE %s ORDER BY l.path LIMIT $%d OFFSET $%d",
+		locationColumns, domain.TableStorageLocations, strings.Join(where, " AND "), len(args)-1, len(args))
+
+	rows, err := conn(ctx, lr.psql).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var locations []domain.StorageLocation
+	for rows.Next() {
+		location, err := scanLocation(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		locations = append(locations, location)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return locations, nil
+}
+
+// GetPutAwayTarget блокирует ячейку и места, в которые она вложена, и возвращает их заполненность
+// без партии materialId. Блокировки до конца транзакции не дают двум размещениям одновременно
+// занять последнее свободное место, места блокируются от зоны к ячейке.
+func (lr *StorageLocationsPostgresRepository) GetPutAwayTarget(ctx context.Context, binId, companyId, materialId int64) (domain.PutAwayTarget, error) {
+	bin, err := lr.GetById(ctx, binId, companyId)
+	if err != nil {
+		return domain.PutAwayTarget{}, err
+	}
+
+	target := domain.PutAwayTarget{Bin: bin}
+
+	query := fmt.Sprintf(`
+		SELECT kind, code FROM %[1]s
+		WHERE (SELECT path FROM %[1]s WHERE id = $1) LIKE path || '%%'
+		ORDER BY length(path)
+		FOR UPDATE`,
+		domain.TableStorageLocations)
+
+	rows, err := conn(ctx, lr.psql).QueryContext(ctx, query, bin.ID)
+	if err != nil {
+		return domain.PutAwayTarget{}, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var locked bool
+	for rows.Next() {
+		var kind, code string
+		if err = rows.Scan(&kind, &code); err != nil {
+			return domain.PutAwayTarget{}, err
+		}
+
+		if kind == domain.LocationKindZone {
+			target.Zone = code
+		}
+
+		// на каждом уровне иерархии один предок, поэтому совпадение уровня - это само место
+		if kind == bin.Kind {
+			locked = true
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.PutAwayTarget{}, err
+	}
+
+	// место успели удалить между чтением и блокировкой
+	if !locked {
+		return domain.PutAwayTarget{}, domain.ErrLocationNotFound
+	}
+
+	query = fmt.Sprintf(`
+		SELECT a.id, a.kind, a.code, a.capacity,
+		       (SELECT COALESCE(SUM(m.volume), 0) FROM %[1]s m JOIN %[2]s b ON b.id = m.bin_id
+		        WHERE b.path LIKE a.path || '%%' AND m.id <> $2)
+		FROM %[2]s a
+		WHERE (SELECT path FROM %[2]s WHERE id = $1) LIKE a.path || '%%' AND a.capacity > 0
+		ORDER BY length(a.path)`,
+		domain.TablePurchasedMaterials, domain.TableStorageLocations)
+
+	usageRows, err := conn(ctx, lr.psql).QueryContext(ctx, query, bin.ID, materialId)
+	if err != nil {
+		return domain.PutAwayTarget{}, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(usageRows)
+
+	for usageRows.Next() {
+		var u domain.LocationUsage
+		if err = usageRows.Scan(&u.ID, &u.Kind, &u.Code, &u.Capacity, &u.Occupancy); err != nil {
+			return domain.PutAwayTarget{}, err
+		}
+
+		target.Usage = append(target.Usage, u)
+	}
+
+	if err = usageRows.Err(); err != nil {
+		return domain.PutAwayTarget{}, err
+	}
+
+	return target, nil
+}
+
+func scanLocation(row interface{ Scan(dest ...any) error }) (domain.StorageLocation, error) {
+	var l domain.StorageLocation
+	var address sql.NullString
+
+	if err := row.Scan(
+		&l.ID, &l.CompanyID, &l.WarehouseID, &l.ParentID, &l.Kind, &l.Code, &l.Name, &l.Capacity,
+		&l.Occupancy, &address, &l.CreatedAt, &l.UpdatedAt,
+	); err != nil {
+		return domain.StorageLocation{}, err
+	}
+
+	l.Address = address.String
+
+	return l, nil
+}
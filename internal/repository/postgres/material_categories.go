@@ -3,8 +3,11 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"strings"
+	"time"
 )
 
 type Category interface {
@@ -14,8 +17,22 @@ type Category interface {
 	Delete(ctx context.Context, id, companyId int64) error
 	List(ctx context.Context, param domain.Param) ([]domain.MaterialCategory, error)
 	Search(ctx context.Context, param domain.Param) ([]domain.MaterialCategory, error)
+
+	GetByName(ctx context.Context, name string, companyId int64) (domain.MaterialCategory, error)
+	GetDeletedById(ctx context.Context, id, companyId int64) (domain.MaterialCategory, error)
+	Restore(ctx context.Context, id, companyId int64) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+
+	Move(ctx context.Context, id, companyId, parentId int64) error
+	Reparent(ctx context.Context, fromId, companyId, parentId int64) (int64, error)
+	GetSubtree(ctx context.Context, id, companyId int64) ([]domain.MaterialCategory, error)
+	SlugExists(ctx context.Context, slug string, companyId int64) (bool, error)
 }
 
+// categoryColumns - поля категории в порядке scanCategory
+const categoryColumns = `id, name, company_id, description, slug, created_at, updated_at, is_active, img_url, deleted_at,
+	COALESCE(parent_id, 0), path, depth`
+
 type MaterialCategoriesPostgresRepository struct {
 	psql *sql.DB
 }
@@ -26,68 +43,422 @@ func NewMaterialCategoriesPostgresRepository(psql *sql.DB) *MaterialCategoriesPo
 	}
 }
 
+// Create создает категорию, родитель должен быть неудаленной категорией той же компании
 func (mc *MaterialCategoriesPostgresRepository) Create(ctx context.Context, c domain.MaterialCategory) (int64, error) {
+	tx, err := beginTx(ctx, mc.psql)
+	if err != nil {
+		return 0, err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	var parentId sql.NullInt64
+	if c.ParentID != 0 {
+		if _, err = lockCategory(ctx, tx.Tx, c.ParentID, c.CompanyID); err != nil {
+			return 0, err
+		}
+
+		parentId = sql.NullInt64{Int64: c.ParentID, Valid: true}
+	}
+
 	query := fmt.Sprintf(`
-		INSERT INTO %s (name, company_id, description, slug, created_at, updated_at, is_active, img_url)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`,
+		INSERT INTO %s (name, company_id, description, slug, created_at, updated_at, is_active, img_url, parent_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`,
 		domain.TableMaterialCategories)
 
 	var id int64
-	if err := mc.psql.QueryRowContext(ctx, query,
-		c.Name, c.CompanyID, c.Description, c.Slug, c.CreatedAt, c.UpdatedAt, c.IsActive, c.ImgURL,
+	if err = tx.QueryRowContext(ctx, query,
+		c.Name, c.CompanyID, c.Description, c.Slug, c.CreatedAt, c.UpdatedAt, c.IsActive, c.ImgURL, parentId,
 	).Scan(&id); err != nil {
-		return 0, fmt.Errorf("failed to insert material category: %v", err)
+		return 0, fmt.Errorf("failed to insert material category: %w", dbError(err))
 	}
 
-	return id, nil
+	// путь строится из пути родителя и известного только после вставки id
+	query = fmt.Sprintf(`
+		UPDATE %[1]s c
+		SET path = COALESCE((SELECT p.path FROM %[1]s p WHERE p.id = c.parent_id), '/') || c.id || '/',
+		    depth = COALESCE((SELECT p.depth + 1 FROM %[1]s p WHERE p.id = c.parent_id), 0)
+		WHERE c.id = $1`,
+		domain.TableMaterialCategories)
+
+	if _, err = tx.ExecContext(ctx, query, id); err != nil {
+		return 0, fmt.Errorf("failed to update material category path: %w", dbError(err))
+	}
+
+	return id, tx.Commit()
 }
 
 func (mc *MaterialCategoriesPostgresRepository) GetById(ctx context.Context, id, companyId int64) (domain.MaterialCategory, error) {
+	return mc.getById(ctx, id, companyId, notDeleted)
+}
+
+// GetDeletedById возвращает только мягко удаленную категорию
+func (mc *MaterialCategoriesPostgresRepository) GetDeletedById(ctx context.Context, id, companyId int64) (domain.MaterialCategory, error) {
+	return mc.getById(ctx, id, companyId, isDeleted)
+}
+
+// GetByName возвращает неудаленную категорию компании по названию, материалы ссылаются на категорию по нему
+func (mc *MaterialCategoriesPostgresRepository) GetByName(ctx context.Context, name string, companyId int64) (domain.MaterialCategory, error) {
 	query := fmt.Sprintf(`
-		SELECT 
-		    id, name, company_id, description, slug, created_at, updated_at, is_active, img_url 
-		FROM %s WHERE id = $1 AND company_id = $2`,
-		domain.TableMaterialCategories)
+		SELECT %s FROM %s WHERE name = $1 AND company_id = $2 AND deleted_at IS NULL
+		ORDER BY id LIMIT 1`,
+		categoryColumns, domain.TableMaterialCategories)
+
+	c, err := scanCategory(conn(ctx, mc.psql).QueryRowContext(ctx, query, name, companyId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.MaterialCategory{}, domain.ErrCategoryNotFound
+		}
 
-	var c domain.MaterialCategory
-	if err := mc.psql.QueryRowContext(ctx, query, id, companyId).Scan(
-		&c.ID, &c.Name, &c.CompanyID, &c.Description, &c.Slug, &c.CreatedAt, &c.UpdatedAt, &c.IsActive, &c.ImgURL,
-	); err != nil {
 		return domain.MaterialCategory{}, err
 	}
 
 	return c, nil
 }
 
+func (mc *MaterialCategoriesPostgresRepository) getById(ctx context.Context, id, companyId int64, state string) (domain.MaterialCategory, error) {
+	query := fmt.Sprintf(`
+		SELECT %s FROM %s WHERE id = $1 AND company_id = $2 AND %s`,
+		categoryColumns, domain.TableMaterialCategories, state)
+
+	c, err := scanCategory(conn(ctx, mc.psql).QueryRowContext(ctx, query, id, companyId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.MaterialCategory{}, domain.ErrCategoryNotFound
+		}
+
+		return domain.MaterialCategory{}, err
+	}
+
+	return c, nil
+}
+
+// Update меняет поля категории, положение в дереве меняет Move. Новое название категории
+// переносится в product_category ее материалов во всех разделах.
 func (mc *MaterialCategoriesPostgresRepository) Update(ctx context.Context, c domain.MaterialCategory) error {
+	tx, err := beginTx(ctx, mc.psql)
+	if err != nil {
+		return err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
 	query := fmt.Sprintf(`
 		UPDATE %s
 		SET
 			name = $1, description = $2, slug = $3, created_at = $4, updated_at = $5, is_active = $6, img_url = $7
-		WHERE id = $8 AND company_id = $9`,
+		WHERE id = $8 AND company_id = $9 AND deleted_at IS NULL`,
 		domain.TableMaterialCategories)
 
-	_, err := mc.psql.ExecContext(ctx, query,
+	res, err := tx.ExecContext(ctx, query,
 		c.Name, c.Description, c.Slug, c.CreatedAt, c.UpdatedAt, c.IsActive, c.ImgURL, c.ID, c.CompanyID,
 	)
+	if err != nil {
+		return dbError(err)
+	}
+
+	if err = checkAffected(res, domain.ErrCategoryNotFound); err != nil {
+		return err
+	}
 
-	return err
+	for _, table := range []string{domain.TablePlanningMaterials, domain.TablePurchasedMaterials,
+		domain.TablePlanningMaterialsArchive, domain.TablePurchasedMaterialsArchive} {
+		query = fmt.Sprintf(`
+			UPDATE %s SET product_category = $1 WHERE category_id = $2 AND company_id = $3 AND product_category <> $1`,
+			table)
+
+		if _, err = tx.ExecContext(ctx, query, c.Name, c.ID, c.CompanyID); err != nil {
+			return fmt.Errorf("failed to update materials category name: %w", dbError(err))
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Move переносит категорию вместе с вложенными под другого родителя, parentId 0 - в корень
+func (mc *MaterialCategoriesPostgresRepository) Move(ctx context.Context, id, companyId, parentId int64) error {
+	tx, err := beginTx(ctx, mc.psql)
+	if err != nil {
+		return err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	if _, err = lockCategory(ctx, tx.Tx, id, companyId); err != nil {
+		return err
+	}
+
+	if err = moveCategory(ctx, tx.Tx, id, companyId, parentId); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Reparent переносит вложенные категории fromId под другого родителя, parentId 0 - в корень.
+// Удаленные вложенные категории переносятся тоже, чтобы после восстановления они остались в дереве.
+func (mc *MaterialCategoriesPostgresRepository) Reparent(ctx context.Context, fromId, companyId, parentId int64) (int64, error) {
+	tx, err := beginTx(ctx, mc.psql)
+	if err != nil {
+		return 0, err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	if _, err = lockCategory(ctx, tx.Tx, fromId, companyId); err != nil {
+		return 0, err
+	}
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT id FROM %s WHERE parent_id = $1 ORDER BY id FOR UPDATE",
+		domain.TableMaterialCategories), fromId)
+	if err != nil {
+		return 0, err
+	}
+
+	var children []int64
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return 0, err
+		}
+
+		children = append(children, id)
+	}
+
+	if err = rows.Close(); err != nil {
+		return 0, err
+	}
+
+	for _, id := range children {
+		if err = moveCategory(ctx, tx.Tx, id, companyId, parentId); err != nil {
+			return 0, err
+		}
+	}
+
+	return int64(len(children)), tx.Commit()
 }
 
+// GetSubtree возвращает категорию и все вложенные в нее, включая удаленные, в порядке пути
+func (mc *MaterialCategoriesPostgresRepository) GetSubtree(ctx context.Context, id, companyId int64) ([]domain.MaterialCategory, error) {
+	query := fmt.Sprintf(`
+		SELECT %s FROM %s
+		WHERE company_id = $2 AND path LIKE (SELECT path FROM %s WHERE id = $1 AND company_id = $2) || '%%'
+		ORDER BY path`,
+		categoryColumns, domain.TableMaterialCategories, domain.TableMaterialCategories)
+
+	rows, err := conn(ctx, mc.psql).QueryContext(ctx, query, id, companyId)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var categories []domain.MaterialCategory
+
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		categories = append(categories, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// SlugExists проверяет slug среди всех категорий компании, включая удаленные
+func (mc *MaterialCategoriesPostgresRepository) SlugExists(ctx context.Context, slug string, companyId int64) (bool, error) {
+	var exists bool
+	if err := conn(ctx, mc.psql).QueryRowContext(ctx, fmt.Sprintf(
+		"SELECT EXISTS (SELECT 1 FROM %s WHERE slug = $1 AND company_id = $2)", domain.TableMaterialCategories),
+		slug, companyId).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// lockCategory блокирует неудаленную категорию компании и возвращает ее путь
+func lockCategory(ctx context.Context, tx *sql.Tx, id, companyId int64) (string, error) {
+	var path string
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf(
+		"SELECT path FROM %s WHERE id = $1 AND company_id = $2 AND deleted_at IS NULL FOR UPDATE",
+		domain.TableMaterialCategories), id, companyId).Scan(&path); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrCategoryNotFound
+		}
+
+		return "", err
+	}
+
+	return path, nil
+}
+
+// moveCategory меняет родителя категории и пересчитывает путь и глубину всего ее поддерева
+func moveCategory(ctx context.Context, tx *sql.Tx, id, companyId, parentId int64) error {
+	var (
+		path  string
+		depth int64
+	)
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT path, depth FROM %s WHERE id = $1 AND company_id = $2",
+		domain.TableMaterialCategories), id, companyId).Scan(&path, &depth); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrCategoryNotFound
+		}
+
+		return err
+	}
+
+	var parent sql.NullInt64
+	newPath, newDepth := fmt.Sprintf("/%d/", id), int64(0)
+	if parentId != 0 {
+		parentPath, err := lockCategory(ctx, tx, parentId, companyId)
+		if err != nil {
+			return err
+		}
+
+		if strings.HasPrefix(parentPath, path) {
+			return fmt.Errorf("%w: category can not be moved into itself or its subcategory", domain.ErrInvalidCategory)
+		}
+
+		parent = sql.NullInt64{Int64: parentId, Valid: true}
+		newPath = fmt.Sprintf("%s%d/", parentPath, id)
+		newDepth = int64(strings.Count(parentPath, "/") - 1)
+	}
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+		"UPDATE %s SET parent_id = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2",
+		domain.TableMaterialCategories), parent, id); err != nil {
+		return fmt.Errorf("failed to move material category: %w", dbError(err))
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET path = $1 || substr(path, length($2) + 1), depth = depth + $3
+		WHERE company_id = $4 AND path LIKE $2 || '%%'`,
+		domain.TableMaterialCategories)
+
+	if _, err := tx.ExecContext(ctx, query, newPath, path, newDepth-depth, companyId); err != nil {
+		return fmt.Errorf("failed to update material category path: %w", dbError(err))
+	}
+
+	return nil
+}
+
+// Delete мягко удаляет категорию без неудаленных вложенных категорий
 func (mc *MaterialCategoriesPostgresRepository) Delete(ctx context.Context, id, companyId int64) error {
-	_, err := mc.psql.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1 AND company_id = $2",
+	tx, err := beginTx(ctx, mc.psql)
+	if err != nil {
+		return err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	if _, err = lockCategory(ctx, tx.Tx, id, companyId); err != nil {
+		return err
+	}
+
+	var hasChildren bool
+	if err = tx.QueryRowContext(ctx, fmt.Sprintf(
+		"SELECT EXISTS (SELECT 1 FROM %s WHERE parent_id = $1 AND deleted_at IS NULL)", domain.TableMaterialCategories),
+		id).Scan(&hasChildren); err != nil {
+		return err
+	}
+
+	if hasChildren {
+		return fmt.Errorf("%w: category has subcategories", domain.ErrReferenced)
+	}
+
+	if _, err = tx.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1",
+		domain.TableMaterialCategories), id); err != nil {
+		return dbError(err)
+	}
+
+	return tx.Commit()
+}
+
+// Restore восстанавливает категорию, если ее родитель не удален
+func (mc *MaterialCategoriesPostgresRepository) Restore(ctx context.Context, id, companyId int64) error {
+	var parentDeleted bool
+	if err := conn(ctx, mc.psql).QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT COALESCE(p.deleted_at IS NOT NULL, false)
+		FROM %[1]s c LEFT JOIN %[1]s p ON p.id = c.parent_id
+		WHERE c.id = $1 AND c.company_id = $2 AND c.deleted_at IS NOT NULL`,
+		domain.TableMaterialCategories), id, companyId).Scan(&parentDeleted); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrCategoryNotFound
+		}
+
+		return err
+	}
+
+	if parentDeleted {
+		return fmt.Errorf("%w: parent category is deleted", domain.ErrInvalidCategory)
+	}
+
+	res, err := conn(ctx, mc.psql).ExecContext(ctx, fmt.Sprintf(`
+		UPDATE %s SET deleted_at = NULL WHERE id = $1 AND company_id = $2 AND deleted_at IS NOT NULL`,
 		domain.TableMaterialCategories), id, companyId)
-	return err
+	if err != nil {
+		return dbError(err)
+	}
+
+	return checkAffected(res, domain.ErrCategoryNotFound)
+}
+
+// Purge окончательно удаляет категории, удаленные раньше deletedBefore, без вложенных категорий
+// и материалов. Родитель удаляется следующим запуском, после своих вложенных категорий.
+func (mc *MaterialCategoriesPostgresRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	query := fmt.Sprintf(`
+		DELETE FROM %[1]s c
+		WHERE c.deleted_at < $1
+		  AND NOT EXISTS (SELECT 1 FROM %[1]s WHERE parent_id = c.id)
+		  AND NOT EXISTS (SELECT 1 FROM %[2]s WHERE category_id = c.id)
+		  AND NOT EXISTS (SELECT 1 FROM %[3]s WHERE category_id = c.id)
+		  AND NOT EXISTS (SELECT 1 FROM %[4]s WHERE category_id = c.id)
+		  AND NOT EXISTS (SELECT 1 FROM %[5]s WHERE category_id = c.id)`,
+		domain.TableMaterialCategories, domain.TablePlanningMaterials, domain.TablePurchasedMaterials,
+		domain.TablePlanningMaterialsArchive, domain.TablePurchasedMaterialsArchive)
+
+	res, err := conn(ctx, mc.psql).ExecContext(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge material categories: %w", dbError(err))
+	}
+
+	return res.RowsAffected()
 }
 
 func (mc *MaterialCategoriesPostgresRepository) List(ctx context.Context, param domain.Param) ([]domain.MaterialCategory, error) {
 	query := fmt.Sprintf(`
-		SELECT 
-		    id, name, company_id, description, slug, created_at, updated_at, is_active, img_url 
-		FROM %s WHERE company_id = $1 LIMIT $2 OFFSET $3`,
-		domain.TableMaterialCategories)
+		SELECT %s FROM %s WHERE company_id = $1 AND ($4 OR deleted_at IS NULL) ORDER BY path LIMIT $2 OFFSET $3`,
+		categoryColumns, domain.TableMaterialCategories)
 
-	rows, err := mc.psql.QueryContext(ctx, query, param.CompanyId, param.Limit, param.Offset)
+	rows, err := conn(ctx, mc.psql).QueryContext(ctx, query, param.CompanyId, param.Limit, param.Offset,
+		param.IncludeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -100,10 +471,8 @@ func (mc *MaterialCategoriesPostgresRepository) List(ctx context.Context, param
 	var categories []domain.MaterialCategory
 
 	for rows.Next() {
-		var c domain.MaterialCategory
-		if err = rows.Scan(
-			&c.ID, &c.Name, &c.CompanyID, &c.Description, &c.Slug, &c.CreatedAt, &c.UpdatedAt, &c.IsActive, &c.ImgURL,
-		); err != nil {
+		c, err := scanCategory(rows)
+		if err != nil {
 			return nil, err
 		}
 
@@ -115,14 +484,13 @@ func (mc *MaterialCategoriesPostgresRepository) List(ctx context.Context, param
 
 func (mc *MaterialCategoriesPostgresRepository) Search(ctx context.Context, param domain.Param) ([]domain.MaterialCategory, error) {
 	query := fmt.Sprintf(`
-		SELECT 
-		    id, name, company_id, description, slug, created_at, updated_at, is_active, img_url 
-		FROM %s WHERE name ILIKE $1 AND company_id = $2 ORDER BY name ASC LIMIT $3 OFFSET $4`,
-		domain.TableMaterialCategories)
+		SELECT %s FROM %s WHERE name ILIKE $1 AND company_id = $2 AND ($5 OR deleted_at IS NULL) ORDER BY name ASC LIMIT $3 OFFSET $4`,
+		categoryColumns, domain.TableMaterialCategories)
 
 	searchQuery := param.Query + "%"
 
-	rows, err := mc.psql.QueryContext(ctx, query, searchQuery, param.CompanyId, param.Limit, param.Offset)
+	rows, err := conn(ctx, mc.psql).QueryContext(ctx, query, searchQuery, param.CompanyId, param.Limit, param.Offset,
+		param.IncludeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -135,10 +503,8 @@ func (mc *MaterialCategoriesPostgresRepository) Search(ctx context.Context, para
 	var categories []domain.MaterialCategory
 
 	for rows.Next() {
-		var c domain.MaterialCategory
-		if err = rows.Scan(
-			&c.ID, &c.Name, &c.CompanyID, &c.Description, &c.Slug, &c.CreatedAt, &c.UpdatedAt, &c.IsActive, &c.ImgURL,
-		); err != nil {
+		c, err := scanCategory(rows)
+		if err != nil {
 			return nil, err
 		}
 
@@ -147,3 +513,19 @@ func (mc *MaterialCategoriesPostgresRepository) Search(ctx context.Context, para
 
 	return categories, nil
 }
+
+func scanCategory(row rowScanner) (domain.MaterialCategory, error) {
+	var c domain.MaterialCategory
+	var deletedAt sql.NullTime
+
+	if err := row.Scan(
+		&c.ID, &c.Name, &c.CompanyID, &c.Description, &c.Slug, &c.CreatedAt, &c.UpdatedAt, &c.IsActive, &c.ImgURL,
+		&deletedAt, &c.ParentID, &c.Path, &c.Depth,
+	); err != nil {
+		return domain.MaterialCategory{}, err
+	}
+
+	c.DeletedAt = deletedAt.Time
+
+	return c, nil
+}
@@ -0,0 +1,346 @@
+package postgres
+
+import (
+	"context"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"sort"
+	"strings"
+)
+
+const planningColumns = `id, warehouse_id, item_id, name, by_invoice, article, product_category, unit, total_quantity,
+	volume, price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve,
+	received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost,
+	warehouse_section, incoming_delivery_number, other_fields, company_id, category_id`
+
+const purchasedColumns = planningColumns + ", bin_id"
+
+// materialSortValues значения полей сортировки материала для курсора
+var materialSortValues = map[string]func(m domain.Material) interface{}{
+	"id":                func(m domain.Material) interface{} { return m.ID },
+	"name":              func(m domain.Material) interface{} { return m.Name },
+	"article":           func(m domain.Material) interface{} { return m.Article },
+	"status":            func(m domain.Material) interface{} { return m.Status },
+	"warehouse_id":      func(m domain.Material) interface{} { return m.WarehouseID },
+	"supplier_id":       func(m domain.Material) interface{} { return m.SupplierID },
+	"total_quantity":    func(m domain.Material) interface{} { return m.TotalQuantity },
+	"price_without_vat": func(m domain.Material) interface{} { return m.PriceWithoutVAT },
+	"received_date":     func(m domain.Material) interface{} { return m.ReceivedDate },
+	"expiration_date":   func(m domain.Material) interface{} { return m.ExpirationDate },
+	"last_updated":      func(m domain.Material) interface{} { return m.LastUpdated },
+}
+
+// materialCursor - содержимое курсора: значения полей сортировки последнего материала страницы
+// и отпечаток фильтра и сортировки, с которыми курсор получен
+type materialCursor struct {
+	Key    string        `json:"k"`
+	Values []interface{} `json:"v"`
+}
+
+// getList возвращает страницу материалов таблицы. Порядок однозначен за счет id в конце сортировки,
+// поэтому следующая страница по курсору начинается строго после последнего материала предыдущей.
+func (mr *MaterialsPostgresRepository) getList(ctx context.Context, table, columns string, params domain.MaterialParams,
+	scan func(row rowScanner) (domain.Material, error)) (domain.MaterialPage, error) {
+	sorts := materialSort(params.Sort)
+	for _, s := range sorts {
+		if _, ok := materialSortValues[s.Field]; !ok {
+			return domain.MaterialPage{}, fmt.Errorf("%w: unknown sort field %s", domain.ErrInvalidArgument, s.Field)
+		}
+	}
+
+	key, err := cursorKey(params.Filter, sorts)
+	if err != nil {
+		return domain.MaterialPage{}, err
+	}
+
+	where, args := materialFilter(params.CompanyId, params.Filter)
+
+	var page domain.MaterialPage
+	if err = conn(ctx, mr.psql).QueryRowContext(ctx,
+		fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", table, strings.Join(where, " AND ")), args...,
+	).Scan(&page.Total); err != nil {
+		return domain.MaterialPage{}, err
+	}
+
+	offset := params.Offset
+	if params.Cursor != "" {
+		values, err := decodeCursor(params.Cursor, key, len(sorts))
+		if err != nil {
+			return domain.MaterialPage{}, err
+		}
+
+		var after string
+		after, args = keysetCondition(sorts, values, args)
+		where = append(where, after)
+		offset = 0
+	}
+
+	order := make([]string, 0, len(sorts))
+	for _, s := range sorts {
+		if s.Desc {
+			order = append(order, s.Field+" DESC")
+		} else {
+			order = append(order, s.Field+" ASC")
+		}
+	}
+
+	// лишний материал показывает, что за страницей есть следующая
+	args = append(args, params.Limit+1, offset)
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s ORDER BY %s LIMIT $%d OFFSET $%d",
+		columns, table, strings.Join(where, " AND "), strings.Join(order, ", "), len(args)-1, len(args))
+
+	rows, err := conn(ctx, mr.psql).QueryContext(ctx, query, args...)
+	if err != nil {
+		return domain.MaterialPage{}, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	for rows.Next() {
+		material, err := scan(rows)
+		if err != nil {
+			return domain.MaterialPage{}, err
+		}
+
+		page.Materials = append(page.Materials, material)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.MaterialPage{}, err
+	}
+
+	if int64(len(page.Materials)) > params.Limit {
+		page.Materials = page.Materials[:params.Limit]
+		if page.NextCursor, err = encodeCursor(key, sorts, page.Materials[len(page.Materials)-1]); err != nil {
+			return domain.MaterialPage{}, err
+		}
+	}
+
+	return page, nil
+}
+
+// materialSort добавляет id в конец сортировки, если его там нет
+func materialSort(sorts []domain.MaterialSort) []domain.MaterialSort {
+	for _, s := range sorts {
+		if s.Field == "id" {
+			return sorts
+		}
+	}
+
+	return append(append(make([]domain.MaterialSort, 0, len(sorts)+1), sorts...), domain.MaterialSort{Field: "id"})
+}
+
+// materialFilter строит условия списка материалов компании, $1 - компания
+func materialFilter(companyId int64, f domain.MaterialFilter) ([]string, []interface{}) {
+	where := []string{"company_id = $1"}
+	args := []interface{}{companyId}
+
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+
+	if f.WarehouseId != 0 {
+		add("warehouse_id = $%d", f.WarehouseId)
+	}
+
+	if f.SupplierId != 0 {
+		add("supplier_id = $%d", f.SupplierId)
+	}
+
+	if f.CategoryId != 0 {
+		args = append(args, f.CategoryId)
+		where = append(where, fmt.Sprintf(`category_id IN (
+			SELECT c.id FROM %[1]s c
+			WHERE c.company_id = $1 AND c.path LIKE (SELECT p.path FROM %[1]s p WHERE p.id = $%[2]d) || '%%')`,
+			domain.TableMaterialCategories, len(args)))
+	}
+
+	if f.Status != "" {
+		add("status = $%d", f.Status)
+	}
+
+	if !f.ReceivedFrom.IsZero() {
+		add("received_date >= $%d", f.ReceivedFrom)
+	}
+
+	if !f.ReceivedTo.IsZero() {
+		add("received_date < $%d", f.ReceivedTo)
+	}
+
+	if !f.ExpirationFrom.IsZero() {
+		add("expiration_date >= $%d", f.ExpirationFrom)
+	}
+
+	if !f.ExpirationTo.IsZero() {
+		add("expiration_date < $%d", f.ExpirationTo)
+	}
+
+	if f.QuantityMin != nil {
+		add("total_quantity >= $%d", *f.QuantityMin)
+	}
+
+	if f.QuantityMax != nil {
+		add("total_quantity <= $%d", *f.QuantityMax)
+	}
+
+	// ->> возвращает значение поля текстом, поэтому числа сравниваются по их записи в JSON.
+	// Ключи сортируются, чтобы текст запроса не зависел от порядка обхода карты.
+	keys := make([]string, 0, len(f.OtherFields))
+	for k := range f.OtherFields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		args = append(args, k, f.OtherFields[k])
+		where = append(where, fmt.Sprintf("other_fields ->> $%d = $%d", len(args)-1, len(args)))
+	}
+
+	return where, args
+}
+
+// keysetCondition - условие "строго после значений курсора" для сортировки по нескольким полям
+// с разными направлениями: (a > x) OR (a = x AND b < y) OR ...
+func keysetCondition(sorts []domain.MaterialSort, values []interface{}, args []interface{}) (string, []interface{}) {
+	first := len(args) + 1
+	args = append(args, values...)
+
+	or := make([]string, 0, len(sorts))
+	for i, s := range sorts {
+		and := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			and = append(and, fmt.Sprintf("%s = $%d", sorts[j].Field, first+j))
+		}
+
+		op := ">"
+		if s.Desc {
+			op = "<"
+		}
+
+		and = append(and, fmt.Sprintf("%s %s $%d", s.Field, op, first+i))
+		or = append(or, "("+strings.Join(and, " AND ")+")")
+	}
+
+	return "(" + strings.Join(or, " OR ") + ")", args
+}
+
+// cursorKey - отпечаток фильтра и сортировки: курсор другого запроса отклоняется
+func cursorKey(filter domain.MaterialFilter, sorts []domain.MaterialSort) (string, error) {
+	data, err := json.Marshal(struct {
+		Filter domain.MaterialFilter
+		Sort   []domain.MaterialSort
+	}{filter, sorts})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha1.Sum(data)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+func encodeCursor(key string, sorts []domain.MaterialSort, last domain.Material) (string, error) {
+	values := make([]interface{}, 0, len(sorts))
+	for _, s := range sorts {
+		values = append(values, materialSortValues[s.Field](last))
+	}
+
+	data, err := json.Marshal(materialCursor{Key: key, Values: values})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor возвращает значения полей сортировки. Числа остаются json.Number, а даты - строками
+// RFC 3339: они передаются в запрос текстом, и тип значения берется из поля, с которым оно сравнивается.
+func decodeCursor(cursor, key string, fields int) ([]interface{}, error) {
+	invalid := fmt.Errorf("%w: invalid cursor", domain.ErrInvalidArgument)
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, invalid
+	}
+
+	var c materialCursor
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+	if err = decoder.Decode(&c); err != nil {
+		return nil, invalid
+	}
+
+	if c.Key != key {
+		return nil, fmt.Errorf("%w: cursor does not match filter or sort", domain.ErrInvalidArgument)
+	}
+
+	if len(c.Values) != fields {
+		return nil, invalid
+	}
+
+	for i, v := range c.Values {
+		switch v := v.(type) {
+		case json.Number:
+			c.Values[i] = v.String()
+		case string:
+		default:
+			return nil, invalid
+		}
+	}
+
+	return c.Values, nil
+}
+
+func scanPlanning(row rowScanner) (domain.Material, error) {
+	var material domain.Material
+	var otherFieldsJSON []byte
+
+	if err := row.Scan(
+		&material.ID, &material.WarehouseID, &material.ItemID, &material.Name, &material.ByInvoice, &material.Article,
+		&material.ProductCategory, &material.Unit, &material.TotalQuantity, &material.Volume,
+		&material.PriceWithoutVAT, &material.TotalWithoutVAT, &material.SupplierID, &material.Location,
+		&material.Contract, &material.File, &material.Status, &material.Comments, &material.Reserve,
+		&material.ReceivedDate, &material.LastUpdated, &material.MinStockLevel, &material.ExpirationDate,
+		&material.ResponsiblePerson, &material.StorageCost, &material.WarehouseSection,
+		&material.IncomingDeliveryNumber, &otherFieldsJSON, &material.CompanyID, &material.CategoryID,
+	); err != nil {
+		return domain.Material{}, err
+	}
+
+	if err := json.Unmarshal(otherFieldsJSON, &material.OtherFields); err != nil {
+		return domain.Material{}, err
+	}
+
+	return material, nil
+}
+
+func scanPurchased(row rowScanner) (domain.Material, error) {
+	var material domain.Material
+	var otherFieldsJSON []byte
+
+	if err := row.Scan(
+		&material.ID, &material.WarehouseID, &material.ItemID, &material.Name, &material.ByInvoice, &material.Article,
+		&material.ProductCategory, &material.Unit, &material.TotalQuantity, &material.Volume,
+		&material.PriceWithoutVAT, &material.TotalWithoutVAT, &material.SupplierID, &material.Location,
+		&material.Contract, &material.File, &material.Status, &material.Comments, &material.Reserve,
+		&material.ReceivedDate, &material.LastUpdated, &material.MinStockLevel, &material.ExpirationDate,
+		&material.ResponsiblePerson, &material.StorageCost, &material.WarehouseSection,
+		&material.IncomingDeliveryNumber, &otherFieldsJSON, &material.CompanyID, &material.CategoryID, &material.BinID,
+	); err != nil {
+		return domain.Material{}, err
+	}
+
+	if err := json.Unmarshal(otherFieldsJSON, &material.OtherFields); err != nil {
+		return domain.Material{}, err
+	}
+
+	return material, nil
+}
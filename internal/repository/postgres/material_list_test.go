@@ -0,0 +1,228 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"errors"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestKeysetCondition(t *testing.T) {
+	tests := []struct {
+		name     string
+		sorts    []domain.MaterialSort
+		values   []interface{}
+		args     []interface{}
+		want     string
+		wantArgs []interface{}
+	}{
+		{
+			name:     "single field",
+			sorts:    []domain.MaterialSort{{Field: "id"}},
+			values:   []interface{}{"10"},
+			args:     []interface{}{int64(1)},
+			want:     "((id > $2))",
+			wantArgs: []interface{}{int64(1), "10"},
+		},
+		{
+			name:     "descending field",
+			sorts:    []domain.MaterialSort{{Field: "id", Desc: true}},
+			values:   []interface{}{"10"},
+			args:     []interface{}{int64(1)},
+			want:     "((id < $2))",
+			wantArgs: []interface{}{int64(1), "10"},
+		},
+		{
+			name:     "mixed directions",
+			sorts:    []domain.MaterialSort{{Field: "name", Desc: true}, {Field: "total_quantity"}, {Field: "id"}},
+			values:   []interface{}{"bolt", "5", "10"},
+			args:     []interface{}{int64(1), int64(2)},
+			want:     "((name < $3) OR (name = $3 AND total_quantity > $4) OR (name = $3 AND total_quantity = $4 AND id > $5))",
+			wantArgs: []interface{}{int64(1), int64(2), "bolt", "5", "10"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, args := keysetCondition(tt.sorts, tt.values, tt.args)
+			if got != tt.want {
+				t.Errorf("keysetCondition() = %q, want %q", got, tt.want)
+			}
+
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("keysetCondition() args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	sorts := []domain.MaterialSort{{Field: "received_date", Desc: true}, {Field: "name"}, {Field: "id"}}
+	last := domain.Material{
+		ID:           42,
+		Name:         "Болт",
+		ReceivedDate: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	valid, err := encodeCursor("key", sorts, last)
+	if err != nil {
+		t.Fatalf("encodeCursor: %v", err)
+	}
+
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+
+	tests := []struct {
+		name    string
+		cursor  string
+		key     string
+		fields  int
+		want    []interface{}
+		wantErr string
+	}{
+		{
+			name:   "round trip",
+			cursor: valid,
+			key:    "key",
+			fields: 3,
+			want:   []interface{}{"2024-01-02T03:04:05Z", "Болт", "42"},
+		},
+		{
+			name:    "other filter or sort",
+			cursor:  valid,
+			key:     "other",
+			fields:  3,
+			wantErr: "invalid argument: cursor does not match filter or sort",
+		},
+		{
+			name:    "not base64",
+			cursor:  "not a cursor!",
+			key:     "key",
+			fields:  3,
+			wantErr: "invalid argument: invalid cursor",
+		},
+		{
+			name:    "not json",
+			cursor:  encode("{"),
+			key:     "key",
+			fields:  1,
+			wantErr: "invalid argument: invalid cursor",
+		},
+		{
+			name:    "wrong number of values",
+			cursor:  encode(`{"k":"key","v":["1"]}`),
+			key:     "key",
+			fields:  2,
+			wantErr: "invalid argument: invalid cursor",
+		},
+		{
+			name:    "non scalar value",
+			cursor:  encode(`{"k":"key","v":[{"a":1}]}`),
+			key:     "key",
+			fields:  1,
+			wantErr: "invalid argument: invalid cursor",
+		},
+		{
+			name:    "null value",
+			cursor:  encode(`{"k":"key","v":[null]}`),
+			key:     "key",
+			fields:  1,
+			wantErr: "invalid argument: invalid cursor",
+		},
+		{
+			name:   "large number keeps precision",
+			cursor: encode(`{"k":"key","v":[9007199254740993]}`),
+			key:    "key",
+			fields: 1,
+			want:   []interface{}{"9007199254740993"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeCursor(tt.cursor, tt.key, tt.fields)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("decodeCursor() error = %v, want %q", err, tt.wantErr)
+				}
+
+				if !errors.Is(err, domain.ErrInvalidArgument) {
+					t.Errorf("decodeCursor() error = %v, want ErrInvalidArgument", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("decodeCursor() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decodeCursor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCursorKey(t *testing.T) {
+	quantityMin := int64(5)
+	base := domain.MaterialFilter{WarehouseId: 1, OtherFields: map[string]string{"color": "red"}}
+	sorts := []domain.MaterialSort{{Field: "name"}, {Field: "id"}}
+
+	key, err := cursorKey(base, sorts)
+	if err != nil {
+		t.Fatalf("cursorKey: %v", err)
+	}
+
+	if len(key) != 16 {
+		t.Errorf("cursorKey() = %q, want 16 hex characters", key)
+	}
+
+	tests := []struct {
+		name   string
+		filter domain.MaterialFilter
+		sorts  []domain.MaterialSort
+		same   bool
+	}{
+		{"same query", domain.MaterialFilter{WarehouseId: 1, OtherFields: map[string]string{"color": "red"}}, sorts, true},
+		{"other warehouse", domain.MaterialFilter{WarehouseId: 2, OtherFields: map[string]string{"color": "red"}}, sorts, false},
+		{"other field value", domain.MaterialFilter{WarehouseId: 1, OtherFields: map[string]string{"color": "blue"}}, sorts, false},
+		{"quantity bound", domain.MaterialFilter{WarehouseId: 1, OtherFields: map[string]string{"color": "red"}, QuantityMin: &quantityMin}, sorts, false},
+		{"other direction", base, []domain.MaterialSort{{Field: "name", Desc: true}, {Field: "id"}}, false},
+		{"other order", base, []domain.MaterialSort{{Field: "id"}, {Field: "name"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cursorKey(tt.filter, tt.sorts)
+			if err != nil {
+				t.Fatalf("cursorKey: %v", err)
+			}
+
+			if (got == key) != tt.same {
+				t.Errorf("cursorKey() = %q, base key %q, want same %v", got, key, tt.same)
+			}
+		})
+	}
+}
+
+func TestMaterialSort(t *testing.T) {
+	tests := []struct {
+		name  string
+		sorts []domain.MaterialSort
+		want  []domain.MaterialSort
+	}{
+		{"empty", nil, []domain.MaterialSort{{Field: "id"}}},
+		{"id appended", []domain.MaterialSort{{Field: "name", Desc: true}}, []domain.MaterialSort{{Field: "name", Desc: true}, {Field: "id"}}},
+		{"id kept", []domain.MaterialSort{{Field: "id", Desc: true}, {Field: "name"}}, []domain.MaterialSort{{Field: "id", Desc: true}, {Field: "name"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := materialSort(tt.sorts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("materialSort() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
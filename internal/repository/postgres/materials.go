@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
 )
@@ -11,26 +12,34 @@ import (
 type Materials interface {
 	CreatePlanning(ctx context.Context, material domain.Material) (int64, error)
 	UpdatePlanning(ctx context.Context, material domain.Material) error
-	DeletePlanning(ctx context.Context, id int64) error
-	GetPlanningById(ctx context.Context, id int64) (domain.Material, error)
-	GetPlanningList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error)
-	MovePlanningToPurchased(ctx context.Context, id int64) (int64, int64, error)
+	DeletePlanning(ctx context.Context, id, companyId int64) error
+	GetPlanningById(ctx context.Context, id, companyId int64) (domain.Material, error)
+	GetPlanningList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error)
+	MovePlanningToPurchased(ctx context.Context, id, companyId int64) (int64, int64, error)
 
 	CreatePurchased(ctx context.Context, material domain.Material) (int64, int64, error)
 	UpdatePurchased(ctx context.Context, material domain.Material) error
-	DeletePurchased(ctx context.Context, id int64) error
-	GetPurchasedById(ctx context.Context, id int64) (domain.Material, error)
-	GetPurchasedList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error)
-	MovePurchasedToArchive(ctx context.Context, id int64) error
-
-	GetPlanningArchiveById(ctx context.Context, id int64) (domain.Material, error)
-	GetPurchasedArchiveById(ctx context.Context, id int64) (domain.Material, error)
-	GetPlanningArchiveList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error)
-	GetPurchasedArchiveList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error)
-	DeletePlanningArchive(ctx context.Context, id int64) error
-	DeletePurchasedArchive(ctx context.Context, id int64) error
+	DeletePurchased(ctx context.Context, id, companyId int64) error
+	GetPurchasedById(ctx context.Context, id, companyId int64) (domain.Material, error)
+	GetPurchasedList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error)
+	MovePurchasedToArchive(ctx context.Context, id, companyId int64) error
+	PutAway(ctx context.Context, id, companyId, binId int64, section, location string) error
+
+	GetPlanningArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error)
+	GetPurchasedArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error)
+	GetPlanningArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error)
+	GetPurchasedArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error)
+	DeletePlanningArchive(ctx context.Context, id, companyId int64) error
+	DeletePurchasedArchive(ctx context.Context, id, companyId int64) error
 
 	Search(ctx context.Context, param domain.Param) ([]domain.Material, error)
+
+	GetExpiring(ctx context.Context, params domain.ExpirationParams) ([]domain.Material, error)
+	GetExpired(ctx context.Context, companyId int64) ([]domain.Material, error)
+	QuarantineExpired(ctx context.Context, companyId int64) (int64, error)
+	GetFefoLots(ctx context.Context, companyId, itemId, warehouseId int64) ([]domain.FefoPick, error)
+
+	GetReferencing(ctx context.Context, ref domain.MaterialReference) (domain.ReferencingMaterials, error)
 }
 
 type MaterialsPostgresRepository struct {
@@ -53,21 +62,21 @@ func (mr *MaterialsPostgresRepository) CreatePlanning(ctx context.Context, mater
 		INSERT INTO %s (warehouse_id, item_id, name, by_invoice, article, product_category, unit, total_quantity, volume, 
 						price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve, 
 						received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost, 
-						warehouse_section, incoming_delivery_number, other_fields, company_id)
+						warehouse_section, incoming_delivery_number, other_fields, company_id, category_id)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, 
-				$22, $23, $24, $25, $26, $27, $28) RETURNING id`,
+				$22, $23, $24, $25, $26, $27, $28, $29) RETURNING id`,
 		domain.TablePlanningMaterials)
 
 	var id int64
-	if err = mr.psql.QueryRowContext(ctx, query,
+	if err = conn(ctx, mr.psql).QueryRowContext(ctx, query,
 		material.WarehouseID, material.ItemID, material.Name, material.ByInvoice, material.Article, material.ProductCategory,
 		material.Unit, material.TotalQuantity, material.Volume, material.PriceWithoutVAT, material.TotalWithoutVAT,
 		material.SupplierID, material.Location, material.Contract, material.File, material.Status, material.Comments,
 		material.Reserve, material.ReceivedDate, material.LastUpdated, material.MinStockLevel, material.ExpirationDate,
 		material.ResponsiblePerson, material.StorageCost, material.WarehouseSection,
-		material.IncomingDeliveryNumber, otherFieldsJSON, material.CompanyID,
+		material.IncomingDeliveryNumber, otherFieldsJSON, material.CompanyID, material.CategoryID,
 	).Scan(&id); err != nil {
-		return 0, fmt.Errorf("failed to insert planning material: %v", err)
+		return 0, fmt.Errorf("failed to insert planning material: %w", dbError(err))
 	}
 
 	return id, nil
@@ -86,125 +95,86 @@ func (mr *MaterialsPostgresRepository) UpdatePlanning(ctx context.Context, mater
 			total_quantity = $8, volume = $9, price_without_vat = $10, total_without_vat = $11, supplier_id = $12, location = $13,
 			contract = $14, file = $15, status = $16, comments = $17, reserve = $18, received_date = $19, last_updated = $20,
 			min_stock_level = $21, expiration_date = $22, responsible_person = $23, storage_cost = $24, warehouse_section = $25,
-			incoming_delivery_number = $26, other_fields = $27
-		WHERE id = $28`,
+			incoming_delivery_number = $26, other_fields = $27, category_id = $28
+		WHERE id = $29 AND company_id = $30`,
 		domain.TablePlanningMaterials)
 
-	_, err = mr.psql.ExecContext(ctx, query,
+	res, err := conn(ctx, mr.psql).ExecContext(ctx, query,
 		material.WarehouseID, material.ItemID, material.Name, material.ByInvoice, material.Article, material.ProductCategory,
 		material.Unit, material.TotalQuantity, material.Volume, material.PriceWithoutVAT, material.TotalWithoutVAT,
 		material.SupplierID, material.Location, material.Contract, material.File, material.Status, material.Comments,
 		material.Reserve, material.ReceivedDate, material.LastUpdated, material.MinStockLevel, material.ExpirationDate,
 		material.ResponsiblePerson, material.StorageCost, material.WarehouseSection,
-		material.IncomingDeliveryNumber, otherFieldsJSON, material.ID,
+		material.IncomingDeliveryNumber, otherFieldsJSON, material.CategoryID, material.ID, material.CompanyID,
 	)
+	if err != nil {
+		return dbError(err)
+	}
 
-	return err
-}
-
-func (mr *MaterialsPostgresRepository) DeletePlanning(ctx context.Context, id int64) error {
-	_, err := mr.psql.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1", domain.TablePlanningMaterials), id)
-	return err
-}
-
-func (mr *MaterialsPostgresRepository) GetPlanningById(ctx context.Context, id int64) (domain.Material, error) {
-	return mr.getPlanningById(ctx, id)
+	return checkAffected(res, domain.ErrMaterialNotFound)
 }
 
-func (mr *MaterialsPostgresRepository) getPlanningById(ctx context.Context, id int64) (domain.Material, error) {
-	query := fmt.Sprintf(`
-	SELECT 
-	    id, warehouse_id, item_id, name, by_invoice, article, product_category, unit, total_quantity, volume,
-		price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve,
-		received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost,
-		warehouse_section, incoming_delivery_number, other_fields, company_id
-	FROM %s WHERE id = $1
-	`, domain.TablePlanningMaterials)
-
-	var material domain.Material
-	var otherFieldsJSON []byte
-
-	if err := mr.psql.QueryRowContext(ctx, query, id).Scan(
-		&material.ID, &material.WarehouseID, &material.ItemID, &material.Name, &material.ByInvoice, &material.Article,
-		&material.ProductCategory, &material.Unit, &material.TotalQuantity, &material.Volume,
-		&material.PriceWithoutVAT, &material.TotalWithoutVAT, &material.SupplierID, &material.Location,
-		&material.Contract, &material.File, &material.Status, &material.Comments, &material.Reserve,
-		&material.ReceivedDate, &material.LastUpdated, &material.MinStockLevel, &material.ExpirationDate,
-		&material.ResponsiblePerson, &material.StorageCost, &material.WarehouseSection,
-		&material.IncomingDeliveryNumber, &otherFieldsJSON, &material.CompanyID,
-	); err != nil {
-		return domain.Material{}, err
-	}
-
-	if err := json.Unmarshal(otherFieldsJSON, &material.OtherFields); err != nil {
-		return domain.Material{}, err
+func (mr *MaterialsPostgresRepository) DeletePlanning(ctx context.Context, id, companyId int64) error {
+	res, err := conn(ctx, mr.psql).ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1 AND company_id = $2",
+		domain.TablePlanningMaterials), id, companyId)
+	if err != nil {
+		return dbError(err)
 	}
 
-	return material, nil
+	return checkAffected(res, domain.ErrMaterialNotFound)
 }
 
-func (mr *MaterialsPostgresRepository) GetPlanningList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error) {
-	query := fmt.Sprintf(`
-	SELECT 
-	    id, warehouse_id, item_id, name, by_invoice, article, product_category, unit, total_quantity, volume,
-		price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve,
-		received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost,
-		warehouse_section, incoming_delivery_number, other_fields, company_id
-	FROM %s WHERE company_id = $1 LIMIT $2 OFFSET $3
-	`, domain.TablePlanningMaterials)
-
-	rows, err := mr.psql.QueryContext(ctx, query, params.CompanyId, params.Limit, params.Offset)
-	if err != nil {
-		return nil, err
-	}
-	defer func(rows *sql.Rows) {
-		if err = rows.Close(); err != nil {
-			return
-		}
-	}(rows)
+func (mr *MaterialsPostgresRepository) GetPlanningById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return mr.getPlanningById(ctx, id, companyId)
+}
 
-	var materials []domain.Material
+func (mr *MaterialsPostgresRepository) getPlanningById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return mr.getById(ctx, domain.TablePlanningMaterials, planningColumns, scanPlanning, id, companyId)
+}
 
-	for rows.Next() {
-		var material domain.Material
-		var otherFieldsJSON []byte
-
-		if err = rows.Scan(
-			&material.ID, &material.WarehouseID, &material.ItemID, &material.Name, &material.ByInvoice, &material.Article,
-			&material.ProductCategory, &material.Unit, &material.TotalQuantity, &material.Volume,
-			&material.PriceWithoutVAT, &material.TotalWithoutVAT, &material.SupplierID, &material.Location,
-			&material.Contract, &material.File, &material.Status, &material.Comments, &material.Reserve,
-			&material.ReceivedDate, &material.LastUpdated, &material.MinStockLevel, &material.ExpirationDate,
-			&material.ResponsiblePerson, &material.StorageCost, &material.WarehouseSection,
-			&material.IncomingDeliveryNumber, &otherFieldsJSON, &material.CompanyID,
-		); err != nil {
-			return nil, err
-		}
+// getById читает материал по id из таблицы table, внутри WithinTx - в той же транзакции
+func (mr *MaterialsPostgresRepository) getById(ctx context.Context, table, columns string,
+	scan func(row rowScanner) (domain.Material, error), id, companyId int64) (domain.Material, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE id = $1 AND company_id = $2", columns, table)
 
-		if err = json.Unmarshal(otherFieldsJSON, &material.OtherFields); err != nil {
-			return nil, err
+	material, err := scan(conn(ctx, mr.psql).QueryRowContext(ctx, query, id, companyId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Material{}, domain.ErrMaterialNotFound
 		}
 
-		materials = append(materials, material)
+		return domain.Material{}, err
 	}
 
-	return materials, nil
+	return material, nil
+}
+
+func (mr *MaterialsPostgresRepository) GetPlanningList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
+	return mr.getList(ctx, domain.TablePlanningMaterials, planningColumns, params, scanPlanning)
 }
 
-func (mr *MaterialsPostgresRepository) MovePlanningToPurchased(ctx context.Context, id int64) (int64, int64, error) {
+func (mr *MaterialsPostgresRepository) MovePlanningToPurchased(ctx context.Context, id, companyId int64) (int64, int64, error) {
 	// Удаляем из planning, переносим сразу в purchased и archived
-	tx, err := mr.psql.Begin()
+	tx, err := beginTx(ctx, mr.psql)
 	if err != nil {
 		return 0, 0, err
 	}
-	defer func(tx *sql.Tx) {
+	defer func(tx *repoTx) {
 		if err = tx.Rollback(); err != nil {
 			return
 		}
 	}(tx)
 
-	material, err := mr.getPlanningById(ctx, id)
+	// строка блокируется до конца транзакции: параллельный перенос того же материала дождется удаления
+	// и получит ErrMaterialNotFound, а не создаст вторую партию с повторным начальным остатком
+	material, err := scanPlanning(tx.QueryRowContext(ctx, fmt.Sprintf(
+		"SELECT %s FROM %s WHERE id = $1 AND company_id = $2 FOR UPDATE",
+		planningColumns, domain.TablePlanningMaterials), id, companyId))
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, 0, domain.ErrMaterialNotFound
+		}
+
 		return 0, 0, err
 	}
 
@@ -214,22 +184,26 @@ func (mr *MaterialsPostgresRepository) MovePlanningToPurchased(ctx context.Conte
 	}
 
 	// 1. удаляем из planning
-	query := fmt.Sprintf("DELETE FROM %s WHERE id = $1",
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = $1 AND company_id = $2",
 		domain.TablePlanningMaterials)
 
-	_, err = tx.ExecContext(ctx, query, id)
+	res, err := tx.ExecContext(ctx, query, id, companyId)
 	if err != nil {
 		return 0, 0, err
 	}
 
+	if err = checkAffected(res, domain.ErrMaterialNotFound); err != nil {
+		return 0, 0, err
+	}
+
 	// 2. переносим в purchased
 	query = fmt.Sprintf(`
 		INSERT INTO %s (warehouse_id, name, by_invoice, article, product_category, unit, total_quantity, volume, 
 						price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve, 
 						received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost, 
-						warehouse_section, incoming_delivery_number, other_fields, company_id)
+						warehouse_section, incoming_delivery_number, other_fields, company_id, category_id)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, 
-				$22, $23, $24, $25, $26, $27) RETURNING id, item_id`,
+				$22, $23, $24, $25, $26, $27, $28) RETURNING id, item_id`,
 		domain.TablePurchasedMaterials)
 
 	var newId int64
@@ -240,9 +214,13 @@ func (mr *MaterialsPostgresRepository) MovePlanningToPurchased(ctx context.Conte
 		material.SupplierID, material.Location, material.Contract, material.File, material.Status, material.Comments,
 		material.Reserve, material.ReceivedDate, material.LastUpdated, material.MinStockLevel, material.ExpirationDate,
 		material.ResponsiblePerson, material.StorageCost, material.WarehouseSection,
-		material.IncomingDeliveryNumber, otherFieldsJSON, material.CompanyID,
+		material.IncomingDeliveryNumber, otherFieldsJSON, material.CompanyID, material.CategoryID,
 	).Scan(&newId, &itemId); err != nil {
-		return 0, 0, fmt.Errorf("failed to insert purchased material: %v", err)
+		return 0, 0, fmt.Errorf("failed to insert purchased material: %w", dbError(err))
+	}
+
+	if err = recordOpeningBalance(ctx, tx.Tx, material, newId, itemId); err != nil {
+		return 0, 0, err
 	}
 
 	// 3. переносим в planning archive
@@ -250,9 +228,9 @@ func (mr *MaterialsPostgresRepository) MovePlanningToPurchased(ctx context.Conte
 		INSERT INTO %s (warehouse_id, item_id, name, by_invoice, article, product_category, unit, total_quantity, volume, 
 						price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve, 
 						received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost, 
-						warehouse_section, incoming_delivery_number, other_fields, company_id)
+						warehouse_section, incoming_delivery_number, other_fields, company_id, category_id)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, 
-				$22, $23, $24, $25, $26, $27, $28)`,
+				$22, $23, $24, $25, $26, $27, $28, $29)`,
 		domain.TablePlanningMaterialsArchive)
 
 	_, err = tx.ExecContext(ctx, query,
@@ -261,10 +239,10 @@ func (mr *MaterialsPostgresRepository) MovePlanningToPurchased(ctx context.Conte
 		material.SupplierID, material.Location, material.Contract, material.File, material.Status, material.Comments,
 		material.Reserve, material.ReceivedDate, material.LastUpdated, material.MinStockLevel, material.ExpirationDate,
 		material.ResponsiblePerson, material.StorageCost, material.WarehouseSection,
-		material.IncomingDeliveryNumber, otherFieldsJSON, material.CompanyID,
+		material.IncomingDeliveryNumber, otherFieldsJSON, material.CompanyID, material.CategoryID,
 	)
 	if err != nil {
-		return 0, 0, fmt.Errorf("failed to insert purchased archive material: %v", err)
+		return 0, 0, fmt.Errorf("failed to insert purchased archive material: %w", dbError(err))
 	}
 
 	return newId, itemId, tx.Commit()
@@ -280,25 +258,39 @@ func (mr *MaterialsPostgresRepository) CreatePurchased(ctx context.Context, mate
 		INSERT INTO %s (warehouse_id, name, by_invoice, article, product_category, unit, total_quantity, volume, 
 						price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve, 
 						received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost, 
-						warehouse_section, incoming_delivery_number, other_fields, company_id)
+						warehouse_section, incoming_delivery_number, other_fields, company_id, bin_id, category_id)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, 
-				$22, $23, $24, $25, $26, $27) RETURNING id, item_id`,
+				$22, $23, $24, $25, $26, $27, $28, $29) RETURNING id, item_id`,
 		domain.TablePurchasedMaterials)
 
+	tx, err := beginTx(ctx, mr.psql)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
 	var id int64
 	var itemId int64
-	if err = mr.psql.QueryRowContext(ctx, query,
+	if err = tx.QueryRowContext(ctx, query,
 		material.WarehouseID, material.Name, material.ByInvoice, material.Article, material.ProductCategory,
 		material.Unit, material.TotalQuantity, material.Volume, material.PriceWithoutVAT, material.TotalWithoutVAT,
 		material.SupplierID, material.Location, material.Contract, material.File, material.Status, material.Comments,
 		material.Reserve, material.ReceivedDate, material.LastUpdated, material.MinStockLevel, material.ExpirationDate,
 		material.ResponsiblePerson, material.StorageCost, material.WarehouseSection,
-		material.IncomingDeliveryNumber, otherFieldsJSON, material.CompanyID,
+		material.IncomingDeliveryNumber, otherFieldsJSON, material.CompanyID, material.BinID, material.CategoryID,
 	).Scan(&id, &itemId); err != nil {
-		return 0, 0, fmt.Errorf("failed to insert purchased material: %v", err)
+		return 0, 0, fmt.Errorf("failed to insert purchased material: %w", dbError(err))
 	}
 
-	return id, itemId, nil
+	if err = recordOpeningBalance(ctx, tx.Tx, material, id, itemId); err != nil {
+		return 0, 0, err
+	}
+
+	return id, itemId, tx.Commit()
 }
 
 func (mr *MaterialsPostgresRepository) UpdatePurchased(ctx context.Context, material domain.Material) error {
@@ -310,128 +302,137 @@ func (mr *MaterialsPostgresRepository) UpdatePurchased(ctx context.Context, mate
 	query := fmt.Sprintf(`
 		UPDATE %s
 		SET
-			warehouse_id = $1, item_id = $2, name = $3, by_invoice = $4, article = $5, product_category = $6, unit = $7,
-			total_quantity = $8, volume = $9, price_without_vat = $10, total_without_vat = $11, supplier_id = $12, location = $13,
-			contract = $14, file = $15, status = $16, comments = $17, reserve = $18, received_date = $19, last_updated = $20,
-			min_stock_level = $21, expiration_date = $22, responsible_person = $23, storage_cost = $24, warehouse_section = $25,
-			incoming_delivery_number = $26, other_fields = $27
-		WHERE id = $28`,
+			name = $1, by_invoice = $2, article = $3, product_category = $4, unit = $5,
+			total_quantity = $6, volume = $7, price_without_vat = $8, total_without_vat = $9, supplier_id = $10, location = $11,
+			contract = $12, file = $13, status = $14, comments = $15, reserve = $16, received_date = $17, last_updated = $18,
+			min_stock_level = $19, expiration_date = $20, responsible_person = $21, storage_cost = $22, warehouse_section = $23,
+			incoming_delivery_number = $24, other_fields = $25, bin_id = $26, category_id = $27
+		WHERE id = $28 AND company_id = $29`,
 		domain.TablePurchasedMaterials)
 
-	_, err = mr.psql.ExecContext(ctx, query,
-		material.WarehouseID, material.ItemID, material.Name, material.ByInvoice, material.Article, material.ProductCategory,
-		material.Unit, material.TotalQuantity, material.Volume, material.PriceWithoutVAT, material.TotalWithoutVAT,
-		material.SupplierID, material.Location, material.Contract, material.File, material.Status, material.Comments,
-		material.Reserve, material.ReceivedDate, material.LastUpdated, material.MinStockLevel, material.ExpirationDate,
-		material.ResponsiblePerson, material.StorageCost, material.WarehouseSection,
-		material.IncomingDeliveryNumber, otherFieldsJSON, material.ID,
-	)
+	tx, err := beginTx(ctx, mr.psql)
+	if err != nil {
+		return err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
 
-	return err
-}
+	// количество меняется только через журнал движения, разницу проводим корректировкой
+	lot, err := lockLot(ctx, tx.Tx, material.ID, material.CompanyID)
+	if err != nil {
+		return err
+	}
 
-func (mr *MaterialsPostgresRepository) DeletePurchased(ctx context.Context, id int64) error {
-	_, err := mr.psql.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1", domain.TablePurchasedMaterials), id)
-	return err
-}
+	// склад меняется только перемещением, товар партии не меняется
+	if material.WarehouseID != lot.warehouseId {
+		return fmt.Errorf("%w: warehouse is changed by transfer only", domain.ErrInvalidMovement)
+	}
 
-func (mr *MaterialsPostgresRepository) GetPurchasedById(ctx context.Context, id int64) (domain.Material, error) {
-	return mr.getPurchasedById(ctx, id)
-}
+	if material.ItemID != lot.itemId {
+		return fmt.Errorf("%w: item can`t be changed", domain.ErrInvalidMovement)
+	}
 
-func (mr *MaterialsPostgresRepository) getPurchasedById(ctx context.Context, id int64) (domain.Material, error) {
-	query := fmt.Sprintf(`
-	SELECT 
-	    id, warehouse_id, item_id, name, by_invoice, article, product_category, unit, total_quantity, volume,
-		price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve,
-		received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost,
-		warehouse_section, incoming_delivery_number, other_fields, company_id
-	FROM %s WHERE id = $1
-	`, domain.TablePurchasedMaterials)
-
-	var material domain.Material
-	var otherFieldsJSON []byte
-
-	if err := mr.psql.QueryRowContext(ctx, query, id).Scan(
-		&material.ID, &material.WarehouseID, &material.ItemID, &material.Name, &material.ByInvoice, &material.Article,
-		&material.ProductCategory, &material.Unit, &material.TotalQuantity, &material.Volume,
-		&material.PriceWithoutVAT, &material.TotalWithoutVAT, &material.SupplierID, &material.Location,
-		&material.Contract, &material.File, &material.Status, &material.Comments, &material.Reserve,
-		&material.ReceivedDate, &material.LastUpdated, &material.MinStockLevel, &material.ExpirationDate,
-		&material.ResponsiblePerson, &material.StorageCost, &material.WarehouseSection,
-		&material.IncomingDeliveryNumber, &otherFieldsJSON, &material.CompanyID,
-	); err != nil {
-		return domain.Material{}, err
+	if material.TotalQuantity < lot.reserved {
+		return fmt.Errorf("%w: quantity is less than reserved %d", domain.ErrInsufficientStock, lot.reserved)
 	}
 
-	if err := json.Unmarshal(otherFieldsJSON, &material.OtherFields); err != nil {
-		return domain.Material{}, err
+	if material.TotalQuantity != lot.onHand {
+		if _, err = insertMovement(ctx, tx.Tx, domain.Movement{
+			CompanyID:    lot.companyId,
+			MaterialID:   lot.id,
+			ItemID:       lot.itemId,
+			WarehouseID:  lot.warehouseId,
+			Type:         domain.MovementTypeAdjustment,
+			Quantity:     material.TotalQuantity - lot.onHand,
+			BalanceAfter: material.TotalQuantity,
+			Comment:      "material update",
+		}); err != nil {
+			return err
+		}
 	}
 
-	return material, nil
+	if _, err = tx.ExecContext(ctx, query,
+		material.Name, material.ByInvoice, material.Article, material.ProductCategory,
+		material.Unit, material.TotalQuantity, material.Volume, material.PriceWithoutVAT, material.TotalWithoutVAT,
+		material.SupplierID, material.Location, material.Contract, material.File, material.Status, material.Comments,
+		material.Reserve, material.ReceivedDate, material.LastUpdated, material.MinStockLevel, material.ExpirationDate,
+		material.ResponsiblePerson, material.StorageCost, material.WarehouseSection,
+		material.IncomingDeliveryNumber, otherFieldsJSON, material.BinID, material.CategoryID, material.ID, material.CompanyID,
+	); err != nil {
+		return fmt.Errorf("failed to update purchased material: %w", dbError(err))
+	}
+
+	return tx.Commit()
 }
 
-func (mr *MaterialsPostgresRepository) GetPurchasedList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error) {
-	query := fmt.Sprintf(`
-	SELECT 
-	    id, warehouse_id, item_id, name, by_invoice, article, product_category, unit, total_quantity, volume,
-		price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve,
-		received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost,
-		warehouse_section, incoming_delivery_number, other_fields, company_id
-	FROM %s WHERE company_id = $1 LIMIT $2 OFFSET $3
-	`, domain.TablePurchasedMaterials)
-
-	rows, err := mr.psql.QueryContext(ctx, query, params.CompanyId, params.Limit, params.Offset)
+func (mr *MaterialsPostgresRepository) DeletePurchased(ctx context.Context, id, companyId int64) error {
+	tx, err := beginTx(ctx, mr.psql)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer func(rows *sql.Rows) {
-		if err = rows.Close(); err != nil {
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
 			return
 		}
-	}(rows)
+	}(tx)
 
-	var materials []domain.Material
+	lot, err := lockLot(ctx, tx.Tx, id, companyId)
+	if err != nil {
+		return err
+	}
 
-	for rows.Next() {
-		var material domain.Material
-		var otherFieldsJSON []byte
-
-		if err = rows.Scan(
-			&material.ID, &material.WarehouseID, &material.ItemID, &material.Name, &material.ByInvoice, &material.Article,
-			&material.ProductCategory, &material.Unit, &material.TotalQuantity, &material.Volume,
-			&material.PriceWithoutVAT, &material.TotalWithoutVAT, &material.SupplierID, &material.Location,
-			&material.Contract, &material.File, &material.Status, &material.Comments, &material.Reserve,
-			&material.ReceivedDate, &material.LastUpdated, &material.MinStockLevel, &material.ExpirationDate,
-			&material.ResponsiblePerson, &material.StorageCost, &material.WarehouseSection,
-			&material.IncomingDeliveryNumber, &otherFieldsJSON, &material.CompanyID,
-		); err != nil {
-			return nil, err
-		}
+	if err = closeLot(ctx, tx.Tx, lot, "material deleted"); err != nil {
+		return err
+	}
 
-		if err = json.Unmarshal(otherFieldsJSON, &material.OtherFields); err != nil {
-			return nil, err
-		}
+	res, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1 AND company_id = $2",
+		domain.TablePurchasedMaterials), id, companyId)
+	if err != nil {
+		return dbError(err)
+	}
 
-		materials = append(materials, material)
+	if err = checkAffected(res, domain.ErrMaterialNotFound); err != nil {
+		return err
 	}
 
-	return materials, nil
+	return tx.Commit()
+}
+
+func (mr *MaterialsPostgresRepository) GetPurchasedById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return mr.getPurchasedById(ctx, id, companyId)
+}
+
+func (mr *MaterialsPostgresRepository) getPurchasedById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return mr.getById(ctx, domain.TablePurchasedMaterials, purchasedColumns, scanPurchased, id, companyId)
 }
 
-func (mr *MaterialsPostgresRepository) MovePurchasedToArchive(ctx context.Context, id int64) error {
+func (mr *MaterialsPostgresRepository) GetPurchasedList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
+	return mr.getList(ctx, domain.TablePurchasedMaterials, purchasedColumns, params, scanPurchased)
+}
+
+func (mr *MaterialsPostgresRepository) MovePurchasedToArchive(ctx context.Context, id, companyId int64) error {
 	// Удаляем из purchased и переносим сразу в archived
-	tx, err := mr.psql.Begin()
+	tx, err := beginTx(ctx, mr.psql)
 	if err != nil {
 		return err
 	}
-	defer func(tx *sql.Tx) {
+	defer func(tx *repoTx) {
 		if err = tx.Rollback(); err != nil {
 			return
 		}
 	}(tx)
 
-	material, err := mr.getPurchasedById(ctx, id)
+	lot, err := lockLot(ctx, tx.Tx, id, companyId)
+	if err != nil {
+		return err
+	}
+
+	// строка уже заблокирована lockLot, читаем ее в той же транзакции
+	material, err := scanPurchased(tx.QueryRowContext(ctx, fmt.Sprintf("SELECT %s FROM %s WHERE id = $1",
+		purchasedColumns, domain.TablePurchasedMaterials), id))
 	if err != nil {
 		return err
 	}
@@ -441,11 +442,20 @@ func (mr *MaterialsPostgresRepository) MovePurchasedToArchive(ctx context.Contex
 		return fmt.Errorf("failed to marshal other_fields to JSON: %v", err)
 	}
 
+	if err = closeLot(ctx, tx.Tx, lot, "material archived"); err != nil {
+		return err
+	}
+
 	// 1. удаляем из purchased
-	query := fmt.Sprintf("DELETE FROM %s WHERE id = $1",
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = $1 AND company_id = $2",
 		domain.TablePurchasedMaterials)
 
-	if _, err = tx.ExecContext(ctx, query, id); err != nil {
+	res, err := tx.ExecContext(ctx, query, id, companyId)
+	if err != nil {
+		return err
+	}
+
+	if err = checkAffected(res, domain.ErrMaterialNotFound); err != nil {
 		return err
 	}
 
@@ -454,9 +464,9 @@ func (mr *MaterialsPostgresRepository) MovePurchasedToArchive(ctx context.Contex
 		INSERT INTO %s (warehouse_id, item_id, name, by_invoice, article, product_category, unit, total_quantity, volume, 
 						price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve, 
 						received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost, 
-						warehouse_section, incoming_delivery_number, other_fields, company_id)
+						warehouse_section, incoming_delivery_number, other_fields, company_id, bin_id, category_id)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, 
-				$22, $23, $24, $25, $26, $27, $28)`,
+				$22, $23, $24, $25, $26, $27, $28, $29, $30)`,
 		domain.TablePurchasedMaterialsArchive)
 
 	_, err = tx.ExecContext(ctx, query,
@@ -465,183 +475,65 @@ func (mr *MaterialsPostgresRepository) MovePurchasedToArchive(ctx context.Contex
 		material.SupplierID, material.Location, material.Contract, material.File, material.Status, material.Comments,
 		material.Reserve, material.ReceivedDate, material.LastUpdated, material.MinStockLevel, material.ExpirationDate,
 		material.ResponsiblePerson, material.StorageCost, material.WarehouseSection,
-		material.IncomingDeliveryNumber, otherFieldsJSON, material.CompanyID,
+		material.IncomingDeliveryNumber, otherFieldsJSON, material.CompanyID, material.BinID, material.CategoryID,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to insert purchased material archive: %v", err)
+		return fmt.Errorf("failed to insert purchased material archive: %w", dbError(err))
 	}
 
 	return tx.Commit()
 }
 
-func (mr *MaterialsPostgresRepository) GetPlanningArchiveById(ctx context.Context, id int64) (domain.Material, error) {
+// PutAway размещает купленную партию в ячейке, секция и место хранения заполняются по адресу ячейки
+func (mr *MaterialsPostgresRepository) PutAway(ctx context.Context, id, companyId, binId int64, section, location string) error {
 	query := fmt.Sprintf(`
-	SELECT 
-	    id, warehouse_id, item_id, name, by_invoice, article, product_category, unit, total_quantity, volume,
-		price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve,
-		received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost,
-		warehouse_section, incoming_delivery_number, other_fields, company_id
-	FROM %s WHERE id = $1
-	`, domain.TablePlanningMaterialsArchive)
-
-	var material domain.Material
-	var otherFieldsJSON []byte
-
-	if err := mr.psql.QueryRowContext(ctx, query, id).Scan(
-		&material.ID, &material.WarehouseID, &material.ItemID, &material.Name, &material.ByInvoice, &material.Article,
-		&material.ProductCategory, &material.Unit, &material.TotalQuantity, &material.Volume,
-		&material.PriceWithoutVAT, &material.TotalWithoutVAT, &material.SupplierID, &material.Location,
-		&material.Contract, &material.File, &material.Status, &material.Comments, &material.Reserve,
-		&material.ReceivedDate, &material.LastUpdated, &material.MinStockLevel, &material.ExpirationDate,
-		&material.ResponsiblePerson, &material.StorageCost, &material.WarehouseSection,
-		&material.IncomingDeliveryNumber, &otherFieldsJSON, &material.CompanyID,
-	); err != nil {
-		return domain.Material{}, err
-	}
+		UPDATE %s
+		SET bin_id = $1, warehouse_section = $2, location = $3, last_updated = CURRENT_TIMESTAMP
+		WHERE id = $4 AND company_id = $5`,
+		domain.TablePurchasedMaterials)
 
-	if err := json.Unmarshal(otherFieldsJSON, &material.OtherFields); err != nil {
-		return domain.Material{}, err
+	res, err := conn(ctx, mr.psql).ExecContext(ctx, query, binId, section, location, id, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to put away purchased material: %w", dbError(err))
 	}
 
-	return material, nil
+	return checkAffected(res, domain.ErrMaterialNotFound)
 }
 
-func (mr *MaterialsPostgresRepository) GetPurchasedArchiveById(ctx context.Context, id int64) (domain.Material, error) {
-	query := fmt.Sprintf(`
-	SELECT 
-	    id, warehouse_id, item_id, name, by_invoice, article, product_category, unit, total_quantity, volume,
-		price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve,
-		received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost,
-		warehouse_section, incoming_delivery_number, other_fields, company_id
-	FROM %s WHERE id = $1
-	`, domain.TablePurchasedMaterialsArchive)
-
-	var material domain.Material
-	var otherFieldsJSON []byte
-
-	if err := mr.psql.QueryRowContext(ctx, query, id).Scan(
-		&material.ID, &material.WarehouseID, &material.ItemID, &material.Name, &material.ByInvoice, &material.Article,
-		&material.ProductCategory, &material.Unit, &material.TotalQuantity, &material.Volume,
-		&material.PriceWithoutVAT, &material.TotalWithoutVAT, &material.SupplierID, &material.Location,
-		&material.Contract, &material.File, &material.Status, &material.Comments, &material.Reserve,
-		&material.ReceivedDate, &material.LastUpdated, &material.MinStockLevel, &material.ExpirationDate,
-		&material.ResponsiblePerson, &material.StorageCost, &material.WarehouseSection,
-		&material.IncomingDeliveryNumber, &otherFieldsJSON, &material.CompanyID,
-	); err != nil {
-		return domain.Material{}, err
-	}
-
-	if err := json.Unmarshal(otherFieldsJSON, &material.OtherFields); err != nil {
-		return domain.Material{}, err
-	}
-
-	return material, nil
+func (mr *MaterialsPostgresRepository) GetPlanningArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return mr.getById(ctx, domain.TablePlanningMaterialsArchive, planningColumns, scanPlanning, id, companyId)
 }
 
-func (mr *MaterialsPostgresRepository) GetPlanningArchiveList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error) {
-	query := fmt.Sprintf(`
-	SELECT 
-	    id, warehouse_id, item_id, name, by_invoice, article, product_category, unit, total_quantity, volume,
-		price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve,
-		received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost,
-		warehouse_section, incoming_delivery_number, other_fields, company_id
-	FROM %s WHERE company_id = $1 LIMIT $2 OFFSET $3
-	`, domain.TablePlanningMaterialsArchive)
-
-	rows, err := mr.psql.QueryContext(ctx, query, params.CompanyId, params.Limit, params.Offset)
-	if err != nil {
-		return nil, err
-	}
-	defer func(rows *sql.Rows) {
-		if err = rows.Close(); err != nil {
-			return
-		}
-	}(rows)
-
-	var materials []domain.Material
-
-	for rows.Next() {
-		var material domain.Material
-		var otherFieldsJSON []byte
-
-		if err = rows.Scan(
-			&material.ID, &material.WarehouseID, &material.ItemID, &material.Name, &material.ByInvoice, &material.Article,
-			&material.ProductCategory, &material.Unit, &material.TotalQuantity, &material.Volume,
-			&material.PriceWithoutVAT, &material.TotalWithoutVAT, &material.SupplierID, &material.Location,
-			&material.Contract, &material.File, &material.Status, &material.Comments, &material.Reserve,
-			&material.ReceivedDate, &material.LastUpdated, &material.MinStockLevel, &material.ExpirationDate,
-			&material.ResponsiblePerson, &material.StorageCost, &material.WarehouseSection,
-			&material.IncomingDeliveryNumber, &otherFieldsJSON, &material.CompanyID,
-		); err != nil {
-			return nil, err
-		}
-
-		if err = json.Unmarshal(otherFieldsJSON, &material.OtherFields); err != nil {
-			return nil, err
-		}
+func (mr *MaterialsPostgresRepository) GetPurchasedArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return mr.getById(ctx, domain.TablePurchasedMaterialsArchive, purchasedColumns, scanPurchased, id, companyId)
+}
 
-		materials = append(materials, material)
-	}
+func (mr *MaterialsPostgresRepository) GetPlanningArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
+	return mr.getList(ctx, domain.TablePlanningMaterialsArchive, planningColumns, params, scanPlanning)
+}
 
-	return materials, nil
+func (mr *MaterialsPostgresRepository) GetPurchasedArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
+	return mr.getList(ctx, domain.TablePurchasedMaterialsArchive, purchasedColumns, params, scanPurchased)
 }
 
-func (mr *MaterialsPostgresRepository) GetPurchasedArchiveList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error) {
-	query := fmt.Sprintf(`
-	SELECT 
-	    id, warehouse_id, item_id, name, by_invoice, article, product_category, unit, total_quantity, volume,
-		price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve,
-		received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost,
-		warehouse_section, incoming_delivery_number, other_fields, company_id
-	FROM %s WHERE company_id = $1 LIMIT $2 OFFSET $3
-	`, domain.TablePurchasedMaterialsArchive)
-
-	rows, err := mr.psql.QueryContext(ctx, query, params.CompanyId, params.Limit, params.Offset)
+func (mr *MaterialsPostgresRepository) DeletePlanningArchive(ctx context.Context, id, companyId int64) error {
+	res, err := conn(ctx, mr.psql).ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1 AND company_id = $2",
+		domain.TablePlanningMaterialsArchive), id, companyId)
 	if err != nil {
-		return nil, err
+		return dbError(err)
 	}
-	defer func(rows *sql.Rows) {
-		if err = rows.Close(); err != nil {
-			return
-		}
-	}(rows)
-
-	var materials []domain.Material
-
-	for rows.Next() {
-		var material domain.Material
-		var otherFieldsJSON []byte
-
-		if err = rows.Scan(
-			&material.ID, &material.WarehouseID, &material.ItemID, &material.Name, &material.ByInvoice, &material.Article,
-			&material.ProductCategory, &material.Unit, &material.TotalQuantity, &material.Volume,
-			&material.PriceWithoutVAT, &material.TotalWithoutVAT, &material.SupplierID, &material.Location,
-			&material.Contract, &material.File, &material.Status, &material.Comments, &material.Reserve,
-			&material.ReceivedDate, &material.LastUpdated, &material.MinStockLevel, &material.ExpirationDate,
-			&material.ResponsiblePerson, &material.StorageCost, &material.WarehouseSection,
-			&material.IncomingDeliveryNumber, &otherFieldsJSON, &material.CompanyID,
-		); err != nil {
-			return nil, err
-		}
 
-		if err = json.Unmarshal(otherFieldsJSON, &material.OtherFields); err != nil {
-			return nil, err
-		}
-
-		materials = append(materials, material)
-	}
-
-	return materials, nil
+	return checkAffected(res, domain.ErrMaterialNotFound)
 }
 
-func (mr *MaterialsPostgresRepository) DeletePlanningArchive(ctx context.Context, id int64) error {
-	_, err := mr.psql.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1", domain.TablePlanningMaterialsArchive), id)
-	return err
-}
+func (mr *MaterialsPostgresRepository) DeletePurchasedArchive(ctx context.Context, id, companyId int64) error {
+	res, err := conn(ctx, mr.psql).ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1 AND company_id = $2",
+		domain.TablePurchasedMaterialsArchive), id, companyId)
+	if err != nil {
+		return dbError(err)
+	}
 
-func (mr *MaterialsPostgresRepository) DeletePurchasedArchive(ctx context.Context, id int64) error {
-	_, err := mr.psql.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1", domain.TablePurchasedMaterialsArchive), id)
-	return err
+	return checkAffected(res, domain.ErrMaterialNotFound)
 }
 
 func (mr *MaterialsPostgresRepository) Search(ctx context.Context, param domain.Param) ([]domain.Material, error) {
@@ -674,7 +566,7 @@ func (mr *MaterialsPostgresRepository) Search(ctx context.Context, param domain.
 
 	searchPattern := param.Query + "%"
 
-	rows, err := mr.psql.QueryContext(ctx, sqlQuery, searchPattern, param.CompanyId, param.Limit, param.Offset)
+	rows, err := conn(ctx, mr.psql).QueryContext(ctx, sqlQuery, searchPattern, param.CompanyId, param.Limit, param.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -703,3 +595,63 @@ func (mr *MaterialsPostgresRepository) Search(ctx context.Context, param domain.
 
 	return materials, nil
 }
+
+// checkAffected возвращает notFound, если запрос не затронул ни одной строки,
+// в том числе когда запись принадлежит другой компании
+func checkAffected(res sql.Result, notFound error) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return notFound
+	}
+
+	return nil
+}
+
+// GetReferencing возвращает материалы в планировании и купленные, ссылающиеся на поставщика или склад,
+// и блокирует их до конца транзакции. Архив хранит ссылку как историю и не проверяется.
+func (mr *MaterialsPostgresRepository) GetReferencing(ctx context.Context, ref domain.MaterialReference) (domain.ReferencingMaterials, error) {
+	var refs domain.ReferencingMaterials
+
+	for _, t := range []struct {
+		table string
+		ids   *[]int64
+	}{
+		{domain.TablePlanningMaterials, &refs.Planning},
+		{domain.TablePurchasedMaterials, &refs.Purchased},
+	} {
+		query := fmt.Sprintf(`
+			SELECT id FROM %s
+			WHERE company_id = $1 AND ($2 = 0 OR supplier_id = $2) AND ($3 = 0 OR warehouse_id = $3)
+			ORDER BY id FOR UPDATE`,
+			t.table)
+
+		rows, err := conn(ctx, mr.psql).QueryContext(ctx, query, ref.CompanyID, ref.SupplierID, ref.WarehouseID)
+		if err != nil {
+			return domain.ReferencingMaterials{}, err
+		}
+
+		for rows.Next() {
+			var id int64
+			if err = rows.Scan(&id); err != nil {
+				_ = rows.Close()
+				return domain.ReferencingMaterials{}, err
+			}
+
+			*t.ids = append(*t.ids, id)
+		}
+
+		if err = rows.Close(); err != nil {
+			return domain.ReferencingMaterials{}, err
+		}
+
+		if err = rows.Err(); err != nil {
+			return domain.ReferencingMaterials{}, err
+		}
+	}
+
+	return refs, nil
+}
@@ -0,0 +1,541 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"strings"
+)
+
+type Movements interface {
+	Create(ctx context.Context, req domain.MovementRequest) ([]domain.Movement, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.Movement, error)
+	GetList(ctx context.Context, params domain.MovementParams) ([]domain.Movement, error)
+	GetStockOnHand(ctx context.Context, params domain.StockParams) (int64, error)
+}
+
+type MovementsPostgresRepository struct {
+	psql *sql.DB
+}
+
+func NewMovementsPostgresRepository(psql *sql.DB) *MovementsPostgresRepository {
+	return &MovementsPostgresRepository{
+		psql: psql,
+	}
+}
+
+// stockLot заблокированная партия товара вместе с ее остатком по журналу
+type stockLot struct {
+	id          int64
+	itemId      int64
+	warehouseId int64
+	companyId   int64
+	volume      int64
+	price       float64
+	onHand      int64
+	reserved    int64 // действующие резервы по партии
+	status      string
+}
+
+// available остаток, который можно отпустить или зарезервировать
+func (l stockLot) available() int64 {
+	return l.onHand - l.reserved
+}
+
+func (l stockLot) quarantined() bool {
+	return l.status == domain.MaterialStatusQuarantine
+}
+
+func (mr *MovementsPostgresRepository) Create(ctx context.Context, req domain.MovementRequest) ([]domain.Movement, error) {
+	tx, err := beginTx(ctx, mr.psql)
+	if err != nil {
+		return nil, err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	lot, err := lockLot(ctx, tx.Tx, req.MaterialID, req.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var movements []domain.Movement
+
+	switch req.Type {
+	case domain.MovementTypeTransfer:
+		movements, err = transferLot(ctx, tx.Tx, lot, req)
+	default:
+		var m domain.Movement
+		m, err = postLotMovement(ctx, tx.Tx, lot, req)
+		movements = []domain.Movement{m}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return movements, tx.Commit()
+}
+
+// postLotMovement проводит приход, расход, списание или корректировку по партии и обновляет ее остаток
+func postLotMovement(ctx context.Context, tx *sql.Tx, lot stockLot, req domain.MovementRequest) (domain.Movement, error) {
+	var delta int64
+
+	switch req.Type {
+	case domain.MovementTypeReceipt:
+		delta = req.Quantity
+	case domain.MovementTypeIssue, domain.MovementTypeWriteOff:
+		delta = -req.Quantity
+	case domain.MovementTypeAdjustment:
+		delta = req.Quantity
+	default:
+		return domain.Movement{}, fmt.Errorf("%w: unknown type %q", domain.ErrInvalidMovement, req.Type)
+	}
+
+	if delta == 0 {
+		return domain.Movement{}, fmt.Errorf("%w: quantity can`t be zero", domain.ErrInvalidMovement)
+	}
+
+	balance := lot.onHand + delta
+	if balance < 0 {
+		return domain.Movement{}, domain.ErrInsufficientStock
+	}
+
+	// отпуск и списание не могут затронуть зарезервированное количество
+	if req.Type != domain.MovementTypeAdjustment && -delta > lot.available() {
+		return domain.Movement{}, domain.ErrInsufficientStock
+	}
+
+	// партия в карантине только списывается
+	if req.Type == domain.MovementTypeIssue && lot.quarantined() {
+		return domain.Movement{}, domain.ErrMaterialQuarantined
+	}
+
+	m, err := insertMovement(ctx, tx, domain.Movement{
+		CompanyID:    lot.companyId,
+		MaterialID:   lot.id,
+		ItemID:       lot.itemId,
+		WarehouseID:  lot.warehouseId,
+		Type:         req.Type,
+		Quantity:     delta,
+		BalanceAfter: balance,
+		Reference:    req.Reference,
+		Comment:      req.Comment,
+		CreatedBy:    req.CreatedBy,
+	})
+	if err != nil {
+		return domain.Movement{}, err
+	}
+
+	if err = setLotQuantity(ctx, tx, lot.id, balance, lot.price); err != nil {
+		return domain.Movement{}, err
+	}
+
+	return m, nil
+}
+
+// transferLot перемещает часть или всю партию на другой склад.
+// При частичном перемещении на складе назначения создается новая партия с тем же item_id,
+// объем делится пропорционально количеству.
+func transferLot(ctx context.Context, tx *sql.Tx, lot stockLot, req domain.MovementRequest) ([]domain.Movement, error) {
+	if req.Quantity <= 0 {
+		return nil, fmt.Errorf("%w: quantity must be positive", domain.ErrInvalidMovement)
+	}
+
+	if req.ToWarehouseID == 0 || req.ToWarehouseID == lot.warehouseId {
+		return nil, fmt.Errorf("%w: destination warehouse must differ from source", domain.ErrInvalidMovement)
+	}
+
+	if err := checkWarehouse(ctx, tx, req.ToWarehouseID, lot.companyId); err != nil {
+		return nil, err
+	}
+
+	if lot.quarantined() {
+		return nil, domain.ErrMaterialQuarantined
+	}
+
+	if req.Quantity > lot.available() {
+		return nil, domain.ErrInsufficientStock
+	}
+
+	targetId := lot.id
+	targetBalance := req.Quantity
+	movedVolume := lot.volume
+
+	if req.Quantity < lot.onHand {
+		movedVolume = lot.volume * req.Quantity / lot.onHand
+
+		var err error
+		targetId, err = splitLot(ctx, tx, lot.id, req.ToWarehouseID, req.Quantity, movedVolume)
+		if err != nil {
+			return nil, err
+		}
+
+		query := fmt.Sprintf(`
+			UPDATE %s
+			SET total_quantity = $1, volume = volume - $2, total_without_vat = price_without_vat * $1, last_updated = CURRENT_TIMESTAMP
+			WHERE id = $3`,
+			domain.TablePurchasedMaterials)
+
+		if _, err = tx.ExecContext(ctx, query, lot.onHand-req.Quantity, movedVolume, lot.id); err != nil {
+			return nil, fmt.Errorf("failed to update source material: %w", dbError(err))
+		}
+	} else {
+		// переносим партию целиком, склад меняется, место хранения на новом складе неизвестно
+		query := fmt.Sprintf(`
+			UPDATE %s
+			SET warehouse_id = $1, location = '', warehouse_section = '', bin_id = 0, last_updated = CURRENT_TIMESTAMP
+			WHERE id = $2`,
+			domain.TablePurchasedMaterials)
+
+		if _, err := tx.ExecContext(ctx, query, req.ToWarehouseID, lot.id); err != nil {
+			return nil, fmt.Errorf("failed to move material: %v", err)
+		}
+	}
+
+	out, err := insertMovement(ctx, tx, domain.Movement{
+		CompanyID:    lot.companyId,
+		MaterialID:   lot.id,
+		ItemID:       lot.itemId,
+		WarehouseID:  lot.warehouseId,
+		Type:         domain.MovementTypeTransfer,
+		Quantity:     -req.Quantity,
+		BalanceAfter: lot.onHand - req.Quantity,
+		Reference:    req.Reference,
+		Comment:      req.Comment,
+		CreatedBy:    req.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	in, err := insertMovement(ctx, tx, domain.Movement{
+		CompanyID:         lot.companyId,
+		MaterialID:        targetId,
+		ItemID:            lot.itemId,
+		WarehouseID:       req.ToWarehouseID,
+		Type:              domain.MovementTypeTransfer,
+		Quantity:          req.Quantity,
+		BalanceAfter:      targetBalance,
+		RelatedMovementID: out.ID,
+		Reference:         req.Reference,
+		Comment:           req.Comment,
+		CreatedBy:         req.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []domain.Movement{out, in}, nil
+}
+
+func lockLot(ctx context.Context, tx *sql.Tx, id, companyId int64) (stockLot, error) {
+	query := fmt.Sprintf(`
+		SELECT id, item_id, warehouse_id, company_id, volume, price_without_vat, status
+		FROM %s WHERE id = $1 AND company_id = $2 FOR UPDATE`,
+		domain.TablePurchasedMaterials)
+
+	var lot stockLot
+	if err := tx.QueryRowContext(ctx, query, id, companyId).Scan(
+		&lot.id, &lot.itemId, &lot.warehouseId, &lot.companyId, &lot.volume, &lot.price, &lot.status,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return stockLot{}, domain.ErrMaterialNotFound
+		}
+
+		return stockLot{}, err
+	}
+
+	query = fmt.Sprintf("SELECT COALESCE(SUM(quantity), 0) FROM %s WHERE material_id = $1", domain.TableStockMovements)
+	if err := tx.QueryRowContext(ctx, query, id).Scan(&lot.onHand); err != nil {
+		return stockLot{}, err
+	}
+
+	reserved, err := reservedQuantity(ctx, tx, id)
+	if err != nil {
+		return stockLot{}, err
+	}
+	lot.reserved = reserved
+
+	return lot, nil
+}
+
+func setLotQuantity(ctx context.Context, tx *sql.Tx, id, quantity int64, price float64) error {
+	query := fmt.Sprintf(`
+		UPDATE %s SET total_quantity = $1, total_without_vat = $2, last_updated = CURRENT_TIMESTAMP WHERE id = $3`,
+		domain.TablePurchasedMaterials)
+
+	if _, err := tx.ExecContext(ctx, query, quantity, price*float64(quantity), id); err != nil {
+		return fmt.Errorf("failed to update material quantity: %w", dbError(err))
+	}
+
+	return nil
+}
+
+// closeLot закрывает партию перед удалением или переносом в архив: остаток списывается в журнале,
+// действующие резервы по партии снимаются
+func closeLot(ctx context.Context, tx *sql.Tx, lot stockLot, comment string) error {
+	if lot.onHand != 0 {
+		if _, err := insertMovement(ctx, tx, domain.Movement{
+			CompanyID:    lot.companyId,
+			MaterialID:   lot.id,
+			ItemID:       lot.itemId,
+			WarehouseID:  lot.warehouseId,
+			Type:         domain.MovementTypeWriteOff,
+			Quantity:     -lot.onHand,
+			BalanceAfter: 0,
+			Comment:      comment,
+		}); err != nil {
+			return err
+		}
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE %s SET status = $1, closed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE material_id = $2 AND status = $3`,
+		domain.TableReservations)
+
+	if _, err := tx.ExecContext(ctx, query, domain.ReservationStatusReleased, lot.id, domain.ReservationStatusActive); err != nil {
+		return fmt.Errorf("failed to release reservations: %w", dbError(err))
+	}
+
+	return nil
+}
+
+func checkWarehouse(ctx context.Context, tx *sql.Tx, id, companyId int64) error {
+	var exists bool
+
+	query := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE id = $1 AND company_id = $2 AND deleted_at IS NULL)",
+		domain.TableWarehouse)
+	if err := tx.QueryRowContext(ctx, query, id, companyId).Scan(&exists); err != nil {
+		return err
+	}
+
+	if !exists {
+		return domain.ErrWarehouseNotFound
+	}
+
+	return nil
+}
+
+// splitLot копирует партию на другой склад с новым количеством и объемом, возвращает id новой партии
+func splitLot(ctx context.Context, tx *sql.Tx, id, warehouseId, quantity, volume int64) (int64, error) {
+	return copyLot(ctx, tx, fmt.Sprintf("%s m WHERE m.id = $4", domain.TablePurchasedMaterials),
+		id, warehouseId, quantity, volume)
+}
+
+// receiveLot создает партию на складе-получателе по снимку, сохраненному в строке заказа при отгрузке.
+// Исходная партия к приемке может быть удалена или перенесена в архив.
+func receiveLot(ctx context.Context, tx *sql.Tx, orderItemId, warehouseId, quantity, volume int64) (int64, error) {
+	source := fmt.Sprintf("%s i, jsonb_populate_record(NULL::%s, i.lot_snapshot) m WHERE i.id = $4 AND i.lot_snapshot IS NOT NULL",
+		domain.TableTransferOrderItems, domain.TablePurchasedMaterials)
+
+	newId, err := copyLot(ctx, tx, source, orderItemId, warehouseId, quantity, volume)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("%w: item %d has no shipped lot snapshot", domain.ErrInvalidTransferOrder, orderItemId)
+	}
+
+	return newId, err
+}
+
+// copyLot создает партию на складе из строки-источника m, место хранения на новом складе неизвестно
+func copyLot(ctx context.Context, tx *sql.Tx, source string, id, warehouseId, quantity, volume int64) (int64, error) {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (warehouse_id, item_id, name, by_invoice, article, product_category, unit, total_quantity, volume,
+						price_without_vat, total_without_vat, supplier_id, location, contract, file, status, comments, reserve,
+						received_date, last_updated, min_stock_level, expiration_date, responsible_person, storage_cost,
+						warehouse_section, incoming_delivery_number, other_fields, company_id, category_id)
+		SELECT $1, m.item_id, m.name, m.by_invoice, m.article, m.product_category, m.unit, $2, $3,
+			   m.price_without_vat, m.price_without_vat * $2, m.supplier_id, '', m.contract, m.file, m.status, m.comments, m.reserve,
+			   m.received_date, CURRENT_TIMESTAMP, m.min_stock_level, m.expiration_date, m.responsible_person, m.storage_cost,
+			   '', m.incoming_delivery_number, m.other_fields, m.company_id, m.category_id
+		FROM %s
+		RETURNING id`,
+		domain.TablePurchasedMaterials, source)
+
+	var newId int64
+	if err := tx.QueryRowContext(ctx, query, warehouseId, quantity, volume, id).Scan(&newId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, err
+		}
+
+		return 0, fmt.Errorf("failed to split material: %v", err)
+	}
+
+	return newId, nil
+}
+
+// recordOpeningBalance заводит в журнал начальное количество новой партии
+func recordOpeningBalance(ctx context.Context, tx *sql.Tx, material domain.Material, id, itemId int64) error {
+	if material.TotalQuantity == 0 {
+		return nil
+	}
+
+	movementType := domain.MovementTypeReceipt
+	if material.TotalQuantity < 0 {
+		movementType = domain.MovementTypeAdjustment
+	}
+
+	_, err := insertMovement(ctx, tx, domain.Movement{
+		CompanyID:    material.CompanyID,
+		MaterialID:   id,
+		ItemID:       itemId,
+		WarehouseID:  material.WarehouseID,
+		Type:         movementType,
+		Quantity:     material.TotalQuantity,
+		BalanceAfter: material.TotalQuantity,
+		Reference:    material.IncomingDeliveryNumber,
+	})
+
+	return err
+}
+
+func insertMovement(ctx context.Context, tx *sql.Tx, m domain.Movement) (domain.Movement, error) {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (company_id, material_id, item_id, warehouse_id, movement_type, quantity, balance_after,
+		                related_movement_id, reference, comment, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id, created_at`,
+		domain.TableStockMovements)
+
+	var related sql.NullInt64
+	if m.RelatedMovementID != 0 {
+		related = sql.NullInt64{Int64: m.RelatedMovementID, Valid: true}
+	}
+
+	if err := tx.QueryRowContext(ctx, query,
+		m.CompanyID, m.MaterialID, m.ItemID, m.WarehouseID, m.Type, m.Quantity, m.BalanceAfter,
+		related, m.Reference, m.Comment, m.CreatedBy,
+	).Scan(&m.ID, &m.CreatedAt); err != nil {
+		return domain.Movement{}, fmt.Errorf("failed to insert stock movement: %w", dbError(err))
+	}
+
+	return m, nil
+}
+
+func (mr *MovementsPostgresRepository) GetById(ctx context.Context, id, companyId int64) (domain.Movement, error) {
+	query := fmt.Sprintf(`
+		SELECT
+		    id, company_id, material_id, item_id, warehouse_id, movement_type, quantity, balance_after,
+		    related_movement_id, reference, comment, created_by, created_at
+		FROM %s WHERE id = $1 AND company_id = $2`,
+		domain.TableStockMovements)
+
+	m, err := scanMovement(mr.psql.QueryRowContext(ctx, query, id, companyId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Movement{}, domain.ErrMovementNotFound
+		}
+
+		return domain.Movement{}, err
+	}
+
+	return m, nil
+}
+
+func (mr *MovementsPostgresRepository) GetList(ctx context.Context, params domain.MovementParams) ([]domain.Movement, error) {
+	where := []string{"company_id = $1"}
+	args := []interface{}{params.CompanyId}
+
+	addFilter := func(column string, value interface{}) {
+		args = append(args, value)
+		where = append(where, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	if params.MaterialId != 0 {
+		addFilter("material_id", params.MaterialId)
+	}
+
+	if params.ItemId != 0 {
+		addFilter("item_id", params.ItemId)
+	}
+
+	if params.WarehouseId != 0 {
+		addFilter("warehouse_id", params.WarehouseId)
+	}
+
+	if params.Type != "" {
+		addFilter("movement_type", params.Type)
+	}
+
+	args = append(args, params.Limit, params.Offset)
+
+	query := fmt.Sprintf(`
+		SELECT
+		    id, company_id, material_id, item_id, warehouse_id, movement_type, quantity, balance_after,
+		    related_movement_id, reference, comment, created_by, created_at
+		FROM %s WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d`,
+		domain.TableStockMovements, strings.Join(where, " AND "), len(args)-1, len(args))
+
+	rows, err := mr.psql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var movements []domain.Movement
+
+	for rows.Next() {
+		m, err := scanMovement(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		movements = append(movements, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
+
+func (mr *MovementsPostgresRepository) GetStockOnHand(ctx context.Context, params domain.StockParams) (int64, error) {
+	query := fmt.Sprintf(`
+		SELECT COALESCE(SUM(quantity), 0)
+		FROM %s
+		WHERE company_id = $1
+		  AND ($2::BIGINT = 0 OR material_id = $2)
+		  AND ($3::BIGINT = 0 OR item_id = $3)
+		  AND ($4::BIGINT = 0 OR warehouse_id = $4)`,
+		domain.TableStockMovements)
+
+	var quantity int64
+	if err := mr.psql.QueryRowContext(ctx, query,
+		params.CompanyId, params.MaterialId, params.ItemId, params.WarehouseId,
+	).Scan(&quantity); err != nil {
+		return 0, err
+	}
+
+	return quantity, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanMovement(row rowScanner) (domain.Movement, error) {
+	var m domain.Movement
+	var related sql.NullInt64
+
+	if err := row.Scan(
+		&m.ID, &m.CompanyID, &m.MaterialID, &m.ItemID, &m.WarehouseID, &m.Type, &m.Quantity, &m.BalanceAfter,
+		&related, &m.Reference, &m.Comment, &m.CreatedBy, &m.CreatedAt,
+	); err != nil {
+		return domain.Movement{}, err
+	}
+
+	m.RelatedMovementID = related.Int64
+
+	return m, nil
+}
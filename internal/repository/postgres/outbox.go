@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"time"
+)
+
+type Outbox interface {
+	Add(ctx context.Context, event domain.Event) (int64, error)
+	PublishPending(ctx context.Context, limit int64, publish func(events []domain.Event) error) (int, error)
+	DeleteProcessed(ctx context.Context, before time.Time, exported bool) (int64, error)
+}
+
+type OutboxPostgresRepository struct {
+	psql *sql.DB
+}
+
+func NewOutboxPostgresRepository(psql *sql.DB) *OutboxPostgresRepository {
+	return &OutboxPostgresRepository{
+		psql: psql,
+	}
+}
+
+// Add сохраняет событие в outbox. Вызывается внутри WithinTx, чтобы событие фиксировалось вместе с изменением.
+func (or *OutboxPostgresRepository) Add(ctx context.Context, event domain.Event) (int64, error) {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (event_type, version, company_id, entity_id, payload, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		domain.TableEventOutbox)
+
+	var id int64
+	if err := conn(ctx, or.psql).QueryRowContext(ctx, query,
+		event.Type, event.Version, event.CompanyID, event.EntityID, []byte(event.Data), event.OccurredAt,
+	).Scan(&id); err != nil {
+		return 0, fmt.Errorf("failed to insert outbox event: %w", dbError(err))
+	}
+
+	return id, nil
+}
+
+// PublishPending передает publish пачку неопубликованных событий в порядке возникновения и отмечает их
+// опубликованными. Пачка блокируется до конца транзакции, поэтому несколько экземпляров сервиса
+// не публикуют одни и те же события. При ошибке publish события остаются в очереди до следующего запуска.
+func (or *OutboxPostgresRepository) PublishPending(ctx context.Context, limit int64, publish func(events []domain.Event) error) (int, error) {
+	tx, err := or.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func(tx *sql.Tx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	query := fmt.Sprintf(`
+		SELECT id, event_type, version, company_id, entity_id, payload, occurred_at
+		FROM %s WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`,
+		domain.TableEventOutbox)
+
+	rows, err := tx.QueryContext(ctx, query, limit)
+	if err != nil {
+		return 0, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var events []domain.Event
+	var ids []int64
+	for rows.Next() {
+		var e domain.Event
+		if err = rows.Scan(&e.ID, &e.Type, &e.Version, &e.CompanyID, &e.EntityID, &e.Data, &e.OccurredAt); err != nil {
+			return 0, err
+		}
+
+		events = append(events, e)
+		ids = append(ids, e.ID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	if pubErr := publish(events); pubErr != nil {
+		query = fmt.Sprintf("UPDATE %s SET attempts = attempts + 1, last_error = $1 WHERE id = ANY($2)",
+			domain.TableEventOutbox)
+
+		if _, err = tx.ExecContext(ctx, query, pubErr.Error(), pq.Array(ids)); err != nil {
+			return 0, fmt.Errorf("failed to update outbox events: %w", dbError(err))
+		}
+
+		if err = tx.Commit(); err != nil {
+			return 0, err
+		}
+
+		return 0, pubErr
+	}
+
+	query = fmt.Sprintf("UPDATE %s SET published_at = CURRENT_TIMESTAMP, attempts = attempts + 1, last_error = '' WHERE id = ANY($1)",
+		domain.TableEventOutbox)
+
+	if _, err = tx.ExecContext(ctx, query, pq.Array(ids)); err != nil {
+		return 0, fmt.Errorf("failed to update outbox events: %w", dbError(err))
+	}
+
+	return len(events), tx.Commit()
+}
+
+// DeleteProcessed удаляет события, опубликованные раньше before. При exported события материалов
+// дополнительно должны быть выгружены в аналитику раньше before.
+func (or *OutboxPostgresRepository) DeleteProcessed(ctx context.Context, before time.Time, exported bool) (int64, error) {
+	query := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE published_at < $1
+		  AND (NOT $2 OR event_type NOT LIKE 'material.%%' OR exported_at < $1)`,
+		domain.TableEventOutbox)
+
+	res, err := or.psql.ExecContext(ctx, query, before, exported)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete outbox events: %w", dbError(err))
+	}
+
+	return res.RowsAffected()
+}
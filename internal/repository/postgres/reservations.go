@@ -0,0 +1,346 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"strings"
+	"time"
+)
+
+type Reservations interface {
+	Create(ctx context.Context, reservation domain.Reservation) (int64, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.Reservation, error)
+	GetList(ctx context.Context, params domain.ReservationParams) ([]domain.Reservation, error)
+	Release(ctx context.Context, id, companyId int64) error
+	Consume(ctx context.Context, id, companyId, quantity, userId int64) (domain.Movement, error)
+	GetAvailability(ctx context.Context, materialId, companyId int64) (domain.Availability, error)
+}
+
+type ReservationsPostgresRepository struct {
+	psql *sql.DB
+}
+
+func NewReservationsPostgresRepository(psql *sql.DB) *ReservationsPostgresRepository {
+	return &ReservationsPostgresRepository{
+		psql: psql,
+	}
+}
+
+// activeReservation - условие действующего резерва, истекшие резервы остаются в статусе active,
+// но количество уже не удерживают
+const activeReservation = "status = 'active' AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)"
+
+// Create резервирует количество партии. Партия блокируется на время проверки,
+// поэтому параллельные резервы одной партии не могут в сумме превысить остаток.
+func (rr *ReservationsPostgresRepository) Create(ctx context.Context, reservation domain.Reservation) (int64, error) {
+	tx, err := beginTx(ctx, rr.psql)
+	if err != nil {
+		return 0, err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	lot, err := lockLot(ctx, tx.Tx, reservation.MaterialID, reservation.CompanyID)
+	if err != nil {
+		return 0, err
+	}
+
+	if lot.quarantined() {
+		return 0, domain.ErrMaterialQuarantined
+	}
+
+	if reservation.Quantity > lot.available() {
+		return 0, domain.ErrInsufficientStock
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (company_id, material_id, item_id, warehouse_id, quantity, order_reference, owner_id, status,
+		                comment, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id`,
+		domain.TableReservations)
+
+	var id int64
+	if err = tx.QueryRowContext(ctx, query,
+		reservation.CompanyID, lot.id, lot.itemId, lot.warehouseId, reservation.Quantity, reservation.OrderReference,
+		reservation.OwnerID, domain.ReservationStatusActive, reservation.Comment, nullTime(reservation.ExpiresAt),
+	).Scan(&id); err != nil {
+		return 0, fmt.Errorf("failed to insert reservation: %w", dbError(err))
+	}
+
+	return id, tx.Commit()
+}
+
+func (rr *ReservationsPostgresRepository) GetById(ctx context.Context, id, companyId int64) (domain.Reservation, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE id = $1 AND company_id = $2", reservationColumns, domain.TableReservations)
+
+	reservation, err := scanReservation(conn(ctx, rr.psql).QueryRowContext(ctx, query, id, companyId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Reservation{}, domain.ErrReservationNotFound
+		}
+
+		return domain.Reservation{}, err
+	}
+
+	return reservation, nil
+}
+
+func (rr *ReservationsPostgresRepository) GetList(ctx context.Context, params domain.ReservationParams) ([]domain.Reservation, error) {
+	where := []string{"company_id = $1"}
+	args := []interface{}{params.CompanyId}
+
+	if params.MaterialId != 0 {
+		args = append(args, params.MaterialId)
+		where = append(where, fmt.Sprintf("material_id = $%d", len(args)))
+	}
+
+	if params.OrderReference != "" {
+		args = append(args, params.OrderReference)
+		where = append(where, fmt.Sprintf("order_reference = $%d", len(args)))
+	}
+
+	// статус expired хранится как active, поэтому фильтр по нему строится по сроку действия
+	switch params.Status {
+	case "":
+	case domain.ReservationStatusActive:
+		where = append(where, activeReservation)
+	case domain.ReservationStatusExpired:
+		where = append(where, "status = 'active' AND expires_at <= CURRENT_TIMESTAMP")
+	default:
+		args = append(args, params.Status)
+		where = append(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	args = append(args, params.Limit, params.Offset)
+
+	query := fmt.Sprintf(`
+		SELECT %s FROM %s WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d`,
+		reservationColumns, domain.TableReservations, strings.Join(where, " AND "), len(args)-1, len(args))
+
+	rows, err := rr.psql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var reservations []domain.Reservation
+	for rows.Next() {
+		reservation, err := scanReservation(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		reservations = append(reservations, reservation)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// Release снимает резерв, израсходованное количество остается в истории резерва
+func (rr *ReservationsPostgresRepository) Release(ctx context.Context, id, companyId int64) error {
+	tx, err := beginTx(ctx, rr.psql)
+	if err != nil {
+		return err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	reservation, err := lockReservation(ctx, tx.Tx, id, companyId)
+	if err != nil {
+		return err
+	}
+
+	// истекший резерв тоже можно снять, чтобы закрыть его в истории
+	if reservation.Status != domain.ReservationStatusActive && reservation.Status != domain.ReservationStatusExpired {
+		return domain.ErrReservationStatus
+	}
+
+	if err = closeReservation(ctx, tx.Tx, id, domain.ReservationStatusReleased, reservation.ConsumedQuantity); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Consume расходует часть или весь резерв: проводит отпуск по партии в журнале движений
+// и уменьшает удерживаемое резервом количество
+func (rr *ReservationsPostgresRepository) Consume(ctx context.Context, id, companyId, quantity, userId int64) (domain.Movement, error) {
+	tx, err := beginTx(ctx, rr.psql)
+	if err != nil {
+		return domain.Movement{}, err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	reservation, err := lockReservation(ctx, tx.Tx, id, companyId)
+	if err != nil {
+		return domain.Movement{}, err
+	}
+
+	if reservation.Status != domain.ReservationStatusActive {
+		return domain.Movement{}, domain.ErrReservationStatus
+	}
+
+	if quantity > reservation.Remaining() {
+		return domain.Movement{}, fmt.Errorf("%w: quantity exceeds reserved %d", domain.ErrInvalidReservation, reservation.Remaining())
+	}
+
+	lot, err := lockLot(ctx, tx.Tx, reservation.MaterialID, companyId)
+	if err != nil {
+		return domain.Movement{}, err
+	}
+
+	// расходуемое количество перестает удерживаться резервом до проведения отпуска
+	lot.reserved -= quantity
+
+	m, err := postLotMovement(ctx, tx.Tx, lot, domain.MovementRequest{
+		CompanyID:  companyId,
+		MaterialID: lot.id,
+		Type:       domain.MovementTypeIssue,
+		Quantity:   quantity,
+		Reference:  reservation.OrderReference,
+		Comment:    reservationReference(reservation.ID),
+		CreatedBy:  userId,
+	})
+	if err != nil {
+		return domain.Movement{}, err
+	}
+
+	consumed := reservation.ConsumedQuantity + quantity
+
+	if consumed == reservation.Quantity {
+		err = closeReservation(ctx, tx.Tx, id, domain.ReservationStatusConsumed, consumed)
+	} else {
+		query := fmt.Sprintf("UPDATE %s SET consumed_quantity = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2",
+			domain.TableReservations)
+
+		if _, err = tx.ExecContext(ctx, query, consumed, id); err != nil {
+			err = fmt.Errorf("failed to update reservation: %w", dbError(err))
+		}
+	}
+	if err != nil {
+		return domain.Movement{}, err
+	}
+
+	return m, tx.Commit()
+}
+
+func (rr *ReservationsPostgresRepository) GetAvailability(ctx context.Context, materialId, companyId int64) (domain.Availability, error) {
+	query := fmt.Sprintf(`
+		SELECT
+		    (SELECT COALESCE(SUM(quantity), 0) FROM %s WHERE material_id = m.id),
+		    (SELECT COALESCE(SUM(quantity - consumed_quantity), 0) FROM %s WHERE material_id = m.id AND %s)
+		FROM %s m WHERE m.id = $1 AND m.company_id = $2`,
+		domain.TableStockMovements, domain.TableReservations, activeReservation, domain.TablePurchasedMaterials)
+
+	availability := domain.Availability{MaterialID: materialId}
+	if err := conn(ctx, rr.psql).QueryRowContext(ctx, query, materialId, companyId).Scan(
+		&availability.OnHand, &availability.Reserved,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Availability{}, domain.ErrMaterialNotFound
+		}
+
+		return domain.Availability{}, err
+	}
+
+	availability.Available = availability.OnHand - availability.Reserved
+
+	return availability, nil
+}
+
+// reservedQuantity сумма действующих резервов по партии
+func reservedQuantity(ctx context.Context, tx *sql.Tx, materialId int64) (int64, error) {
+	query := fmt.Sprintf("SELECT COALESCE(SUM(quantity - consumed_quantity), 0) FROM %s WHERE material_id = $1 AND %s",
+		domain.TableReservations, activeReservation)
+
+	var reserved int64
+	if err := tx.QueryRowContext(ctx, query, materialId).Scan(&reserved); err != nil {
+		return 0, err
+	}
+
+	return reserved, nil
+}
+
+func reservationReference(id int64) string {
+	return fmt.Sprintf("RS-%d", id)
+}
+
+func lockReservation(ctx context.Context, tx *sql.Tx, id, companyId int64) (domain.Reservation, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE id = $1 AND company_id = $2 FOR UPDATE",
+		reservationColumns, domain.TableReservations)
+
+	reservation, err := scanReservation(tx.QueryRowContext(ctx, query, id, companyId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Reservation{}, domain.ErrReservationNotFound
+		}
+
+		return domain.Reservation{}, err
+	}
+
+	return reservation, nil
+}
+
+func closeReservation(ctx context.Context, tx *sql.Tx, id int64, status string, consumed int64) error {
+	query := fmt.Sprintf(`
+		UPDATE %s SET status = $1, consumed_quantity = $2, closed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3`,
+		domain.TableReservations)
+
+	if _, err := tx.ExecContext(ctx, query, status, consumed, id); err != nil {
+		return fmt.Errorf("failed to update reservation: %w", dbError(err))
+	}
+
+	return nil
+}
+
+const reservationColumns = `id, company_id, material_id, item_id, warehouse_id, quantity, consumed_quantity, order_reference,
+	owner_id, status, comment, expires_at, created_at, updated_at, closed_at`
+
+func scanReservation(row rowScanner) (domain.Reservation, error) {
+	var r domain.Reservation
+	var expiresAt, closedAt sql.NullTime
+
+	if err := row.Scan(
+		&r.ID, &r.CompanyID, &r.MaterialID, &r.ItemID, &r.WarehouseID, &r.Quantity, &r.ConsumedQuantity,
+		&r.OrderReference, &r.OwnerID, &r.Status, &r.Comment, &expiresAt, &r.CreatedAt, &r.UpdatedAt, &closedAt,
+	); err != nil {
+		return domain.Reservation{}, err
+	}
+
+	r.ExpiresAt = expiresAt.Time
+	r.ClosedAt = closedAt.Time
+
+	if r.IsExpired(time.Now()) {
+		r.Status = domain.ReservationStatusExpired
+	}
+
+	return r, nil
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
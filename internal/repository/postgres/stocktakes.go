@@ -0,0 +1,461 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"strings"
+)
+
+type Stocktakes interface {
+	Open(ctx context.Context, stocktake domain.Stocktake) (int64, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.Stocktake, error)
+	GetList(ctx context.Context, params domain.StocktakeParams) ([]domain.Stocktake, error)
+	Count(ctx context.Context, req domain.StocktakeCountRequest, source string) (domain.StocktakeLine, error)
+	GetCounts(ctx context.Context, id, companyId int64) ([]domain.StocktakeCount, error)
+	Approve(ctx context.Context, id, companyId, userId int64) ([]domain.Movement, error)
+	Cancel(ctx context.Context, id, companyId int64) error
+}
+
+type StocktakesPostgresRepository struct {
+	psql *sql.DB
+}
+
+func NewStocktakesPostgresRepository(psql *sql.DB) *StocktakesPostgresRepository {
+	return &StocktakesPostgresRepository{
+		psql: psql,
+	}
+}
+
+const stocktakeColumns = `
+	id, company_id, warehouse_id, zone_id, status, comment, created_by, approved_by, created_at, updated_at, closed_at`
+
+const stocktakeLineColumns = `
+	id, stocktake_id, material_id, item_id, name, location, price, expected, counted, counted_by, counted_at`
+
+// Open открывает инвентаризацию и фиксирует ожидаемые остатки партий склада или зоны по журналу движения.
+// Партии, принятые на склад после открытия, в инвентаризацию не попадают.
+func (sr *StocktakesPostgresRepository) Open(ctx context.Context, stocktake domain.Stocktake) (int64, error) {
+	tx, err := beginTx(ctx, sr.psql)
+	if err != nil {
+		return 0, err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	if err = checkWarehouse(ctx, tx.Tx, stocktake.WarehouseID, stocktake.CompanyID); err != nil {
+		return 0, err
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (company_id, warehouse_id, zone_id, status, comment, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		domain.TableStocktakes)
+
+	var id int64
+	if err = tx.QueryRowContext(ctx, query,
+		stocktake.CompanyID, stocktake.WarehouseID, stocktake.ZoneID, domain.StocktakeStatusOpen,
+		stocktake.Comment, stocktake.CreatedBy,
+	).Scan(&id); err != nil {
+		return 0, fmt.Errorf("failed to insert stocktake: %w", dbError(err))
+	}
+
+	query = fmt.Sprintf(`
+		INSERT INTO %[1]s (stocktake_id, material_id, item_id, name, location, price, expected)
+		SELECT $1, m.id, m.item_id, m.name, m.location, m.price_without_vat,
+		       (SELECT COALESCE(SUM(s.quantity), 0) FROM %[2]s s WHERE s.material_id = m.id)
+		FROM %[3]s m
+		WHERE m.warehouse_id = $2 AND m.company_id = $3
+		  AND ($4::BIGINT = 0 OR m.bin_id IN (
+		      SELECT b.id FROM %[4]s b WHERE b.path LIKE (SELECT z.path FROM %[4]s z WHERE z.id = $4) || '%%'))`,
+		domain.TableStocktakeLines, domain.TableStockMovements, domain.TablePurchasedMaterials, domain.TableStorageLocations)
+
+	if _, err = tx.ExecContext(ctx, query, id, stocktake.WarehouseID, stocktake.CompanyID, stocktake.ZoneID); err != nil {
+		return 0, fmt.Errorf("failed to insert stocktake lines: %w", dbError(err))
+	}
+
+	return id, tx.Commit()
+}
+
+func (sr *StocktakesPostgresRepository) GetById(ctx context.Context, id, companyId int64) (domain.Stocktake, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE id = $1 AND company_id = $2", stocktakeColumns, domain.TableStocktakes)
+
+	stocktake, err := scanStocktake(conn(ctx, sr.psql).QueryRowContext(ctx, query, id, companyId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Stocktake{}, domain.ErrStocktakeNotFound
+		}
+
+		return domain.Stocktake{}, err
+	}
+
+	if stocktake.Lines, err = getStocktakeLines(ctx, conn(ctx, sr.psql), stocktake.ID); err != nil {
+		return domain.Stocktake{}, err
+	}
+
+	return stocktake, nil
+}
+
+// GetList инвентаризации без строк, строки возвращает GetById
+func (sr *StocktakesPostgresRepository) GetList(ctx context.Context, params domain.StocktakeParams) ([]domain.Stocktake, error) {
+	where := []string{"company_id = $1"}
+	args := []interface{}{params.CompanyId}
+
+	if params.WarehouseId != 0 {
+		args = append(args, params.WarehouseId)
+		where = append(where, fmt.Sprintf("warehouse_id = $%d", len(args)))
+	}
+
+	if params.Status != "" {
+		args = append(args, params.Status)
+		where = append(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	args = append(args, params.Limit, params.Offset)
+
+	query := fmt.Sprintf(`
+		SELECT %s FROM %s WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d`,
+		stocktakeColumns, domain.TableStocktakes, strings.Join(where, " AND "), len(args)-1, len(args))
+
+	rows, err := conn(ctx, sr.psql).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var stocktakes []domain.Stocktake
+
+	for rows.Next() {
+		stocktake, err := scanStocktake(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		stocktakes = append(stocktakes, stocktake)
+	}
+
+	return stocktakes, rows.Err()
+}
+
+// Count записывает посчитанный остаток партии открытой инвентаризации. Партия ищется по id партии
+// и/или id товара; по id товара подсчет возможен, только если товар в инвентаризации одной партией.
+// Повторный подсчет заменяет остаток строки, все подсчеты сохраняются в stocktake_counts.
+func (sr *StocktakesPostgresRepository) Count(ctx context.Context, req domain.StocktakeCountRequest, source string) (domain.StocktakeLine, error) {
+	tx, err := beginTx(ctx, sr.psql)
+	if err != nil {
+		return domain.StocktakeLine{}, err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	// разделяемая блокировка не мешает параллельным подсчетам, но ждет утверждения или отмены
+	var status string
+	query := fmt.Sprintf("SELECT status FROM %s WHERE id = $1 AND company_id = $2 FOR SHARE", domain.TableStocktakes)
+	if err = tx.QueryRowContext(ctx, query, req.StocktakeID, req.CompanyID).Scan(&status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.StocktakeLine{}, domain.ErrStocktakeNotFound
+		}
+
+		return domain.StocktakeLine{}, err
+	}
+
+	if status != domain.StocktakeStatusOpen {
+		return domain.StocktakeLine{}, domain.ErrStocktakeStatus
+	}
+
+	where := []string{"stocktake_id = $1"}
+	args := []interface{}{req.StocktakeID}
+
+	if req.MaterialID != 0 {
+		args = append(args, req.MaterialID)
+		where = append(where, fmt.Sprintf("material_id = $%d", len(args)))
+	}
+
+	if req.ItemID != 0 {
+		args = append(args, req.ItemID)
+		where = append(where, fmt.Sprintf("item_id = $%d", len(args)))
+	}
+
+	query = fmt.Sprintf("SELECT %s FROM %s WHERE %s ORDER BY id FOR UPDATE",
+		stocktakeLineColumns, domain.TableStocktakeLines, strings.Join(where, " AND "))
+
+	lines, err := queryStocktakeLines(ctx, tx, query, args...)
+	if err != nil {
+		return domain.StocktakeLine{}, err
+	}
+
+	switch {
+	case len(lines) == 0:
+		return domain.StocktakeLine{}, fmt.Errorf("%w: material is not in stocktake", domain.ErrInvalidStocktake)
+	case len(lines) > 1:
+		return domain.StocktakeLine{}, fmt.Errorf("%w: item %d has %d materials in stocktake, count by material or qr code",
+			domain.ErrInvalidStocktake, req.ItemID, len(lines))
+	}
+
+	line := lines[0]
+
+	query = fmt.Sprintf(`
+		INSERT INTO %s (stocktake_id, line_id, quantity, source, counted_by) VALUES ($1, $2, $3, $4, $5)
+		RETURNING counted_at`,
+		domain.TableStocktakeCounts)
+
+	if err = tx.QueryRowContext(ctx, query, req.StocktakeID, line.ID, req.Quantity, source, req.CountedBy).Scan(&line.CountedAt); err != nil {
+		return domain.StocktakeLine{}, fmt.Errorf("failed to insert stocktake count: %w", dbError(err))
+	}
+
+	query = fmt.Sprintf("UPDATE %s SET counted = $1, counted_by = $2, counted_at = $3 WHERE id = $4",
+		domain.TableStocktakeLines)
+
+	if _, err = tx.ExecContext(ctx, query, req.Quantity, req.CountedBy, line.CountedAt, line.ID); err != nil {
+		return domain.StocktakeLine{}, fmt.Errorf("failed to update stocktake line: %w", dbError(err))
+	}
+
+	query = fmt.Sprintf("UPDATE %s SET updated_at = CURRENT_TIMESTAMP WHERE id = $1", domain.TableStocktakes)
+	if _, err = tx.ExecContext(ctx, query, req.StocktakeID); err != nil {
+		return domain.StocktakeLine{}, fmt.Errorf("failed to update stocktake: %w", dbError(err))
+	}
+
+	line.Counted, line.IsCounted, line.CountedBy = req.Quantity, true, req.CountedBy
+
+	return line, tx.Commit()
+}
+
+// GetCounts журнал подсчетов инвентаризации в порядке записи
+func (sr *StocktakesPostgresRepository) GetCounts(ctx context.Context, id, companyId int64) ([]domain.StocktakeCount, error) {
+	query := fmt.Sprintf(`
+		SELECT c.id, c.stocktake_id, c.line_id, l.material_id, c.quantity, c.source, c.counted_by, c.counted_at
+		FROM %s c
+		JOIN %s l ON l.id = c.line_id
+		JOIN %s s ON s.id = c.stocktake_id
+		WHERE c.stocktake_id = $1 AND s.company_id = $2
+		ORDER BY c.id`,
+		domain.TableStocktakeCounts, domain.TableStocktakeLines, domain.TableStocktakes)
+
+	rows, err := conn(ctx, sr.psql).QueryContext(ctx, query, id, companyId)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var counts []domain.StocktakeCount
+
+	for rows.Next() {
+		var c domain.StocktakeCount
+		if err = rows.Scan(&c.ID, &c.StocktakeID, &c.LineID, &c.MaterialID, &c.Quantity, &c.Source, &c.CountedBy, &c.CountedAt); err != nil {
+			return nil, err
+		}
+
+		counts = append(counts, c)
+	}
+
+	return counts, rows.Err()
+}
+
+// Approve проводит расхождения инвентаризации корректировками и закрывает ее. Расхождение строки
+// прибавляется к текущему остатку партии, поэтому движения, проведенные во время подсчета, сохраняются.
+// Утвердить можно только инвентаризацию, в которой посчитаны все партии.
+func (sr *StocktakesPostgresRepository) Approve(ctx context.Context, id, companyId, userId int64) ([]domain.Movement, error) {
+	tx, err := beginTx(ctx, sr.psql)
+	if err != nil {
+		return nil, err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE id = $1 AND company_id = $2 FOR UPDATE",
+		stocktakeColumns, domain.TableStocktakes)
+
+	stocktake, err := scanStocktake(tx.QueryRowContext(ctx, query, id, companyId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrStocktakeNotFound
+		}
+
+		return nil, err
+	}
+
+	if stocktake.Status != domain.StocktakeStatusOpen {
+		return nil, domain.ErrStocktakeStatus
+	}
+
+	lines, err := getStocktakeLines(ctx, tx, stocktake.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var uncounted int
+	for _, line := range lines {
+		if !line.IsCounted {
+			uncounted++
+		}
+	}
+
+	if uncounted > 0 {
+		return nil, fmt.Errorf("%w: %d materials are not counted", domain.ErrInvalidStocktake, uncounted)
+	}
+
+	reference := stocktakeReference(stocktake.ID)
+
+	var movements []domain.Movement
+	for _, line := range lines {
+		variance := line.Variance()
+		if variance == 0 {
+			continue
+		}
+
+		lot, err := lockLot(ctx, tx.Tx, line.MaterialID, companyId)
+		if err != nil {
+			return nil, fmt.Errorf("material %d: %w", line.MaterialID, err)
+		}
+
+		if lot.warehouseId != stocktake.WarehouseID {
+			return nil, fmt.Errorf("%w: material %d has left the warehouse", domain.ErrInvalidStocktake, line.MaterialID)
+		}
+
+		balance := lot.onHand + variance
+		if balance < 0 {
+			return nil, fmt.Errorf("material %d: %w", line.MaterialID, domain.ErrInsufficientStock)
+		}
+
+		m, err := insertMovement(ctx, tx.Tx, domain.Movement{
+			CompanyID:    companyId,
+			MaterialID:   lot.id,
+			ItemID:       lot.itemId,
+			WarehouseID:  lot.warehouseId,
+			Type:         domain.MovementTypeAdjustment,
+			Quantity:     variance,
+			BalanceAfter: balance,
+			Reference:    reference,
+			Comment:      "stocktake",
+			CreatedBy:    userId,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err = setLotQuantity(ctx, tx.Tx, lot.id, balance, lot.price); err != nil {
+			return nil, err
+		}
+
+		movements = append(movements, m)
+	}
+
+	query = fmt.Sprintf(`
+		UPDATE %s SET status = $1, approved_by = $2, closed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3`,
+		domain.TableStocktakes)
+
+	if _, err = tx.ExecContext(ctx, query, domain.StocktakeStatusClosed, userId, stocktake.ID); err != nil {
+		return nil, fmt.Errorf("failed to update stocktake: %w", dbError(err))
+	}
+
+	return movements, tx.Commit()
+}
+
+func (sr *StocktakesPostgresRepository) Cancel(ctx context.Context, id, companyId int64) error {
+	query := fmt.Sprintf(`
+		UPDATE %s SET status = $1, closed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND company_id = $3 AND status = $4`,
+		domain.TableStocktakes)
+
+	res, err := conn(ctx, sr.psql).ExecContext(ctx, query, domain.StocktakeStatusCancelled, id, companyId, domain.StocktakeStatusOpen)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		// различаем отсутствующую инвентаризацию и инвентаризацию в неподходящем статусе
+		if _, err = sr.GetById(ctx, id, companyId); err != nil {
+			return err
+		}
+
+		return domain.ErrStocktakeStatus
+	}
+
+	return nil
+}
+
+func stocktakeReference(id int64) string {
+	return fmt.Sprintf("stocktake:%d", id)
+}
+
+func getStocktakeLines(ctx context.Context, q queryer, stocktakeId int64) ([]domain.StocktakeLine, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE stocktake_id = $1 ORDER BY id", stocktakeLineColumns, domain.TableStocktakeLines)
+
+	return queryStocktakeLines(ctx, q, query, stocktakeId)
+}
+
+func queryStocktakeLines(ctx context.Context, q queryer, query string, args ...interface{}) ([]domain.StocktakeLine, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var lines []domain.StocktakeLine
+
+	for rows.Next() {
+		var line domain.StocktakeLine
+		var counted sql.NullInt64
+		var countedAt sql.NullTime
+
+		if err = rows.Scan(
+			&line.ID, &line.StocktakeID, &line.MaterialID, &line.ItemID, &line.Name, &line.Location, &line.Price,
+			&line.Expected, &counted, &line.CountedBy, &countedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		line.Counted, line.IsCounted = counted.Int64, counted.Valid
+		line.CountedAt = countedAt.Time
+		lines = append(lines, line)
+	}
+
+	return lines, rows.Err()
+}
+
+func scanStocktake(row rowScanner) (domain.Stocktake, error) {
+	var s domain.Stocktake
+	var closedAt sql.NullTime
+
+	if err := row.Scan(
+		&s.ID, &s.CompanyID, &s.WarehouseID, &s.ZoneID, &s.Status, &s.Comment, &s.CreatedBy, &s.ApprovedBy,
+		&s.CreatedAt, &s.UpdatedAt, &closedAt,
+	); err != nil {
+		return domain.Stocktake{}, err
+	}
+
+	s.ClosedAt = closedAt.Time
+
+	return s, nil
+}
@@ -4,16 +4,22 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"time"
 )
 
 type Suppliers interface {
 	Create(ctx context.Context, supplier domain.Supplier) (int64, error)
-	GetById(ctx context.Context, id int64) (domain.Supplier, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.Supplier, error)
 	Update(ctx context.Context, supplier domain.Supplier) error
-	Delete(ctx context.Context, id int64) error
-	GetListByCompanyId(ctx context.Context, id int64) ([]domain.Supplier, error)
+	Delete(ctx context.Context, id, companyId int64) error
+	GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Supplier, error)
+
+	GetDeletedById(ctx context.Context, id, companyId int64) (domain.Supplier, error)
+	Restore(ctx context.Context, id, companyId int64) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
 }
 
 type SuppliersPostgresRepository struct {
@@ -24,6 +30,12 @@ func NewSuppliersPostgresRepository(psql *sql.DB) *SuppliersPostgresRepository {
 	return &SuppliersPostgresRepository{psql: psql}
 }
 
+// Условия мягкого удаления поставщиков, складов и категорий материалов
+const (
+	notDeleted = "deleted_at IS NULL"
+	isDeleted  = "deleted_at IS NOT NULL"
+)
+
 func (sr *SuppliersPostgresRepository) Create(ctx context.Context, supplier domain.Supplier) (int64, error) {
 	otherFieldsJSON, err := json.Marshal(supplier.OtherFields)
 	if err != nil {
@@ -39,35 +51,45 @@ func (sr *SuppliersPostgresRepository) Create(ctx context.Context, supplier doma
 		domain.TableSupplier)
 
 	var id int64
-	if err = sr.psql.QueryRowContext(ctx, query, supplier.Name, supplier.LegalAddress, supplier.ActualAddress,
+	if err = conn(ctx, sr.psql).QueryRowContext(ctx, query, supplier.Name, supplier.LegalAddress, supplier.ActualAddress,
 		supplier.WarehouseAddress, supplier.ContactPerson, supplier.Phone, supplier.Email, supplier.Website,
 		supplier.ContractNumber, supplier.ProductCategories, supplier.PurchaseAmount, supplier.Balance, supplier.ProductTypes,
 		supplier.Comments, supplier.Files, supplier.Country, supplier.Region, supplier.TaxID, supplier.BankDetails,
 		supplier.RegistrationDate, supplier.PaymentTerms, supplier.IsActive, otherFieldsJSON, supplier.CompanyID,
 	).Scan(&id); err != nil {
-		return 0, err
+		return 0, dbError(err)
 	}
 
 	return id, nil
 }
 
-func (sr *SuppliersPostgresRepository) GetById(ctx context.Context, id int64) (domain.Supplier, error) {
+func (sr *SuppliersPostgresRepository) GetById(ctx context.Context, id, companyId int64) (domain.Supplier, error) {
+	return sr.getById(ctx, id, companyId, notDeleted)
+}
+
+// GetDeletedById возвращает только мягко удаленного поставщика
+func (sr *SuppliersPostgresRepository) GetDeletedById(ctx context.Context, id, companyId int64) (domain.Supplier, error) {
+	return sr.getById(ctx, id, companyId, isDeleted)
+}
+
+func (sr *SuppliersPostgresRepository) getById(ctx context.Context, id, companyId int64, state string) (domain.Supplier, error) {
 	query := fmt.Sprintf(`
     SELECT
         id, name, legal_address, actual_address, warehouse_address,
         contact_person, phone, email, website, contract_number,
         product_categories, purchase_amount, balance, product_types,
         comments, files, country, region, tax_id, bank_details,
-        registration_date, payment_terms, is_active, other_fields, company_id
+        registration_date, payment_terms, is_active, other_fields, company_id, deleted_at
     FROM %s
-    WHERE id = $1;
-    `, domain.TableSupplier)
+    WHERE id = $1 AND company_id = $2 AND %s;
+    `, domain.TableSupplier, state)
 
 	var supplier domain.Supplier
 	var otherFieldsJSON []byte
+	var deletedAt sql.NullTime
 
 	// Выполнение запроса и сканирование результата в объект Supplier
-	row := sr.psql.QueryRowContext(ctx, query, id)
+	row := conn(ctx, sr.psql).QueryRowContext(ctx, query, id, companyId)
 	err := row.Scan(
 		&supplier.ID, &supplier.Name, &supplier.LegalAddress, &supplier.ActualAddress,
 		&supplier.WarehouseAddress, &supplier.ContactPerson, &supplier.Phone, &supplier.Email,
@@ -75,8 +97,13 @@ func (sr *SuppliersPostgresRepository) GetById(ctx context.Context, id int64) (d
 		&supplier.Balance, &supplier.ProductTypes, &supplier.Comments, &supplier.Files,
 		&supplier.Country, &supplier.Region, &supplier.TaxID, &supplier.BankDetails,
 		&supplier.RegistrationDate, &supplier.PaymentTerms, &supplier.IsActive, &otherFieldsJSON, &supplier.CompanyID,
+		&deletedAt,
 	)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Supplier{}, domain.ErrSupplierNotFound
+		}
+
 		return domain.Supplier{}, err
 	}
 
@@ -84,6 +111,8 @@ func (sr *SuppliersPostgresRepository) GetById(ctx context.Context, id int64) (d
 		return domain.Supplier{}, fmt.Errorf("failed to unmarshal other_fields JSON: %v", err)
 	}
 
+	supplier.DeletedAt = deletedAt.Time
+
 	return supplier, nil
 }
 
@@ -99,36 +128,77 @@ func (sr *SuppliersPostgresRepository) Update(ctx context.Context, supplier doma
 			phone = $6, email = $7, website = $8, contract_number = $9, product_categories = $10, purchase_amount = $11,
 			balance = $12, product_types = $13, comments = $14, files = $15, country = $16, region = $17, tax_id = $18,
 			bank_details = $19, registration_date = $20, payment_terms = $21, is_active = $22, other_fields = $23
-		WHERE id = $24;
+		WHERE id = $24 AND company_id = $25 AND deleted_at IS NULL;
 	`, domain.TableSupplier)
 
-	_, err = sr.psql.ExecContext(ctx, query, supplier.Name, supplier.LegalAddress, supplier.ActualAddress,
+	res, err := conn(ctx, sr.psql).ExecContext(ctx, query, supplier.Name, supplier.LegalAddress, supplier.ActualAddress,
 		supplier.WarehouseAddress, supplier.ContactPerson, supplier.Phone, supplier.Email, supplier.Website,
 		supplier.ContractNumber, supplier.ProductCategories, supplier.PurchaseAmount, supplier.Balance, supplier.ProductTypes,
 		supplier.Comments, supplier.Files, supplier.Country, supplier.Region, supplier.TaxID, supplier.BankDetails,
-		supplier.RegistrationDate, supplier.PaymentTerms, supplier.IsActive, otherFieldsJSON, supplier.ID)
+		supplier.RegistrationDate, supplier.PaymentTerms, supplier.IsActive, otherFieldsJSON, supplier.ID, supplier.CompanyID)
+	if err != nil {
+		return dbError(err)
+	}
+
+	return checkAffected(res, domain.ErrSupplierNotFound)
+}
+
+// Delete мягко удаляет поставщика, материалы сохраняют ссылку на него до окончательного удаления
+func (sr *SuppliersPostgresRepository) Delete(ctx context.Context, id, companyId int64) error {
+	res, err := conn(ctx, sr.psql).ExecContext(ctx, fmt.Sprintf(`
+		UPDATE %s SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND company_id = $2 AND deleted_at IS NULL`,
+		domain.TableSupplier), id, companyId)
+	if err != nil {
+		return dbError(err)
+	}
 
-	return err
+	return checkAffected(res, domain.ErrSupplierNotFound)
 }
 
-func (sr *SuppliersPostgresRepository) Delete(ctx context.Context, id int64) error {
-	_, err := sr.psql.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1", domain.TableSupplier), id)
-	return err
+func (sr *SuppliersPostgresRepository) Restore(ctx context.Context, id, companyId int64) error {
+	res, err := conn(ctx, sr.psql).ExecContext(ctx, fmt.Sprintf(`
+		UPDATE %s SET deleted_at = NULL WHERE id = $1 AND company_id = $2 AND deleted_at IS NOT NULL`,
+		domain.TableSupplier), id, companyId)
+	if err != nil {
+		return dbError(err)
+	}
+
+	return checkAffected(res, domain.ErrSupplierNotFound)
+}
+
+// Purge окончательно удаляет поставщиков, удаленных раньше deletedBefore, на которых не ссылается ни один материал
+func (sr *SuppliersPostgresRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	query := fmt.Sprintf(`
+		DELETE FROM %[1]s s
+		WHERE s.deleted_at < $1
+		  AND NOT EXISTS (SELECT 1 FROM %[2]s WHERE supplier_id = s.id)
+		  AND NOT EXISTS (SELECT 1 FROM %[3]s WHERE supplier_id = s.id)
+		  AND NOT EXISTS (SELECT 1 FROM %[4]s WHERE supplier_id = s.id)
+		  AND NOT EXISTS (SELECT 1 FROM %[5]s WHERE supplier_id = s.id)`,
+		domain.TableSupplier, domain.TablePlanningMaterials, domain.TablePurchasedMaterials,
+		domain.TablePlanningMaterialsArchive, domain.TablePurchasedMaterialsArchive)
+
+	res, err := conn(ctx, sr.psql).ExecContext(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge suppliers: %w", dbError(err))
+	}
+
+	return res.RowsAffected()
 }
 
-func (sr *SuppliersPostgresRepository) GetListByCompanyId(ctx context.Context, id int64) ([]domain.Supplier, error) {
+func (sr *SuppliersPostgresRepository) GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Supplier, error) {
 	query := fmt.Sprintf(`
 	SELECT
 		id, name, legal_address, actual_address, warehouse_address,
 		contact_person, phone, email, website, contract_number,
 		product_categories, purchase_amount, balance, product_types,
 		comments, files, country, region, tax_id, bank_details,
-		registration_date, payment_terms, is_active, other_fields, company_id
+		registration_date, payment_terms, is_active, other_fields, company_id, deleted_at
 	FROM %s
-	WHERE company_id = $1;
+	WHERE company_id = $1 AND ($2 OR deleted_at IS NULL);
 	`, domain.TableSupplier)
 
-	rows, err := sr.psql.QueryContext(ctx, query, id)
+	rows, err := conn(ctx, sr.psql).QueryContext(ctx, query, id, includeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -143,6 +213,7 @@ func (sr *SuppliersPostgresRepository) GetListByCompanyId(ctx context.Context, i
 	for rows.Next() {
 		var supplier domain.Supplier
 		var otherFieldsJSON []byte
+		var deletedAt sql.NullTime
 
 		if err = rows.Scan(
 			&supplier.ID, &supplier.Name, &supplier.LegalAddress, &supplier.ActualAddress,
@@ -151,6 +222,7 @@ func (sr *SuppliersPostgresRepository) GetListByCompanyId(ctx context.Context, i
 			&supplier.Balance, &supplier.ProductTypes, &supplier.Comments, &supplier.Files,
 			&supplier.Country, &supplier.Region, &supplier.TaxID, &supplier.BankDetails,
 			&supplier.RegistrationDate, &supplier.PaymentTerms, &supplier.IsActive, &otherFieldsJSON, &supplier.CompanyID,
+			&deletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -159,6 +231,7 @@ func (sr *SuppliersPostgresRepository) GetListByCompanyId(ctx context.Context, i
 			return nil, fmt.Errorf("failed to unmarshal other_fields JSON: %v", err)
 		}
 
+		supplier.DeletedAt = deletedAt.Time
 		suppliers = append(suppliers, supplier)
 	}
 
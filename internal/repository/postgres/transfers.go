@@ -0,0 +1,547 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"strings"
+)
+
+type Transfers interface {
+	Create(ctx context.Context, order domain.TransferOrder) (int64, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.TransferOrder, error)
+	GetList(ctx context.Context, params domain.TransferOrderParams) ([]domain.TransferOrder, error)
+	Ship(ctx context.Context, id, companyId, userId int64) error
+	Receive(ctx context.Context, id, companyId, userId int64, receipts []domain.TransferReceipt) error
+	Cancel(ctx context.Context, id, companyId int64) error
+	GetInTransit(ctx context.Context, params domain.InTransitParams) (int64, error)
+}
+
+type TransfersPostgresRepository struct {
+	psql *sql.DB
+}
+
+func NewTransfersPostgresRepository(psql *sql.DB) *TransfersPostgresRepository {
+	return &TransfersPostgresRepository{
+		psql: psql,
+	}
+}
+
+func (tr *TransfersPostgresRepository) Create(ctx context.Context, order domain.TransferOrder) (int64, error) {
+	tx, err := beginTx(ctx, tr.psql)
+	if err != nil {
+		return 0, err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	if err = checkWarehouse(ctx, tx.Tx, order.SourceWarehouseID, order.CompanyID); err != nil {
+		return 0, err
+	}
+
+	if err = checkWarehouse(ctx, tx.Tx, order.DestinationWarehouseID, order.CompanyID); err != nil {
+		return 0, err
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (company_id, source_warehouse_id, destination_warehouse_id, status, comment, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		domain.TableTransferOrders)
+
+	var id int64
+	if err = tx.QueryRowContext(ctx, query,
+		order.CompanyID, order.SourceWarehouseID, order.DestinationWarehouseID, domain.TransferStatusDraft,
+		order.Comment, order.CreatedBy,
+	).Scan(&id); err != nil {
+		return 0, fmt.Errorf("failed to insert transfer order: %w", dbError(err))
+	}
+
+	for _, item := range order.Items {
+		var itemId, warehouseId int64
+
+		query = fmt.Sprintf("SELECT item_id, warehouse_id FROM %s WHERE id = $1 AND company_id = $2",
+			domain.TablePurchasedMaterials)
+
+		if err = tx.QueryRowContext(ctx, query, item.MaterialID, order.CompanyID).Scan(&itemId, &warehouseId); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return 0, domain.ErrMaterialNotFound
+			}
+
+			return 0, err
+		}
+
+		if warehouseId != order.SourceWarehouseID {
+			return 0, fmt.Errorf("%w: material %d is not stored in source warehouse", domain.ErrInvalidTransferOrder, item.MaterialID)
+		}
+
+		query = fmt.Sprintf(`
+			INSERT INTO %s (transfer_order_id, material_id, item_id, quantity) VALUES ($1, $2, $3, $4)`,
+			domain.TableTransferOrderItems)
+
+		if _, err = tx.ExecContext(ctx, query, id, item.MaterialID, itemId, item.Quantity); err != nil {
+			return 0, fmt.Errorf("failed to insert transfer order item: %w", dbError(err))
+		}
+	}
+
+	return id, tx.Commit()
+}
+
+func (tr *TransfersPostgresRepository) GetById(ctx context.Context, id, companyId int64) (domain.TransferOrder, error) {
+	query := fmt.Sprintf(`
+		SELECT
+		    id, company_id, source_warehouse_id, destination_warehouse_id, status, comment, created_by,
+		    created_at, updated_at, shipped_at, received_at
+		FROM %s WHERE id = $1 AND company_id = $2`,
+		domain.TableTransferOrders)
+
+	order, err := scanTransferOrder(conn(ctx, tr.psql).QueryRowContext(ctx, query, id, companyId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TransferOrder{}, domain.ErrTransferOrderNotFound
+		}
+
+		return domain.TransferOrder{}, err
+	}
+
+	if order.Items, err = getTransferItems(ctx, conn(ctx, tr.psql), order.ID); err != nil {
+		return domain.TransferOrder{}, err
+	}
+
+	return order, nil
+}
+
+func (tr *TransfersPostgresRepository) GetList(ctx context.Context, params domain.TransferOrderParams) ([]domain.TransferOrder, error) {
+	where := []string{"company_id = $1"}
+	args := []interface{}{params.CompanyId}
+
+	if params.WarehouseId != 0 {
+		args = append(args, params.WarehouseId)
+		where = append(where, fmt.Sprintf("(source_warehouse_id = $%d OR destination_warehouse_id = $%d)", len(args), len(args)))
+	}
+
+	if params.Status != "" {
+		args = append(args, params.Status)
+		where = append(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	args = append(args, params.Limit, params.Offset)
+
+	query := fmt.Sprintf(`
+		SELECT
+		    id, company_id, source_warehouse_id, destination_warehouse_id, status, comment, created_by,
+		    created_at, updated_at, shipped_at, received_at
+		FROM %s WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d`,
+		domain.TableTransferOrders, strings.Join(where, " AND "), len(args)-1, len(args))
+
+	rows, err := tr.psql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var orders []domain.TransferOrder
+
+	for rows.Next() {
+		order, err := scanTransferOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, order)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range orders {
+		if orders[i].Items, err = getTransferItems(ctx, tr.psql, orders[i].ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return orders, nil
+}
+
+// Ship списывает товар со склада-отправителя, после отгрузки товар числится в пути
+func (tr *TransfersPostgresRepository) Ship(ctx context.Context, id, companyId, userId int64) error {
+	tx, err := beginTx(ctx, tr.psql)
+	if err != nil {
+		return err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	order, err := lockTransferOrder(ctx, tx.Tx, id, companyId)
+	if err != nil {
+		return err
+	}
+
+	if order.Status != domain.TransferStatusDraft {
+		return domain.ErrTransferOrderStatus
+	}
+
+	if len(order.Items) == 0 {
+		return fmt.Errorf("%w: order has no items", domain.ErrInvalidTransferOrder)
+	}
+
+	// склады могли быть удалены после создания заказа
+	if err = checkWarehouse(ctx, tx.Tx, order.SourceWarehouseID, companyId); err != nil {
+		return err
+	}
+
+	if err = checkWarehouse(ctx, tx.Tx, order.DestinationWarehouseID, companyId); err != nil {
+		return err
+	}
+
+	reference := transferReference(order.ID)
+
+	for _, item := range order.Items {
+		lot, err := lockLot(ctx, tx.Tx, item.MaterialID, companyId)
+		if err != nil {
+			return err
+		}
+
+		if lot.warehouseId != order.SourceWarehouseID {
+			return fmt.Errorf("%w: material %d is not stored in source warehouse", domain.ErrInvalidTransferOrder, item.MaterialID)
+		}
+
+		if lot.quarantined() {
+			return domain.ErrMaterialQuarantined
+		}
+
+		if item.Quantity > lot.available() {
+			return domain.ErrInsufficientStock
+		}
+
+		shippedVolume := lot.volume
+		if item.Quantity < lot.onHand {
+			shippedVolume = lot.volume * item.Quantity / lot.onHand
+		}
+
+		balance := lot.onHand - item.Quantity
+
+		if _, err = insertMovement(ctx, tx.Tx, domain.Movement{
+			CompanyID:    companyId,
+			MaterialID:   lot.id,
+			ItemID:       lot.itemId,
+			WarehouseID:  lot.warehouseId,
+			Type:         domain.MovementTypeTransfer,
+			Quantity:     -item.Quantity,
+			BalanceAfter: balance,
+			Reference:    reference,
+			CreatedBy:    userId,
+		}); err != nil {
+			return err
+		}
+
+		query := fmt.Sprintf(`
+			UPDATE %s
+			SET total_quantity = $1, volume = volume - $2, total_without_vat = price_without_vat * $1, last_updated = CURRENT_TIMESTAMP
+			WHERE id = $3`,
+			domain.TablePurchasedMaterials)
+
+		if _, err = tx.ExecContext(ctx, query, balance, shippedVolume, lot.id); err != nil {
+			return fmt.Errorf("failed to update source material: %w", dbError(err))
+		}
+
+		// снимок партии нужен приемке, исходная партия к тому времени может быть удалена
+		query = fmt.Sprintf(`
+			UPDATE %s SET shipped_quantity = $1, shipped_volume = $2,
+			    lot_snapshot = (SELECT to_jsonb(m) FROM %s m WHERE m.id = $3)
+			WHERE id = $4`,
+			domain.TableTransferOrderItems, domain.TablePurchasedMaterials)
+
+		if _, err = tx.ExecContext(ctx, query, item.Quantity, shippedVolume, lot.id, item.ID); err != nil {
+			return fmt.Errorf("failed to update transfer order item: %w", dbError(err))
+		}
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE %s SET status = $1, shipped_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP WHERE id = $2`,
+		domain.TableTransferOrders)
+
+	if _, err = tx.ExecContext(ctx, query, domain.TransferStatusShipped, order.ID); err != nil {
+		return fmt.Errorf("failed to update transfer order: %w", dbError(err))
+	}
+
+	return tx.Commit()
+}
+
+// Receive оприходует на складе-получателе принятое количество, допускается частичная приемка
+func (tr *TransfersPostgresRepository) Receive(ctx context.Context, id, companyId, userId int64, receipts []domain.TransferReceipt) error {
+	tx, err := beginTx(ctx, tr.psql)
+	if err != nil {
+		return err
+	}
+	defer func(tx *repoTx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	order, err := lockTransferOrder(ctx, tx.Tx, id, companyId)
+	if err != nil {
+		return err
+	}
+
+	if order.Status != domain.TransferStatusShipped && order.Status != domain.TransferStatusPartiallyReceived {
+		return domain.ErrTransferOrderStatus
+	}
+
+	// склад-получатель мог быть удален, пока товар был в пути
+	if err = checkWarehouse(ctx, tx.Tx, order.DestinationWarehouseID, companyId); err != nil {
+		return err
+	}
+
+	items := make(map[int64]*domain.TransferOrderItem, len(order.Items))
+	for i := range order.Items {
+		items[order.Items[i].ID] = &order.Items[i]
+	}
+
+	reference := transferReference(order.ID)
+
+	for _, receipt := range receipts {
+		item, ok := items[receipt.ItemID]
+		if !ok {
+			return fmt.Errorf("%w: item %d does not belong to order", domain.ErrInvalidTransferOrder, receipt.ItemID)
+		}
+
+		if receipt.Quantity <= 0 || receipt.Quantity > item.InTransit() {
+			return fmt.Errorf("%w: item %d receipt quantity exceeds quantity in transit", domain.ErrInvalidTransferOrder, item.ID)
+		}
+
+		// последняя приемка забирает остаток объема, чтобы не терять его на округлении
+		volume := item.ShippedVolume * receipt.Quantity / item.ShippedQuantity
+		if receipt.Quantity == item.InTransit() {
+			volume = item.ShippedVolume - item.ReceivedVolume
+		}
+
+		var balance int64
+
+		lot, err := stockLot{}, domain.ErrMaterialNotFound
+		if item.DestinationMaterialID != 0 {
+			lot, err = lockLot(ctx, tx.Tx, item.DestinationMaterialID, companyId)
+		}
+
+		switch {
+		case errors.Is(err, domain.ErrMaterialNotFound):
+			// первая приемка, либо партию прошлой приемки успели удалить или перенести в архив - создается новая
+			if item.DestinationMaterialID, err = receiveLot(ctx, tx.Tx, item.ID, order.DestinationWarehouseID, receipt.Quantity, volume); err != nil {
+				return err
+			}
+
+			balance = receipt.Quantity
+		case err != nil:
+			return err
+		default:
+			balance = lot.onHand + receipt.Quantity
+
+			query := fmt.Sprintf(`
+				UPDATE %s
+				SET total_quantity = $1, volume = volume + $2, total_without_vat = price_without_vat * $1, last_updated = CURRENT_TIMESTAMP
+				WHERE id = $3`,
+				domain.TablePurchasedMaterials)
+
+			if _, err = tx.ExecContext(ctx, query, balance, volume, lot.id); err != nil {
+				return fmt.Errorf("failed to update destination material: %w", dbError(err))
+			}
+		}
+
+		if _, err = insertMovement(ctx, tx.Tx, domain.Movement{
+			CompanyID:    companyId,
+			MaterialID:   item.DestinationMaterialID,
+			ItemID:       item.ItemID,
+			WarehouseID:  order.DestinationWarehouseID,
+			Type:         domain.MovementTypeTransfer,
+			Quantity:     receipt.Quantity,
+			BalanceAfter: balance,
+			Reference:    reference,
+			CreatedBy:    userId,
+		}); err != nil {
+			return err
+		}
+
+		item.ReceivedQuantity += receipt.Quantity
+		item.ReceivedVolume += volume
+
+		query := fmt.Sprintf(`
+			UPDATE %s SET destination_material_id = $1, received_quantity = $2, received_volume = $3 WHERE id = $4`,
+			domain.TableTransferOrderItems)
+
+		if _, err = tx.ExecContext(ctx, query, item.DestinationMaterialID, item.ReceivedQuantity, item.ReceivedVolume, item.ID); err != nil {
+			return fmt.Errorf("failed to update transfer order item: %w", dbError(err))
+		}
+	}
+
+	status := domain.TransferStatusReceived
+	for _, item := range order.Items {
+		if item.InTransit() > 0 {
+			status = domain.TransferStatusPartiallyReceived
+			break
+		}
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET status = $1, updated_at = CURRENT_TIMESTAMP,
+		    received_at = CASE WHEN $1 = '%s' THEN CURRENT_TIMESTAMP ELSE received_at END
+		WHERE id = $2`,
+		domain.TableTransferOrders, domain.TransferStatusReceived)
+
+	if _, err = tx.ExecContext(ctx, query, status, order.ID); err != nil {
+		return fmt.Errorf("failed to update transfer order: %w", dbError(err))
+	}
+
+	return tx.Commit()
+}
+
+func (tr *TransfersPostgresRepository) Cancel(ctx context.Context, id, companyId int64) error {
+	query := fmt.Sprintf(`
+		UPDATE %s SET status = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND company_id = $3 AND status = $4`,
+		domain.TableTransferOrders)
+
+	res, err := tr.psql.ExecContext(ctx, query, domain.TransferStatusCancelled, id, companyId, domain.TransferStatusDraft)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		// различаем отсутствующий заказ и заказ в неподходящем статусе
+		if _, err = tr.GetById(ctx, id, companyId); err != nil {
+			return err
+		}
+
+		return domain.ErrTransferOrderStatus
+	}
+
+	return nil
+}
+
+func (tr *TransfersPostgresRepository) GetInTransit(ctx context.Context, params domain.InTransitParams) (int64, error) {
+	query := fmt.Sprintf(`
+		SELECT COALESCE(SUM(i.shipped_quantity - i.received_quantity), 0)
+		FROM %s i
+		JOIN %s o ON o.id = i.transfer_order_id
+		WHERE o.company_id = $1
+		  AND o.status IN ($2, $3)
+		  AND ($4::BIGINT = 0 OR o.destination_warehouse_id = $4)
+		  AND ($5::BIGINT = 0 OR i.item_id = $5)`,
+		domain.TableTransferOrderItems, domain.TableTransferOrders)
+
+	var quantity int64
+	if err := tr.psql.QueryRowContext(ctx, query,
+		params.CompanyId, domain.TransferStatusShipped, domain.TransferStatusPartiallyReceived, params.WarehouseId, params.ItemId,
+	).Scan(&quantity); err != nil {
+		return 0, err
+	}
+
+	return quantity, nil
+}
+
+func transferReference(id int64) string {
+	return fmt.Sprintf("transfer_order:%d", id)
+}
+
+func lockTransferOrder(ctx context.Context, tx *sql.Tx, id, companyId int64) (domain.TransferOrder, error) {
+	query := fmt.Sprintf(`
+		SELECT
+		    id, company_id, source_warehouse_id, destination_warehouse_id, status, comment, created_by,
+		    created_at, updated_at, shipped_at, received_at
+		FROM %s WHERE id = $1 AND company_id = $2 FOR UPDATE`,
+		domain.TableTransferOrders)
+
+	order, err := scanTransferOrder(tx.QueryRowContext(ctx, query, id, companyId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TransferOrder{}, domain.ErrTransferOrderNotFound
+		}
+
+		return domain.TransferOrder{}, err
+	}
+
+	if order.Items, err = getTransferItems(ctx, tx, order.ID); err != nil {
+		return domain.TransferOrder{}, err
+	}
+
+	return order, nil
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+func getTransferItems(ctx context.Context, q queryer, orderId int64) ([]domain.TransferOrderItem, error) {
+	query := fmt.Sprintf(`
+		SELECT
+		    id, transfer_order_id, material_id, item_id, destination_material_id, quantity,
+		    shipped_quantity, shipped_volume, received_quantity, received_volume
+		FROM %s WHERE transfer_order_id = $1 ORDER BY id`,
+		domain.TableTransferOrderItems)
+
+	rows, err := q.QueryContext(ctx, query, orderId)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var items []domain.TransferOrderItem
+
+	for rows.Next() {
+		var item domain.TransferOrderItem
+		var destination sql.NullInt64
+
+		if err = rows.Scan(
+			&item.ID, &item.TransferOrderID, &item.MaterialID, &item.ItemID, &destination, &item.Quantity,
+			&item.ShippedQuantity, &item.ShippedVolume, &item.ReceivedQuantity, &item.ReceivedVolume,
+		); err != nil {
+			return nil, err
+		}
+
+		item.DestinationMaterialID = destination.Int64
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+func scanTransferOrder(row rowScanner) (domain.TransferOrder, error) {
+	var order domain.TransferOrder
+	var shippedAt, receivedAt sql.NullTime
+
+	if err := row.Scan(
+		&order.ID, &order.CompanyID, &order.SourceWarehouseID, &order.DestinationWarehouseID, &order.Status,
+		&order.Comment, &order.CreatedBy, &order.CreatedAt, &order.UpdatedAt, &shippedAt, &receivedAt,
+	); err != nil {
+		return domain.TransferOrder{}, err
+	}
+
+	order.ShippedAt = shippedAt.Time
+	order.ReceivedAt = receivedAt.Time
+
+	return order, nil
+}
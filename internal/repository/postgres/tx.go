@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+)
+
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type TransactorPostgres struct {
+	psql *sql.DB
+}
+
+func NewTransactorPostgres(psql *sql.DB) *TransactorPostgres {
+	return &TransactorPostgres{
+		psql: psql,
+	}
+}
+
+type txKey struct{}
+
+// txState - транзакция WithinTx и действия, отложенные до ее фиксации
+type txState struct {
+	tx          *sql.Tx
+	afterCommit []func()
+}
+
+func txFromContext(ctx context.Context) (*txState, bool) {
+	state, ok := ctx.Value(txKey{}).(*txState)
+	return state, ok
+}
+
+// WithinTx выполняет fn в одной транзакции: методы репозиториев, вызванные с переданным в fn контекстом,
+// работают в ней же. Вложенный вызов продолжает внешнюю транзакцию.
+func (t *TransactorPostgres) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := txFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.psql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func(tx *sql.Tx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	state := &txState{tx: tx}
+	if err = fn(context.WithValue(ctx, txKey{}, state)); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	for _, f := range state.afterCommit {
+		f()
+	}
+
+	return nil
+}
+
+// InTx сообщает, выполняется ли вызов внутри WithinTx
+func InTx(ctx context.Context) bool {
+	_, ok := txFromContext(ctx)
+	return ok
+}
+
+// AfterCommit откладывает fn до фиксации транзакции WithinTx, при откате fn не выполняется.
+// Вне транзакции fn выполняется сразу.
+func AfterCommit(ctx context.Context, fn func()) {
+	state, ok := txFromContext(ctx)
+	if !ok {
+		fn()
+		return
+	}
+
+	state.afterCommit = append(state.afterCommit, fn)
+}
+
+// executor - общие методы *sql.DB и *sql.Tx
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// conn возвращает транзакцию WithinTx из контекста, вне транзакции - пул соединений
+func conn(ctx context.Context, psql *sql.DB) executor {
+	if state, ok := txFromContext(ctx); ok {
+		return state.tx
+	}
+
+	return psql
+}
+
+// repoTx - собственная транзакция метода репозитория. Внутри WithinTx метод продолжает внешнюю
+// транзакцию, а фиксацию и откат выполняет WithinTx.
+type repoTx struct {
+	*sql.Tx
+	outer bool
+}
+
+func beginTx(ctx context.Context, psql *sql.DB) (*repoTx, error) {
+	if state, ok := txFromContext(ctx); ok {
+		return &repoTx{Tx: state.tx, outer: true}, nil
+	}
+
+	tx, err := psql.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repoTx{Tx: tx}, nil
+}
+
+func (t *repoTx) Commit() error {
+	if t.outer {
+		return nil
+	}
+
+	return t.Tx.Commit()
+}
+
+func (t *repoTx) Rollback() error {
+	if t.outer {
+		return nil
+	}
+
+	return t.Tx.Rollback()
+}
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Users interface {
+	GetById(ctx context.Context, id int64) (domain.User, error)
+}
+
+type UsersPostgresRepository struct {
+	psql *sql.DB
+}
+
+func NewUsersPostgresRepository(psql *sql.DB) *UsersPostgresRepository {
+	return &UsersPostgresRepository{
+		psql: psql,
+	}
+}
+
+func (ur *UsersPostgresRepository) GetById(ctx context.Context, id int64) (domain.User, error) {
+	query := fmt.Sprintf(`
+		SELECT 
+		    id, company_id, username, name, email, phone, password_hash, created_at, 
+		    updated_at, last_login, is_active, role, language, country, 
+		    is_approved, is_send_system_notification, sections, position
+		FROM %s
+		WHERE id = $1`,
+		domain.UsersTable)
+
+	var user domain.User
+	var b []byte
+
+	if err := ur.psql.QueryRowContext(ctx, query, id).Scan(
+		&user.ID, &user.CompanyID, &user.Username, &user.Name, &user.Email, &user.Phone, &user.PasswordHash, &user.CreatedAt, &user.UpdatedAt,
+		&user.LastLogin, &user.IsActive, &user.Role, &user.Language, &user.Country, &user.IsApproved, &user.IsSendSystemNotification,
+		&b, &user.Position,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.User{}, domain.ErrUserNotFound
+		}
+
+		return domain.User{}, err
+	}
+
+	if err := json.Unmarshal(b, &user.Sections); err != nil {
+		return domain.User{}, err
+	}
+
+	return user, nil
+}
@@ -4,18 +4,28 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/lib/pq"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"slices"
+	"strings"
+	"time"
 )
 
 type Warehouse interface {
 	Create(ctx context.Context, warehouse domain.Warehouse) (int64, error)
-	GetById(ctx context.Context, id int64) (domain.Warehouse, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.Warehouse, error)
 	Update(ctx context.Context, warehouse domain.Warehouse) error
-	Delete(ctx context.Context, id int64) error
-	GetListByCompanyId(ctx context.Context, id int64) ([]domain.Warehouse, error)
+	Delete(ctx context.Context, id, companyId int64) error
+	GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Warehouse, error)
 	GetResponsibleUsers(ctx context.Context, companyId int64) ([]domain.User, error)
+
+	GetDeletedById(ctx context.Context, id, companyId int64) (domain.Warehouse, error)
+	Restore(ctx context.Context, id, companyId int64) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+
+	RefreshOccupancy(ctx context.Context, companyId int64, ids ...int64) ([]domain.WarehouseOccupancy, error)
 }
 
 type WarehousePostgresRepository struct {
@@ -35,7 +45,7 @@ func (wpr *WarehousePostgresRepository) Create(ctx context.Context, warehouse do
 	query := fmt.Sprintf(`
     INSERT INTO %s (
         name, address, responsible_person, phone, email,
-        max_capacity, current_occupancy, other_fields, country, company_id
+        max_capacity, capacity_policy, other_fields, country, company_id
     ) VALUES (
         $1, $2, $3, $4, $5,
         $6, $7, $8, $9, $10
@@ -43,34 +53,49 @@ func (wpr *WarehousePostgresRepository) Create(ctx context.Context, warehouse do
     `, domain.TableWarehouse)
 
 	var id int64
-	if err = wpr.db.QueryRowContext(ctx, query,
+	if err = conn(ctx, wpr.db).QueryRowContext(ctx, query,
 		warehouse.Name, warehouse.Address, warehouse.ResponsiblePerson, warehouse.Phone, warehouse.Email,
-		warehouse.MaxCapacity, warehouse.CurrentOccupancy, otherFieldsJSON, warehouse.Country, warehouse.CompanyID,
+		warehouse.MaxCapacity, warehouse.CapacityPolicy, otherFieldsJSON, warehouse.Country, warehouse.CompanyID,
 	).Scan(&id); err != nil {
-		return 0, fmt.Errorf("failed to insert warehouse: %v", err)
+		return 0, fmt.Errorf("failed to insert warehouse: %w", dbError(err))
 	}
 
 	return id, nil
 }
 
-func (wpr *WarehousePostgresRepository) GetById(ctx context.Context, id int64) (domain.Warehouse, error) {
+func (wpr *WarehousePostgresRepository) GetById(ctx context.Context, id, companyId int64) (domain.Warehouse, error) {
+	return wpr.getById(ctx, id, companyId, notDeleted)
+}
+
+// GetDeletedById возвращает только мягко удаленный склад
+func (wpr *WarehousePostgresRepository) GetDeletedById(ctx context.Context, id, companyId int64) (domain.Warehouse, error) {
+	return wpr.getById(ctx, id, companyId, isDeleted)
+}
+
+func (wpr *WarehousePostgresRepository) getById(ctx context.Context, id, companyId int64, state string) (domain.Warehouse, error) {
 	query := fmt.Sprintf(`
     SELECT
         id, name, address, responsible_person, phone, email,
-        max_capacity, current_occupancy, other_fields, country, company_id
+        max_capacity, current_occupancy, capacity_policy, other_fields, country, company_id, deleted_at
     FROM %s
-    WHERE id = $1;
-    `, domain.TableWarehouse)
+    WHERE id = $1 AND company_id = $2 AND %s;
+    `, domain.TableWarehouse, state)
 
 	var warehouse domain.Warehouse
 	var otherFieldsJSON []byte
+	var deletedAt sql.NullTime
 
-	row := wpr.db.QueryRowContext(ctx, query, id)
+	row := conn(ctx, wpr.db).QueryRowContext(ctx, query, id, companyId)
 	err := row.Scan(
 		&warehouse.ID, &warehouse.Name, &warehouse.Address, &warehouse.ResponsiblePerson, &warehouse.Phone, &warehouse.Email,
-		&warehouse.MaxCapacity, &warehouse.CurrentOccupancy, &otherFieldsJSON, &warehouse.Country, &warehouse.CompanyID,
+		&warehouse.MaxCapacity, &warehouse.CurrentOccupancy, &warehouse.CapacityPolicy, &otherFieldsJSON, &warehouse.Country, &warehouse.CompanyID,
+		&deletedAt,
 	)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Warehouse{}, domain.ErrWarehouseNotFound
+		}
+
 		return domain.Warehouse{}, err
 	}
 
@@ -78,6 +103,8 @@ func (wpr *WarehousePostgresRepository) GetById(ctx context.Context, id int64) (
 		return domain.Warehouse{}, fmt.Errorf("failed to unmarshal other_fields JSON: %v", err)
 	}
 
+	warehouse.DeletedAt = deletedAt.Time
+
 	return warehouse, nil
 }
 
@@ -91,37 +118,83 @@ func (wpr *WarehousePostgresRepository) Update(ctx context.Context, warehouse do
 	UPDATE %s
 	SET
 		name = $1, address = $2, responsible_person = $3, phone = $4, email = $5,
-		max_capacity = $6, current_occupancy = $7, other_fields = $8, country = $9
-	WHERE id = $10
+		max_capacity = $6, capacity_policy = $7, other_fields = $8, country = $9
+	WHERE id = $10 AND company_id = $11 AND deleted_at IS NULL
 	`, domain.TableWarehouse)
 
-	_, err = wpr.db.ExecContext(ctx, query,
+	res, err := conn(ctx, wpr.db).ExecContext(ctx, query,
 		warehouse.Name, warehouse.Address, warehouse.ResponsiblePerson, warehouse.Phone, warehouse.Email,
-		warehouse.MaxCapacity, warehouse.CurrentOccupancy, otherFieldsJSON, warehouse.Country,
-		warehouse.ID,
+		warehouse.MaxCapacity, warehouse.CapacityPolicy, otherFieldsJSON, warehouse.Country,
+		warehouse.ID, warehouse.CompanyID,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to update warehouse: %v", err)
+		return fmt.Errorf("failed to update warehouse: %w", dbError(err))
 	}
 
-	return nil
+	return checkAffected(res, domain.ErrWarehouseNotFound)
 }
 
-func (wpr *WarehousePostgresRepository) Delete(ctx context.Context, id int64) error {
-	_, err := wpr.db.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1", domain.TableWarehouse), id)
-	return err
+// Delete мягко удаляет склад, партии и журнал движений сохраняют ссылку на него до окончательного удаления
+func (wpr *WarehousePostgresRepository) Delete(ctx context.Context, id, companyId int64) error {
+	res, err := conn(ctx, wpr.db).ExecContext(ctx, fmt.Sprintf(`
+		UPDATE %s SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND company_id = $2 AND deleted_at IS NULL`,
+		domain.TableWarehouse), id, companyId)
+	if err != nil {
+		return dbError(err)
+	}
+
+	return checkAffected(res, domain.ErrWarehouseNotFound)
 }
 
-func (wpr *WarehousePostgresRepository) GetListByCompanyId(ctx context.Context, id int64) ([]domain.Warehouse, error) {
+func (wpr *WarehousePostgresRepository) Restore(ctx context.Context, id, companyId int64) error {
+	res, err := conn(ctx, wpr.db).ExecContext(ctx, fmt.Sprintf(`
+		UPDATE %s SET deleted_at = NULL WHERE id = $1 AND company_id = $2 AND deleted_at IS NOT NULL`,
+		domain.TableWarehouse), id, companyId)
+	if err != nil {
+		return dbError(err)
+	}
+
+	return checkAffected(res, domain.ErrWarehouseNotFound)
+}
+
+// Purge окончательно удаляет склады, удаленные раньше deletedBefore, на которые не ссылаются
+// материалы, движения, перемещения, резервы, уведомления, места хранения и инвентаризации
+func (wpr *WarehousePostgresRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	refs := []string{
+		fmt.Sprintf("SELECT 1 FROM %s WHERE warehouse_id = w.id", domain.TablePlanningMaterials),
+		fmt.Sprintf("SELECT 1 FROM %s WHERE warehouse_id = w.id", domain.TablePurchasedMaterials),
+		fmt.Sprintf("SELECT 1 FROM %s WHERE warehouse_id = w.id", domain.TablePlanningMaterialsArchive),
+		fmt.Sprintf("SELECT 1 FROM %s WHERE warehouse_id = w.id", domain.TablePurchasedMaterialsArchive),
+		fmt.Sprintf("SELECT 1 FROM %s WHERE warehouse_id = w.id", domain.TableStockMovements),
+		fmt.Sprintf("SELECT 1 FROM %s WHERE source_warehouse_id = w.id OR destination_warehouse_id = w.id",
+			domain.TableTransferOrders),
+		fmt.Sprintf("SELECT 1 FROM %s WHERE warehouse_id = w.id", domain.TableReservations),
+		fmt.Sprintf("SELECT 1 FROM %s WHERE warehouse_id = w.id", domain.TableLowStockAlerts),
+		fmt.Sprintf("SELECT 1 FROM %s WHERE warehouse_id = w.id", domain.TableStorageLocations),
+		fmt.Sprintf("SELECT 1 FROM %s WHERE warehouse_id = w.id", domain.TableStocktakes),
+	}
+
+	query := fmt.Sprintf("DELETE FROM %s w WHERE w.deleted_at < $1 AND NOT EXISTS (%s)",
+		domain.TableWarehouse, strings.Join(refs, ") AND NOT EXISTS ("))
+
+	res, err := conn(ctx, wpr.db).ExecContext(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge warehouses: %w", dbError(err))
+	}
+
+	return res.RowsAffected()
+}
+
+func (wpr *WarehousePostgresRepository) GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Warehouse, error) {
 	query := fmt.Sprintf(`
 	SELECT
 		id, name, address, responsible_person, phone, email,
-		max_capacity, current_occupancy, other_fields, country, company_id
+		max_capacity, current_occupancy, capacity_policy, other_fields, country, company_id, deleted_at
 	FROM %s
-	WHERE company_id = $1;
+	WHERE company_id = $1 AND ($2 OR deleted_at IS NULL);
 	`, domain.TableWarehouse)
 
-	rows, err := wpr.db.QueryContext(ctx, query, id)
+	rows, err := conn(ctx, wpr.db).QueryContext(ctx, query, id, includeDeleted)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get warehouses by company ID: %v", err)
 	}
@@ -135,9 +208,11 @@ func (wpr *WarehousePostgresRepository) GetListByCompanyId(ctx context.Context,
 	for rows.Next() {
 		var warehouse domain.Warehouse
 		var otherFieldsJSON []byte
+		var deletedAt sql.NullTime
 		if err = rows.Scan(
 			&warehouse.ID, &warehouse.Name, &warehouse.Address, &warehouse.ResponsiblePerson, &warehouse.Phone, &warehouse.Email,
-			&warehouse.MaxCapacity, &warehouse.CurrentOccupancy, &otherFieldsJSON, &warehouse.Country, &warehouse.CompanyID,
+			&warehouse.MaxCapacity, &warehouse.CurrentOccupancy, &warehouse.CapacityPolicy, &otherFieldsJSON, &warehouse.Country, &warehouse.CompanyID,
+			&deletedAt,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan warehouse: %v", err)
 		}
@@ -146,6 +221,7 @@ func (wpr *WarehousePostgresRepository) GetListByCompanyId(ctx context.Context,
 			return nil, fmt.Errorf("failed to unmarshal other_fields JSON: %v", err)
 		}
 
+		warehouse.DeletedAt = deletedAt.Time
 		warehouses = append(warehouses, warehouse)
 	}
 
@@ -167,7 +243,7 @@ func (wpr *WarehousePostgresRepository) GetResponsibleUsers(ctx context.Context,
 
 	var users []domain.User
 
-	rows, err := wpr.db.QueryContext(ctx, query, companyId, sections)
+	rows, err := conn(ctx, wpr.db).QueryContext(ctx, query, companyId, sections)
 	if err != nil {
 		return nil, err
 	}
@@ -202,3 +278,56 @@ func (wpr *WarehousePostgresRepository) GetResponsibleUsers(ctx context.Context,
 
 	return users, nil
 }
+
+// RefreshOccupancy пересчитывает заполненность складов по объему их купленных партий. Вызывается в WithinTx
+// после изменения партий: склады блокируются в порядке id, поэтому параллельные приемки на склад
+// выполняются по очереди, а пересчет видит партии уже зафиксированных транзакций.
+func (wpr *WarehousePostgresRepository) RefreshOccupancy(ctx context.Context, companyId int64, ids ...int64) ([]domain.WarehouseOccupancy, error) {
+	ids = slices.Clone(ids)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	query := fmt.Sprintf("SELECT id FROM %s WHERE id = ANY ($1) AND company_id = $2 ORDER BY id FOR UPDATE",
+		domain.TableWarehouse)
+
+	rows, err := conn(ctx, wpr.db).QueryContext(ctx, query, pq.Array(ids), companyId)
+	if err != nil {
+		return nil, err
+	}
+	if err = rows.Close(); err != nil {
+		return nil, err
+	}
+
+	query = fmt.Sprintf(`
+		UPDATE %s w
+		SET current_occupancy = COALESCE((SELECT SUM(m.volume) FROM %s m WHERE m.warehouse_id = w.id), 0)
+		WHERE w.id = ANY ($1) AND w.company_id = $2
+		RETURNING w.id, w.company_id, w.max_capacity, w.current_occupancy, w.capacity_policy`,
+		domain.TableWarehouse, domain.TablePurchasedMaterials)
+
+	rows, err = conn(ctx, wpr.db).QueryContext(ctx, query, pq.Array(ids), companyId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update warehouse occupancy: %w", dbError(err))
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	var occupancies []domain.WarehouseOccupancy
+	for rows.Next() {
+		var o domain.WarehouseOccupancy
+		if err = rows.Scan(&o.WarehouseID, &o.CompanyID, &o.MaxCapacity, &o.Occupancy, &o.CapacityPolicy); err != nil {
+			return nil, err
+		}
+
+		occupancies = append(occupancies, o)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return occupancies, nil
+}
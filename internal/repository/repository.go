@@ -2,21 +2,51 @@ package repository
 
 import (
 	"database/sql"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/cache"
 )
 
 type Repository struct {
-	Suppliers *SuppliersRepository
-	Warehouse *WarehouseRepository
-	Materials *MaterialsRepository
-	Category  *MaterialCategoriesRepository
+	Suppliers    *SuppliersRepository
+	Warehouse    *WarehouseRepository
+	Materials    *MaterialsRepository
+	Category     *MaterialCategoriesRepository
+	Movements    *MovementsRepository
+	Transfers    *TransfersRepository
+	Reservations *ReservationsRepository
+	Alerts       *AlertsRepository
+	Users        *UsersRepository
+	Outbox       *OutboxRepository
+	Inbox        *InboxRepository
+	Tx           *TransactorRepository
+	Analytics    *AnalyticsRepository
+	Locations    *StorageLocationsRepository
+	Stocktakes   *StocktakesRepository
+	Audit        *AuditRepository
 }
 
-func New(cfg *config.Config, postgres *sql.DB) *Repository {
+// New собирает репозитории. ch - ClickHouse аналитики, c - кэш чтения, nil отключает аналитику и кэширование.
+// Изменения материалов, поставщиков, складов и категорий записываются в журнал аудита.
+func New(cfg *config.Config, postgres *sql.DB, ch driver.Conn, c *cache.Cache) *Repository {
+	log := newAuditLog(postgres)
+
 	return &Repository{
-		Suppliers: NewSuppliersRepository(cfg, postgres),
-		Warehouse: NewWarehouseRepository(cfg, postgres),
-		Materials: NewMaterialsRepository(cfg, postgres),
-		Category:  NewMaterialCategoriesRepository(cfg, postgres),
+		Suppliers:    NewSuppliersRepository(cfg, postgres, c, log),
+		Warehouse:    NewWarehouseRepository(cfg, postgres, c, log),
+		Materials:    NewMaterialsRepository(cfg, postgres, c, log),
+		Category:     NewMaterialCategoriesRepository(cfg, postgres, c, log),
+		Movements:    NewMovementsRepository(cfg, postgres, c),
+		Transfers:    NewTransfersRepository(cfg, postgres, c),
+		Reservations: NewReservationsRepository(cfg, postgres, c),
+		Alerts:       NewAlertsRepository(cfg, postgres),
+		Users:        NewUsersRepository(cfg, postgres),
+		Outbox:       NewOutboxRepository(cfg, postgres),
+		Inbox:        NewInboxRepository(cfg, postgres),
+		Tx:           NewTransactorRepository(cfg, postgres),
+		Analytics:    NewAnalyticsRepository(cfg, postgres, ch),
+		Locations:    NewStorageLocationsRepository(cfg, postgres),
+		Stocktakes:   NewStocktakesRepository(cfg, postgres, c),
+		Audit:        NewAuditRepository(cfg, postgres),
 	}
 }
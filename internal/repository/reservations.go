@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/cache"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Reservations interface {
+	Create(ctx context.Context, reservation domain.Reservation) (int64, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.Reservation, error)
+	GetList(ctx context.Context, params domain.ReservationParams) ([]domain.Reservation, error)
+	Release(ctx context.Context, id, companyId int64) error
+	Consume(ctx context.Context, id, companyId, quantity, userId int64) (domain.Movement, error)
+	GetAvailability(ctx context.Context, materialId, companyId int64) (domain.Availability, error)
+}
+
+type ReservationsRepository struct {
+	cfg  *config.Config
+	psql postgres.Reservations
+}
+
+func NewReservationsRepository(cfg *config.Config, db *sql.DB, c *cache.Cache) *ReservationsRepository {
+	return &ReservationsRepository{
+		cfg:  cfg,
+		psql: cache.NewReservations(postgres.NewReservationsPostgresRepository(db), c),
+	}
+}
+
+func (rr *ReservationsRepository) Create(ctx context.Context, reservation domain.Reservation) (int64, error) {
+	return rr.psql.Create(ctx, reservation)
+}
+
+func (rr *ReservationsRepository) GetById(ctx context.Context, id, companyId int64) (domain.Reservation, error) {
+	return rr.psql.GetById(ctx, id, companyId)
+}
+
+func (rr *ReservationsRepository) GetList(ctx context.Context, params domain.ReservationParams) ([]domain.Reservation, error) {
+	return rr.psql.GetList(ctx, params)
+}
+
+func (rr *ReservationsRepository) Release(ctx context.Context, id, companyId int64) error {
+	return rr.psql.Release(ctx, id, companyId)
+}
+
+func (rr *ReservationsRepository) Consume(ctx context.Context, id, companyId, quantity, userId int64) (domain.Movement, error) {
+	return rr.psql.Consume(ctx, id, companyId, quantity, userId)
+}
+
+func (rr *ReservationsRepository) GetAvailability(ctx context.Context, materialId, companyId int64) (domain.Availability, error) {
+	return rr.psql.GetAvailability(ctx, materialId, companyId)
+}
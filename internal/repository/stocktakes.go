@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/cache"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Stocktakes interface {
+	Open(ctx context.Context, stocktake domain.Stocktake) (int64, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.Stocktake, error)
+	GetList(ctx context.Context, params domain.StocktakeParams) ([]domain.Stocktake, error)
+	Count(ctx context.Context, req domain.StocktakeCountRequest, source string) (domain.StocktakeLine, error)
+	GetCounts(ctx context.Context, id, companyId int64) ([]domain.StocktakeCount, error)
+	Approve(ctx context.Context, id, companyId, userId int64) ([]domain.Movement, error)
+	Cancel(ctx context.Context, id, companyId int64) error
+}
+
+type StocktakesRepository struct {
+	cfg  *config.Config
+	psql postgres.Stocktakes
+}
+
+func NewStocktakesRepository(cfg *config.Config, db *sql.DB, c *cache.Cache) *StocktakesRepository {
+	return &StocktakesRepository{
+		cfg:  cfg,
+		psql: cache.NewStocktakes(postgres.NewStocktakesPostgresRepository(db), c),
+	}
+}
+
+func (sr *StocktakesRepository) Open(ctx context.Context, stocktake domain.Stocktake) (int64, error) {
+	return sr.psql.Open(ctx, stocktake)
+}
+
+func (sr *StocktakesRepository) GetById(ctx context.Context, id, companyId int64) (domain.Stocktake, error) {
+	return sr.psql.GetById(ctx, id, companyId)
+}
+
+func (sr *StocktakesRepository) GetList(ctx context.Context, params domain.StocktakeParams) ([]domain.Stocktake, error) {
+	return sr.psql.GetList(ctx, params)
+}
+
+func (sr *StocktakesRepository) Count(ctx context.Context, req domain.StocktakeCountRequest, source string) (domain.StocktakeLine, error) {
+	return sr.psql.Count(ctx, req, source)
+}
+
+func (sr *StocktakesRepository) GetCounts(ctx context.Context, id, companyId int64) ([]domain.StocktakeCount, error) {
+	return sr.psql.GetCounts(ctx, id, companyId)
+}
+
+func (sr *StocktakesRepository) Approve(ctx context.Context, id, companyId, userId int64) ([]domain.Movement, error) {
+	return sr.psql.Approve(ctx, id, companyId, userId)
+}
+
+func (sr *StocktakesRepository) Cancel(ctx context.Context, id, companyId int64) error {
+	return sr.psql.Cancel(ctx, id, companyId)
+}
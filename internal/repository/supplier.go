@@ -4,16 +4,23 @@ import (
 	"context"
 	"database/sql"
 	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/audit"
+	"github.com/rusystem/crm-warehouse/internal/repository/cache"
 	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"time"
 )
 
 type Suppliers interface {
 	Create(ctx context.Context, supplier domain.Supplier) (int64, error)
-	GetById(ctx context.Context, id int64) (domain.Supplier, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.Supplier, error)
 	Update(ctx context.Context, supplier domain.Supplier) error
-	Delete(ctx context.Context, id int64) error
-	GetListByCompanyId(ctx context.Context, id int64) ([]domain.Supplier, error)
+	Delete(ctx context.Context, id, companyId int64) error
+	GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Supplier, error)
+
+	GetDeletedById(ctx context.Context, id, companyId int64) (domain.Supplier, error)
+	Restore(ctx context.Context, id, companyId int64) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
 }
 
 type SuppliersRepository struct {
@@ -21,10 +28,10 @@ type SuppliersRepository struct {
 	psql postgres.Suppliers
 }
 
-func NewSuppliersRepository(cfg *config.Config, db *sql.DB) *SuppliersRepository {
+func NewSuppliersRepository(cfg *config.Config, db *sql.DB, c *cache.Cache, log *audit.Log) *SuppliersRepository {
 	return &SuppliersRepository{
 		cfg:  cfg,
-		psql: postgres.NewSuppliersPostgresRepository(db),
+		psql: cache.NewSuppliers(audit.NewSuppliers(postgres.NewSuppliersPostgresRepository(db), log), c),
 	}
 }
 
@@ -32,18 +39,30 @@ func (sr *SuppliersRepository) Create(ctx context.Context, supplier domain.Suppl
 	return sr.psql.Create(ctx, supplier)
 }
 
-func (sr *SuppliersRepository) GetById(ctx context.Context, id int64) (domain.Supplier, error) {
-	return sr.psql.GetById(ctx, id)
+func (sr *SuppliersRepository) GetById(ctx context.Context, id, companyId int64) (domain.Supplier, error) {
+	return sr.psql.GetById(ctx, id, companyId)
 }
 
 func (sr *SuppliersRepository) Update(ctx context.Context, supplier domain.Supplier) error {
 	return sr.psql.Update(ctx, supplier)
 }
 
-func (sr *SuppliersRepository) Delete(ctx context.Context, id int64) error {
-	return sr.psql.Delete(ctx, id)
+func (sr *SuppliersRepository) Delete(ctx context.Context, id, companyId int64) error {
+	return sr.psql.Delete(ctx, id, companyId)
+}
+
+func (sr *SuppliersRepository) GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Supplier, error) {
+	return sr.psql.GetListByCompanyId(ctx, id, includeDeleted)
+}
+
+func (sr *SuppliersRepository) GetDeletedById(ctx context.Context, id, companyId int64) (domain.Supplier, error) {
+	return sr.psql.GetDeletedById(ctx, id, companyId)
+}
+
+func (sr *SuppliersRepository) Restore(ctx context.Context, id, companyId int64) error {
+	return sr.psql.Restore(ctx, id, companyId)
 }
 
-func (sr *SuppliersRepository) GetListByCompanyId(ctx context.Context, id int64) ([]domain.Supplier, error) {
-	return sr.psql.GetListByCompanyId(ctx, id)
+func (sr *SuppliersRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	return sr.psql.Purge(ctx, deletedBefore)
 }
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/cache"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Transfers interface {
+	Create(ctx context.Context, order domain.TransferOrder) (int64, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.TransferOrder, error)
+	GetList(ctx context.Context, params domain.TransferOrderParams) ([]domain.TransferOrder, error)
+	Ship(ctx context.Context, id, companyId, userId int64) error
+	Receive(ctx context.Context, id, companyId, userId int64, receipts []domain.TransferReceipt) error
+	Cancel(ctx context.Context, id, companyId int64) error
+	GetInTransit(ctx context.Context, params domain.InTransitParams) (int64, error)
+}
+
+type TransfersRepository struct {
+	cfg  *config.Config
+	psql postgres.Transfers
+}
+
+func NewTransfersRepository(cfg *config.Config, db *sql.DB, c *cache.Cache) *TransfersRepository {
+	return &TransfersRepository{
+		cfg:  cfg,
+		psql: cache.NewTransfers(postgres.NewTransfersPostgresRepository(db), c),
+	}
+}
+
+func (tr *TransfersRepository) Create(ctx context.Context, order domain.TransferOrder) (int64, error) {
+	return tr.psql.Create(ctx, order)
+}
+
+func (tr *TransfersRepository) GetById(ctx context.Context, id, companyId int64) (domain.TransferOrder, error) {
+	return tr.psql.GetById(ctx, id, companyId)
+}
+
+func (tr *TransfersRepository) GetList(ctx context.Context, params domain.TransferOrderParams) ([]domain.TransferOrder, error) {
+	return tr.psql.GetList(ctx, params)
+}
+
+func (tr *TransfersRepository) Ship(ctx context.Context, id, companyId, userId int64) error {
+	return tr.psql.Ship(ctx, id, companyId, userId)
+}
+
+func (tr *TransfersRepository) Receive(ctx context.Context, id, companyId, userId int64, receipts []domain.TransferReceipt) error {
+	return tr.psql.Receive(ctx, id, companyId, userId, receipts)
+}
+
+func (tr *TransfersRepository) Cancel(ctx context.Context, id, companyId int64) error {
+	return tr.psql.Cancel(ctx, id, companyId)
+}
+
+func (tr *TransfersRepository) GetInTransit(ctx context.Context, params domain.InTransitParams) (int64, error) {
+	return tr.psql.GetInTransit(ctx, params)
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+)
+
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type TransactorRepository struct {
+	cfg  *config.Config
+	psql postgres.Transactor
+}
+
+func NewTransactorRepository(cfg *config.Config, db *sql.DB) *TransactorRepository {
+	return &TransactorRepository{
+		cfg:  cfg,
+		psql: postgres.NewTransactorPostgres(db),
+	}
+}
+
+// WithinTx выполняет fn в одной транзакции postgres, репозитории вызываются с контекстом из fn
+func (tr *TransactorRepository) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return tr.psql.WithinTx(ctx, fn)
+}
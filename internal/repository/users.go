@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Users interface {
+	GetById(ctx context.Context, id int64) (domain.User, error)
+}
+
+type UsersRepository struct {
+	cfg  *config.Config
+	psql postgres.Users
+}
+
+func NewUsersRepository(cfg *config.Config, db *sql.DB) *UsersRepository {
+	return &UsersRepository{
+		cfg:  cfg,
+		psql: postgres.NewUsersPostgresRepository(db),
+	}
+}
+
+func (ur *UsersRepository) GetById(ctx context.Context, id int64) (domain.User, error) {
+	return ur.psql.GetById(ctx, id)
+}
@@ -4,17 +4,26 @@ import (
 	"context"
 	"database/sql"
 	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository/audit"
+	"github.com/rusystem/crm-warehouse/internal/repository/cache"
 	"github.com/rusystem/crm-warehouse/internal/repository/postgres"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"time"
 )
 
 type Warehouse interface {
 	Create(ctx context.Context, warehouse domain.Warehouse) (int64, error)
-	GetById(ctx context.Context, id int64) (domain.Warehouse, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.Warehouse, error)
 	Update(ctx context.Context, warehouse domain.Warehouse) error
-	Delete(ctx context.Context, id int64) error
-	GetListByCompanyId(ctx context.Context, id int64) ([]domain.Warehouse, error)
+	Delete(ctx context.Context, id, companyId int64) error
+	GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Warehouse, error)
 	GetResponsibleUsers(ctx context.Context, companyId int64) ([]domain.User, error)
+
+	RefreshOccupancy(ctx context.Context, companyId int64, ids ...int64) ([]domain.WarehouseOccupancy, error)
+
+	GetDeletedById(ctx context.Context, id, companyId int64) (domain.Warehouse, error)
+	Restore(ctx context.Context, id, companyId int64) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
 }
 
 type WarehouseRepository struct {
@@ -22,10 +31,10 @@ type WarehouseRepository struct {
 	psql postgres.Warehouse
 }
 
-func NewWarehouseRepository(cfg *config.Config, psql *sql.DB) *WarehouseRepository {
+func NewWarehouseRepository(cfg *config.Config, psql *sql.DB, c *cache.Cache, log *audit.Log) *WarehouseRepository {
 	return &WarehouseRepository{
 		cfg:  cfg,
-		psql: postgres.NewWarehousePostgresRepository(psql),
+		psql: cache.NewWarehouse(audit.NewWarehouse(postgres.NewWarehousePostgresRepository(psql), log), c),
 	}
 }
 
@@ -33,22 +42,38 @@ func (wr *WarehouseRepository) Create(ctx context.Context, warehouse domain.Ware
 	return wr.psql.Create(ctx, warehouse)
 }
 
-func (wr *WarehouseRepository) GetById(ctx context.Context, id int64) (domain.Warehouse, error) {
-	return wr.psql.GetById(ctx, id)
+func (wr *WarehouseRepository) GetById(ctx context.Context, id, companyId int64) (domain.Warehouse, error) {
+	return wr.psql.GetById(ctx, id, companyId)
 }
 
 func (wr *WarehouseRepository) Update(ctx context.Context, warehouse domain.Warehouse) error {
 	return wr.psql.Update(ctx, warehouse)
 }
 
-func (wr *WarehouseRepository) Delete(ctx context.Context, id int64) error {
-	return wr.psql.Delete(ctx, id)
+func (wr *WarehouseRepository) Delete(ctx context.Context, id, companyId int64) error {
+	return wr.psql.Delete(ctx, id, companyId)
 }
 
-func (wr *WarehouseRepository) GetListByCompanyId(ctx context.Context, id int64) ([]domain.Warehouse, error) {
-	return wr.psql.GetListByCompanyId(ctx, id)
+func (wr *WarehouseRepository) GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Warehouse, error) {
+	return wr.psql.GetListByCompanyId(ctx, id, includeDeleted)
 }
 
 func (wr *WarehouseRepository) GetResponsibleUsers(ctx context.Context, companyId int64) ([]domain.User, error) {
 	return wr.psql.GetResponsibleUsers(ctx, companyId)
 }
+
+func (wr *WarehouseRepository) RefreshOccupancy(ctx context.Context, companyId int64, ids ...int64) ([]domain.WarehouseOccupancy, error) {
+	return wr.psql.RefreshOccupancy(ctx, companyId, ids...)
+}
+
+func (wr *WarehouseRepository) GetDeletedById(ctx context.Context, id, companyId int64) (domain.Warehouse, error) {
+	return wr.psql.GetDeletedById(ctx, id, companyId)
+}
+
+func (wr *WarehouseRepository) Restore(ctx context.Context, id, companyId int64) error {
+	return wr.psql.Restore(ctx, id, companyId)
+}
+
+func (wr *WarehouseRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	return wr.psql.Purge(ctx, deletedBefore)
+}
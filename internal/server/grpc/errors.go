@@ -0,0 +1,129 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorCodes - соответствие доменных ошибок кодам grpc, первое совпадение по errors.Is
+var errorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{domain.ErrWarehouseNotFound, codes.NotFound},
+	{domain.ErrSupplierNotFound, codes.NotFound},
+	{domain.ErrMaterialNotFound, codes.NotFound},
+	{domain.ErrCategoryNotFound, codes.NotFound},
+	{domain.ErrMovementNotFound, codes.NotFound},
+	{domain.ErrUserNotFound, codes.NotFound},
+	{domain.ErrTransferOrderNotFound, codes.NotFound},
+	{domain.ErrReservationNotFound, codes.NotFound},
+	{domain.ErrLocationNotFound, codes.NotFound},
+	{domain.ErrStocktakeNotFound, codes.NotFound},
+	{domain.ErrEmptyId, codes.InvalidArgument},
+	{domain.ErrInvalidArgument, codes.InvalidArgument},
+	{domain.ErrInvalidMovement, codes.InvalidArgument},
+	{domain.ErrInvalidTransferOrder, codes.InvalidArgument},
+	{domain.ErrInvalidReservation, codes.InvalidArgument},
+	{domain.ErrInvalidLocation, codes.InvalidArgument},
+	{domain.ErrInvalidStocktake, codes.InvalidArgument},
+	{domain.ErrInvalidCategory, codes.InvalidArgument},
+	{domain.ErrAlreadyExists, codes.AlreadyExists},
+	{domain.ErrInsufficientStock, codes.FailedPrecondition},
+	{domain.ErrMaterialQuarantined, codes.FailedPrecondition},
+	{domain.ErrTransferOrderStatus, codes.FailedPrecondition},
+	{domain.ErrReservationStatus, codes.FailedPrecondition},
+	{domain.ErrReferenced, codes.FailedPrecondition},
+	{domain.ErrLocationFull, codes.FailedPrecondition},
+	{domain.ErrWarehouseFull, codes.FailedPrecondition},
+	{domain.ErrStocktakeStatus, codes.FailedPrecondition},
+	{domain.ErrUnauthenticated, codes.Unauthenticated},
+	{domain.ErrPermissionDenied, codes.PermissionDenied},
+	{domain.ErrAnalyticsDisabled, codes.Unavailable},
+	{context.Canceled, codes.Canceled},
+	{context.DeadlineExceeded, codes.DeadlineExceeded},
+}
+
+// ErrorUnaryInterceptor переводит ошибки обработчиков в статусы grpc, должен стоять первым в цепочке
+func ErrorUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatus(info.FullMethod, err)
+		}
+
+		return resp, nil
+	}
+}
+
+// ErrorStreamInterceptor - то же для потоковых методов
+func ErrorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return toStatus(info.FullMethod, err)
+		}
+
+		return nil
+	}
+}
+
+// toStatus возвращает статус с кодом доменной ошибки, ErrorInfo с ее reason и BadRequest для ошибок валидации,
+// неизвестные ошибки логируются и уходят клиенту как Internal без подробностей
+func toStatus(method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code := codes.Internal
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			code = c.code
+			break
+		}
+	}
+
+	if code == codes.Internal {
+		logger.Error("grpc handler error", zap.String("method", method), zap.Error(err))
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	st := status.New(code, err.Error())
+
+	reason, ok := domain.ErrorReason(err)
+	if !ok {
+		return st.Err()
+	}
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: domain.ErrorDomain,
+	}}
+
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range validationErr.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+
+		details = append(details, badRequest)
+	}
+
+	detailed, dErr := st.WithDetails(details...)
+	if dErr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
@@ -0,0 +1,155 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	logger.ZapLoggerInit()
+	os.Exit(m.Run())
+}
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		code       codes.Code
+		message    string
+		reason     string
+		violations []domain.FieldViolation
+	}{
+		{
+			name:    "not found",
+			err:     domain.ErrWarehouseNotFound,
+			code:    codes.NotFound,
+			message: "warehouse not found",
+			reason:  "WAREHOUSE_NOT_FOUND",
+		},
+		{
+			name:    "wrapped error keeps message",
+			err:     fmt.Errorf("%w: on hand 3, requested 5", domain.ErrInsufficientStock),
+			code:    codes.FailedPrecondition,
+			message: "insufficient stock: on hand 3, requested 5",
+			reason:  "INSUFFICIENT_STOCK",
+		},
+		{
+			name:    "invalid movement",
+			err:     fmt.Errorf("%w: warehouse is changed by transfer only", domain.ErrInvalidMovement),
+			code:    codes.InvalidArgument,
+			message: "invalid movement: warehouse is changed by transfer only",
+			reason:  "INVALID_MOVEMENT",
+		},
+		{
+			name:    "already exists",
+			err:     domain.ErrAlreadyExists,
+			code:    codes.AlreadyExists,
+			message: domain.ErrAlreadyExists.Error(),
+			reason:  "ALREADY_EXISTS",
+		},
+		{
+			name:    "permission denied",
+			err:     domain.ErrPermissionDenied,
+			code:    codes.PermissionDenied,
+			message: domain.ErrPermissionDenied.Error(),
+			reason:  "PERMISSION_DENIED",
+		},
+		{
+			name: "validation error",
+			err: &domain.ValidationError{Violations: []domain.FieldViolation{
+				{Field: "name", Description: "must not be empty"},
+				{Field: "supplier_id", Description: "supplier not found"},
+			}},
+			code:    codes.InvalidArgument,
+			message: "invalid argument: name: must not be empty; supplier_id: supplier not found",
+			reason:  "INVALID_ARGUMENT",
+			violations: []domain.FieldViolation{
+				{Field: "name", Description: "must not be empty"},
+				{Field: "supplier_id", Description: "supplier not found"},
+			},
+		},
+		{
+			name:    "context canceled without reason",
+			err:     fmt.Errorf("query: %w", context.Canceled),
+			code:    codes.Canceled,
+			message: "query: context canceled",
+		},
+		{
+			name:    "deadline exceeded",
+			err:     context.DeadlineExceeded,
+			code:    codes.DeadlineExceeded,
+			message: "context deadline exceeded",
+		},
+		{
+			name:    "unknown error hidden",
+			err:     errors.New("pq: relation \"materials\" does not exist"),
+			code:    codes.Internal,
+			message: "internal server error",
+		},
+		{
+			name:    "status passed through",
+			err:     status.Error(codes.Unimplemented, "method not implemented"),
+			code:    codes.Unimplemented,
+			message: "method not implemented",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(toStatus("/warehouse.Service/Method", tt.err))
+			if !ok {
+				t.Fatalf("toStatus() is not a grpc status")
+			}
+
+			if st.Code() != tt.code || st.Message() != tt.message {
+				t.Errorf("toStatus() = %s %q, want %s %q", st.Code(), st.Message(), tt.code, tt.message)
+			}
+
+			var reason string
+			var violations []domain.FieldViolation
+			for _, d := range st.Details() {
+				switch d := d.(type) {
+				case *errdetails.ErrorInfo:
+					if d.Domain != domain.ErrorDomain {
+						t.Errorf("ErrorInfo domain = %q, want %q", d.Domain, domain.ErrorDomain)
+					}
+					reason = d.Reason
+				case *errdetails.BadRequest:
+					for _, v := range d.FieldViolations {
+						violations = append(violations, domain.FieldViolation{Field: v.Field, Description: v.Description})
+					}
+				}
+			}
+
+			if reason != tt.reason {
+				t.Errorf("ErrorInfo reason = %q, want %q", reason, tt.reason)
+			}
+
+			if !reflect.DeepEqual(violations, tt.violations) {
+				t.Errorf("BadRequest violations = %v, want %v", violations, tt.violations)
+			}
+		})
+	}
+}
+
+// Каждая доменная ошибка из errorCodes отдается клиенту с кодом grpc, отличным от Internal, и с reason
+func TestErrorCodesHaveReasons(t *testing.T) {
+	for _, c := range errorCodes {
+		if c.code == codes.Internal {
+			t.Errorf("%v is mapped to Internal", c.err)
+		}
+
+		if _, ok := domain.ErrorReason(c.err); !ok && c.err != context.Canceled && c.err != context.DeadlineExceeded {
+			t.Errorf("%v has no reason", c.err)
+		}
+	}
+}
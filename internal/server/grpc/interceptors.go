@@ -0,0 +1,146 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/auth"
+	"github.com/rusystem/crm-warehouse/internal/service"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+)
+
+const bearerPrefix = "bearer "
+
+// MethodUnaryInterceptor кладет в контекст вызванный метод, метод попадает в журнал аудита изменений
+func MethodUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(auth.WithMethod(ctx, info.FullMethod), req)
+	}
+}
+
+// AuthUnaryInterceptor проверяет токен, права по таблице policies и привязывает запрос к компании пользователя
+func AuthUnaryInterceptor(svc service.Auth) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, p, err := authorize(ctx, svc, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = bindCompany(ctx, req, p.companyField); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamInterceptor - то же для потоковых методов, компания проверяется на каждом входящем сообщении
+func AuthStreamInterceptor(svc service.Auth) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, p, err := authorize(ss.Context(), svc, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authStream{ServerStream: ss, ctx: ctx, companyField: p.companyField})
+	}
+}
+
+type authStream struct {
+	grpc.ServerStream
+	ctx          context.Context
+	companyField string
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return bindCompany(s.ctx, m, s.companyField)
+}
+
+func authorize(ctx context.Context, svc service.Auth, method string) (context.Context, policy, error) {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, policy{}, fmt.Errorf("%w: %v", domain.ErrUnauthenticated, err)
+	}
+
+	user, err := svc.Authenticate(ctx, token)
+	if err != nil {
+		return nil, policy{}, err
+	}
+
+	p, ok := policies[method]
+	if !auth.IsSuperuser(user) && (!ok || !auth.HasAnySection(user, p.sections...)) {
+		return nil, policy{}, fmt.Errorf("%w: %s", domain.ErrPermissionDenied, method)
+	}
+
+	return auth.WithUser(ctx, user), p, nil
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errors.New("missing metadata")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", errors.New("missing authorization header")
+	}
+
+	if len(values[0]) <= len(bearerPrefix) || !strings.EqualFold(values[0][:len(bearerPrefix)], bearerPrefix) {
+		return "", errors.New("authorization header must be a bearer token")
+	}
+
+	return values[0][len(bearerPrefix):], nil
+}
+
+// bindCompany подставляет компанию пользователя в пустое поле компании запроса
+// и запрещает обращение к данным чужой компании
+func bindCompany(ctx context.Context, req interface{}, companyField string) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+
+	var fd protoreflect.FieldDescriptor
+	if companyField != "" {
+		fd = fields.ByName(protoreflect.Name(companyField))
+	} else if fd = fields.ByName("CompanyId"); fd == nil {
+		fd = fields.ByName("company_id")
+	}
+
+	if fd == nil || fd.Kind() != protoreflect.Int64Kind || fd.IsList() {
+		return nil
+	}
+
+	companyId := m.Get(fd).Int()
+
+	switch {
+	case companyId == 0:
+		m.Set(fd, protoreflect.ValueOfInt64(user.CompanyID))
+	case companyId != user.CompanyID && !auth.IsSuperuser(user):
+		return fmt.Errorf("%w: company %d", domain.ErrPermissionDenied, companyId)
+	}
+
+	return nil
+}
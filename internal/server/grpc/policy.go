@@ -0,0 +1,137 @@
+package grpc
+
+import (
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/audit"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/materials"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/movements"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/reports"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/reservations"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/stocktakes"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/supplier"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/transfers"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/warehouse"
+)
+
+// policy описывает, каким секциям разрешен вызов метода
+type policy struct {
+	sections []string
+	// companyField - поле запроса с идентификатором компании, если оно называется не CompanyId/company_id
+	companyField string
+}
+
+var (
+	// управление в рамках компании
+	adminSections = []string{
+		domain.SectionFullCompanyAccess,
+		domain.SectionFullAccess,
+	}
+
+	// снабжение: заявки на закуп, закупленные материалы, поставщики
+	purchaseSections = append([]string{domain.SectionPurchasePlanningAccess}, adminSections...)
+
+	// просмотр складских данных для производства
+	readSections = append([]string{domain.SectionProductionDataAccess}, purchaseSections...)
+)
+
+// policies - таблица доступа по методам, метод без записи в таблице запрещен всем,
+// кроме пользователей с domain.SectionFullAllAccess
+var policies = map[string]policy{
+	materials.MaterialService_CreatePlanning_FullMethodName:          {sections: purchaseSections},
+	materials.MaterialService_UpdatePlanning_FullMethodName:          {sections: purchaseSections},
+	materials.MaterialService_DeletePlanning_FullMethodName:          {sections: purchaseSections},
+	materials.MaterialService_GetPlanning_FullMethodName:             {sections: purchaseSections},
+	materials.MaterialService_GetListPlanning_FullMethodName:         {sections: purchaseSections},
+	materials.MaterialService_MovePlanningToPurchased_FullMethodName: {sections: purchaseSections},
+
+	materials.MaterialService_CreatePurchased_FullMethodName:        {sections: purchaseSections},
+	materials.MaterialService_UpdatePurchased_FullMethodName:        {sections: purchaseSections},
+	materials.MaterialService_DeletePurchased_FullMethodName:        {sections: adminSections},
+	materials.MaterialService_GetPurchased_FullMethodName:           {sections: readSections},
+	materials.MaterialService_GetListPurchased_FullMethodName:       {sections: readSections},
+	materials.MaterialService_MovePurchasedToArchive_FullMethodName: {sections: purchaseSections},
+
+	materials.MaterialService_GetPlanningArchive_FullMethodName:      {sections: purchaseSections},
+	materials.MaterialService_GetPurchasedArchive_FullMethodName:     {sections: readSections},
+	materials.MaterialService_GetListPlanningArchive_FullMethodName:  {sections: purchaseSections},
+	materials.MaterialService_GetListPurchasedArchive_FullMethodName: {sections: readSections},
+	materials.MaterialService_DeletePlanningArchive_FullMethodName:   {sections: adminSections},
+	materials.MaterialService_DeletePurchasedArchive_FullMethodName:  {sections: adminSections},
+
+	materials.MaterialService_SearchMaterial_FullMethodName: {sections: readSections},
+
+	materials.MaterialService_CreateMaterialCategory_FullMethodName:     {sections: purchaseSections},
+	materials.MaterialService_GetByIdMaterialCategory_FullMethodName:    {sections: readSections},
+	materials.MaterialService_UpdateMaterialCategory_FullMethodName:     {sections: purchaseSections},
+	materials.MaterialService_DeleteMaterialCategory_FullMethodName:     {sections: adminSections},
+	materials.MaterialService_GetListMaterialCategory_FullMethodName:    {sections: readSections},
+	materials.MaterialService_SearchMaterialCategory_FullMethodName:     {sections: readSections},
+	materials.MaterialService_RestoreMaterialCategory_FullMethodName:    {sections: adminSections},
+	materials.MaterialService_MoveMaterialCategory_FullMethodName:       {sections: purchaseSections},
+	materials.MaterialService_ReparentMaterialCategories_FullMethodName: {sections: purchaseSections},
+
+	materials.MaterialService_GetExpiring_FullMethodName:       {sections: readSections},
+	materials.MaterialService_QuarantineExpired_FullMethodName: {sections: purchaseSections},
+	materials.MaterialService_SuggestFefo_FullMethodName:       {sections: readSections},
+
+	materials.MaterialService_GetQRCode_FullMethodName: {sections: readSections},
+	materials.MaterialService_GetLabels_FullMethodName: {sections: readSections},
+	materials.MaterialService_ScanQR_FullMethodName:    {sections: readSections},
+	materials.MaterialService_PutAway_FullMethodName:   {sections: purchaseSections},
+
+	warehouse.WarehouseService_Create_FullMethodName:              {sections: adminSections},
+	warehouse.WarehouseService_GetById_FullMethodName:             {sections: readSections},
+	warehouse.WarehouseService_Update_FullMethodName:              {sections: adminSections},
+	warehouse.WarehouseService_Delete_FullMethodName:              {sections: adminSections},
+	warehouse.WarehouseService_GetList_FullMethodName:             {sections: readSections, companyField: "Id"},
+	warehouse.WarehouseService_GetResponsibleUsers_FullMethodName: {sections: purchaseSections, companyField: "Id"},
+	warehouse.WarehouseService_Restore_FullMethodName:             {sections: adminSections},
+
+	warehouse.WarehouseService_CreateLocation_FullMethodName:   {sections: adminSections},
+	warehouse.WarehouseService_GetLocation_FullMethodName:      {sections: readSections},
+	warehouse.WarehouseService_UpdateLocation_FullMethodName:   {sections: adminSections},
+	warehouse.WarehouseService_DeleteLocation_FullMethodName:   {sections: adminSections},
+	warehouse.WarehouseService_GetListLocations_FullMethodName: {sections: readSections},
+
+	supplier.SupplierService_Create_FullMethodName:  {sections: purchaseSections},
+	supplier.SupplierService_GetById_FullMethodName: {sections: purchaseSections},
+	supplier.SupplierService_Update_FullMethodName:  {sections: purchaseSections},
+	supplier.SupplierService_Delete_FullMethodName:  {sections: adminSections},
+	supplier.SupplierService_GetList_FullMethodName: {sections: purchaseSections, companyField: "Id"},
+	supplier.SupplierService_Restore_FullMethodName: {sections: adminSections},
+
+	movements.MovementService_Create_FullMethodName:         {sections: purchaseSections},
+	movements.MovementService_GetById_FullMethodName:        {sections: readSections},
+	movements.MovementService_GetList_FullMethodName:        {sections: readSections},
+	movements.MovementService_GetStockOnHand_FullMethodName: {sections: readSections},
+
+	transfers.TransferService_Create_FullMethodName:       {sections: purchaseSections},
+	transfers.TransferService_GetById_FullMethodName:      {sections: readSections},
+	transfers.TransferService_GetList_FullMethodName:      {sections: readSections},
+	transfers.TransferService_Ship_FullMethodName:         {sections: purchaseSections},
+	transfers.TransferService_Receive_FullMethodName:      {sections: purchaseSections},
+	transfers.TransferService_Cancel_FullMethodName:       {sections: purchaseSections},
+	transfers.TransferService_GetInTransit_FullMethodName: {sections: readSections},
+
+	reservations.ReservationService_Reserve_FullMethodName:         {sections: purchaseSections},
+	reservations.ReservationService_GetById_FullMethodName:         {sections: readSections},
+	reservations.ReservationService_GetList_FullMethodName:         {sections: readSections},
+	reservations.ReservationService_Release_FullMethodName:         {sections: purchaseSections},
+	reservations.ReservationService_Consume_FullMethodName:         {sections: purchaseSections},
+	reservations.ReservationService_GetAvailability_FullMethodName: {sections: readSections},
+
+	reports.ReportService_GetStockOnDate_FullMethodName:      {sections: readSections},
+	reports.ReportService_GetCategoryTurnover_FullMethodName: {sections: readSections},
+	reports.ReportService_GetSupplierSpend_FullMethodName:    {sections: purchaseSections},
+
+	stocktakes.StocktakeService_Open_FullMethodName:        {sections: purchaseSections},
+	stocktakes.StocktakeService_GetById_FullMethodName:     {sections: readSections},
+	stocktakes.StocktakeService_GetList_FullMethodName:     {sections: readSections},
+	stocktakes.StocktakeService_Count_FullMethodName:       {sections: purchaseSections},
+	stocktakes.StocktakeService_GetCounts_FullMethodName:   {sections: readSections},
+	stocktakes.StocktakeService_GetVariance_FullMethodName: {sections: readSections},
+	stocktakes.StocktakeService_Approve_FullMethodName:     {sections: adminSections},
+	stocktakes.StocktakeService_Cancel_FullMethodName:      {sections: purchaseSections},
+
+	audit.AuditService_GetList_FullMethodName: {sections: adminSections},
+}
@@ -2,8 +2,15 @@ package grpc
 
 import (
 	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/service"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/audit"
 	"github.com/rusystem/crm-warehouse/pkg/gen/proto/materials"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/movements"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/reports"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/reservations"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/stocktakes"
 	"github.com/rusystem/crm-warehouse/pkg/gen/proto/supplier"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/transfers"
 	"github.com/rusystem/crm-warehouse/pkg/gen/proto/warehouse"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
@@ -12,13 +19,23 @@ import (
 )
 
 type Server struct {
-	server          *grpc.Server
-	warehouseServer warehouse.WarehouseServiceServer
-	supplierServer  supplier.SupplierServiceServer
-	materialsServer materials.MaterialServiceServer
+	server             *grpc.Server
+	warehouseServer    warehouse.WarehouseServiceServer
+	supplierServer     supplier.SupplierServiceServer
+	materialsServer    materials.MaterialServiceServer
+	movementsServer    movements.MovementServiceServer
+	transfersServer    transfers.TransferServiceServer
+	reservationsServer reservations.ReservationServiceServer
+	reportsServer      reports.ReportServiceServer
+	stocktakesServer   stocktakes.StocktakeServiceServer
+	auditServer        audit.AuditServiceServer
 }
 
-func New(warehouseServer warehouse.WarehouseServiceServer, supplierServer supplier.SupplierServiceServer, materialsServer materials.MaterialServiceServer) *Server {
+func New(auth service.Auth, warehouseServer warehouse.WarehouseServiceServer, supplierServer supplier.SupplierServiceServer,
+	materialsServer materials.MaterialServiceServer, movementsServer movements.MovementServiceServer,
+	transfersServer transfers.TransferServiceServer, reservationsServer reservations.ReservationServiceServer,
+	reportsServer reports.ReportServiceServer, stocktakesServer stocktakes.StocktakeServiceServer,
+	auditServer audit.AuditServiceServer) *Server {
 	opt := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(1024 * 1024 * 100),
 		grpc.MaxSendMsgSize(1024 * 1024 * 100),
@@ -28,14 +45,38 @@ func New(warehouseServer warehouse.WarehouseServiceServer, supplierServer suppli
 		}),
 	}
 
+	stream := []grpc.StreamServerInterceptor{ErrorStreamInterceptor()}
+	if auth != nil {
+		stream = append(stream, AuthStreamInterceptor(auth))
+	}
+
+	opt = append(opt, grpc.ChainUnaryInterceptor(UnaryInterceptors(auth)...), grpc.ChainStreamInterceptor(stream...))
+
 	return &Server{
-		server:          grpc.NewServer(opt...),
-		warehouseServer: warehouseServer,
-		supplierServer:  supplierServer,
-		materialsServer: materialsServer,
+		server:             grpc.NewServer(opt...),
+		warehouseServer:    warehouseServer,
+		supplierServer:     supplierServer,
+		materialsServer:    materialsServer,
+		movementsServer:    movementsServer,
+		transfersServer:    transfersServer,
+		reservationsServer: reservationsServer,
+		reportsServer:      reportsServer,
+		stocktakesServer:   stocktakesServer,
+		auditServer:        auditServer,
 	}
 }
 
+// UnaryInterceptors цепочка перехватчиков унарных методов, общая для grpc и NATS транспорта.
+// Без auth методы вызываются без проверки токена, например в dev окружении.
+func UnaryInterceptors(auth service.Auth) []grpc.UnaryServerInterceptor {
+	unary := []grpc.UnaryServerInterceptor{ErrorUnaryInterceptor(), MethodUnaryInterceptor()}
+	if auth != nil {
+		unary = append(unary, AuthUnaryInterceptor(auth))
+	}
+
+	return unary
+}
+
 func (s *Server) Run(port int64) error {
 	addr := fmt.Sprintf(":%d", port)
 
@@ -47,6 +88,12 @@ func (s *Server) Run(port int64) error {
 	warehouse.RegisterWarehouseServiceServer(s.server, s.warehouseServer)
 	supplier.RegisterSupplierServiceServer(s.server, s.supplierServer)
 	materials.RegisterMaterialServiceServer(s.server, s.materialsServer)
+	movements.RegisterMovementServiceServer(s.server, s.movementsServer)
+	transfers.RegisterTransferServiceServer(s.server, s.transfersServer)
+	reservations.RegisterReservationServiceServer(s.server, s.reservationsServer)
+	reports.RegisterReportServiceServer(s.server, s.reportsServer)
+	stocktakes.RegisterStocktakeServiceServer(s.server, s.stocktakesServer)
+	audit.RegisterAuditServiceServer(s.server, s.auditServer)
 
 	if err = s.server.Serve(lis); err != nil {
 		return err
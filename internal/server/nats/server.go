@@ -0,0 +1,197 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	natsio "github.com/nats-io/nats.go"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	grpcServer "github.com/rusystem/crm-warehouse/internal/server/grpc"
+	"github.com/rusystem/crm-warehouse/internal/service"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/materials"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/supplier"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/warehouse"
+	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"github.com/rusystem/crm-warehouse/pkg/mq"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Server - request-reply транспорт NATS поверх тех же обработчиков, что и grpc сервер.
+// Каждый метод слушает subject mq.RPCSubject в очереди QueueGroup, запрос и ответ - protobuf сообщения метода.
+type Server struct {
+	nc          *natsio.Conn
+	cfg         config.NatsRPC
+	interceptor grpc.UnaryServerInterceptor
+	services    []registration
+	subs        []*natsio.Subscription
+	sem         chan struct{}
+	inflight    sync.WaitGroup // запросы, переданные в обработку
+}
+
+type registration struct {
+	desc *grpc.ServiceDesc
+	impl interface{}
+}
+
+func New(nc *natsio.Conn, cfg config.NatsRPC, auth service.Auth, warehouseServer warehouse.WarehouseServiceServer,
+	supplierServer supplier.SupplierServiceServer, materialsServer materials.MaterialServiceServer) *Server {
+	return &Server{
+		nc:          nc,
+		cfg:         cfg,
+		interceptor: chainUnary(grpcServer.UnaryInterceptors(auth)),
+		services: []registration{
+			{desc: &warehouse.WarehouseService_ServiceDesc, impl: warehouseServer},
+			{desc: &supplier.SupplierService_ServiceDesc, impl: supplierServer},
+			{desc: &materials.MaterialService_ServiceDesc, impl: materialsServer},
+		},
+		sem: make(chan struct{}, cfg.Concurrency),
+	}
+}
+
+func (s *Server) Run() error {
+	for _, r := range s.services {
+		for _, m := range r.desc.Methods {
+			fullMethod := "/" + r.desc.ServiceName + "/" + m.MethodName
+
+			sub, err := s.nc.QueueSubscribe(mq.RPCSubject(s.cfg.SubjectPrefix, fullMethod), s.cfg.QueueGroup,
+				s.handler(r.impl, fullMethod, m))
+			if err != nil {
+				return fmt.Errorf("failed to subscribe %s: %w", fullMethod, err)
+			}
+
+			s.subs = append(s.subs, sub)
+		}
+	}
+
+	return nil
+}
+
+// Stop отписывается от subject и ждет обработки уже полученных запросов, но не дольше cfg.Timeout
+func (s *Server) Stop() {
+	closed := make([]<-chan natsio.SubStatus, 0, len(s.subs))
+
+	for _, sub := range s.subs {
+		ch := sub.StatusChanged(natsio.SubscriptionClosed)
+		if err := sub.Drain(); err != nil {
+			logger.Warn("nats rpc: failed to drain subscription", zap.String("subject", sub.Subject), zap.Error(err))
+			continue
+		}
+
+		closed = append(closed, ch)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		// Drain асинхронный: подписка закрывается, когда все полученные сообщения переданы обработчику
+		for _, ch := range closed {
+			<-ch
+		}
+
+		s.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(s.cfg.Timeout):
+		logger.Warn("nats rpc: stop timed out, requests in progress are abandoned")
+	}
+}
+
+func (s *Server) handler(impl interface{}, fullMethod string, method grpc.MethodDesc) natsio.MsgHandler {
+	return func(msg *natsio.Msg) {
+		s.sem <- struct{}{}
+		s.inflight.Add(1)
+
+		go func() {
+			defer func() {
+				<-s.sem
+				s.inflight.Done()
+			}()
+
+			s.serve(msg, impl, fullMethod, method)
+		}()
+	}
+}
+
+func (s *Server) serve(msg *natsio.Msg, impl interface{}, fullMethod string, method grpc.MethodDesc) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+
+	// заголовки запроса передаются обработчикам как метаданные grpc, в том числе authorization
+	ctx = metadata.NewIncomingContext(ctx, incomingMetadata(msg.Header))
+
+	dec := func(in interface{}) error {
+		if err := proto.Unmarshal(msg.Data, in.(proto.Message)); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid request payload")
+		}
+
+		return nil
+	}
+
+	resp, err := method.Handler(impl, ctx, dec, s.interceptor)
+
+	s.reply(msg, fullMethod, resp, err)
+}
+
+// reply отвечает телом ответа метода или google.rpc.Status при ошибке, код статуса всегда в StatusHeader
+func (s *Server) reply(msg *natsio.Msg, fullMethod string, resp interface{}, err error) {
+	if msg.Reply == "" {
+		return
+	}
+
+	out := natsio.NewMsg(msg.Reply)
+
+	st := status.Convert(err)
+	if err == nil {
+		if out.Data, err = proto.Marshal(resp.(proto.Message)); err != nil {
+			logger.Error("nats rpc: failed to marshal response", zap.String("method", fullMethod), zap.Error(err))
+			st = status.New(codes.Internal, "internal server error")
+		}
+	}
+
+	out.Header.Set(mq.StatusHeader, strconv.Itoa(int(st.Code())))
+
+	if st.Code() != codes.OK {
+		out.Header.Set(mq.MessageHeader, st.Message())
+		if out.Data, err = proto.Marshal(st.Proto()); err != nil {
+			out.Data = nil
+		}
+	}
+
+	if err = msg.RespondMsg(out); err != nil {
+		logger.Warn("nats rpc: failed to respond", zap.String("method", fullMethod), zap.Error(err))
+	}
+}
+
+func incomingMetadata(h natsio.Header) metadata.MD {
+	md := metadata.MD{}
+	for k, v := range h {
+		md[strings.ToLower(k)] = v
+	}
+
+	return md
+}
+
+// chainUnary объединяет перехватчики в один в том же порядке, что и grpc.ChainUnaryInterceptor
+func chainUnary(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, h := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, h)
+			}
+		}
+
+		return next(ctx, req)
+	}
+}
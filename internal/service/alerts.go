@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/nats-io/nats.go"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"github.com/rusystem/crm-warehouse/pkg/telegram"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+type Alerts interface {
+	CheckLowStock(ctx context.Context) error
+}
+
+// TelegramSender отправляет сообщение в чат уведомлений, реализуется telegram.Telegram.
+// Ошибка означает, что сообщение не принято в очередь отправки.
+type TelegramSender interface {
+	Send(msg telegram.Message) error
+}
+
+type AlertService struct {
+	cfg  *config.Config
+	repo *repository.Repository
+	nc   *nats.Conn
+	tg   TelegramSender
+}
+
+func NewAlertService(cfg *config.Config, repo *repository.Repository, nc *nats.Conn, tg TelegramSender) *AlertService {
+	return &AlertService{
+		cfg:  cfg,
+		repo: repo,
+		nc:   nc,
+		tg:   tg,
+	}
+}
+
+// CheckLowStock закрывает уведомления по восстановленным остаткам, открывает новые и рассылает недоставленные.
+// Уведомление по товару на складе доставляется один раз, пока остаток не поднимется до минимального,
+// неудачная рассылка повторяется при следующей проверке только по не получившим его каналам.
+func (as *AlertService) CheckLowStock(ctx context.Context) error {
+	if _, err := as.repo.Alerts.ResolveLowStock(ctx); err != nil {
+		return err
+	}
+
+	if _, err := as.repo.Alerts.RaiseLowStock(ctx); err != nil {
+		return err
+	}
+
+	alerts, err := as.repo.Alerts.GetUndelivered(ctx)
+	if err != nil {
+		return err
+	}
+
+	recipients := make(map[int64][]domain.User)
+
+	var errs []error
+	for _, alert := range alerts {
+		users, ok := recipients[alert.CompanyID]
+		if !ok {
+			if users, err = as.responsibleUsers(ctx, alert.CompanyID); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			recipients[alert.CompanyID] = users
+		}
+
+		for _, u := range users {
+			alert.Recipients = append(alert.Recipients, u.ID)
+		}
+
+		if err = as.notify(ctx, alert, users); err != nil {
+			logger.Warn("low stock alert delivery failed", zap.Int64("alert_id", alert.ID), zap.Error(err))
+			errs = append(errs, err)
+			continue
+		}
+
+		if err = as.repo.Alerts.MarkNotified(ctx, alert.ID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// responsibleUsers ответственные за склады пользователи, согласные получать системные уведомления
+func (as *AlertService) responsibleUsers(ctx context.Context, companyId int64) ([]domain.User, error) {
+	users, err := as.repo.Warehouse.GetResponsibleUsers(ctx, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	var active []domain.User
+	for _, u := range users {
+		if u.IsActive && u.IsSendSystemNotification {
+			active = append(active, u)
+		}
+	}
+
+	return active, nil
+}
+
+// notify рассылает уведомление по каналам, которые его еще не получили. Доставка по каждому каналу
+// отмечается сразу, поэтому сбой одного канала повторяет при следующей проверке только его.
+func (as *AlertService) notify(ctx context.Context, alert domain.LowStockAlert, users []domain.User) error {
+	var errs []error
+
+	if as.tg != nil && !alert.TelegramNotified {
+		if err := as.sendTelegram(alert, users); err != nil {
+			errs = append(errs, err)
+		} else if err = as.repo.Alerts.MarkChannelNotified(ctx, alert.ID, domain.AlertChannelTelegram); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if as.nc != nil && as.cfg.Alerts.LowStockSubject != "" && !alert.NatsNotified {
+		if err := as.publish(alert); err != nil {
+			errs = append(errs, err)
+		} else if err = as.repo.Alerts.MarkChannelNotified(ctx, alert.ID, domain.AlertChannelNats); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (as *AlertService) sendTelegram(alert domain.LowStockAlert, users []domain.User) error {
+	names := make([]string, 0, len(users))
+	for _, u := range users {
+		names = append(names, fmt.Sprintf("%s <%s>", u.Name, u.Email))
+	}
+
+	return as.tg.Send(telegram.Message{
+		Type:      telegram.TypeLowStock,
+		CompanyID: alert.CompanyID,
+		Datetime:  alert.RaisedAt.Format(time.DateTime),
+		Payload:   "Ответственные: " + strings.Join(names, ", "),
+		Data:      alert,
+	})
+}
+
+func (as *AlertService) publish(alert domain.LowStockAlert) error {
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	return as.nc.Publish(as.cfg.Alerts.LowStockSubject, data)
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Audit interface {
+	GetList(ctx context.Context, params domain.AuditParams) ([]domain.AuditEntry, error)
+}
+
+type AuditService struct {
+	repo *repository.Repository
+}
+
+func NewAuditService(repo *repository.Repository) *AuditService {
+	return &AuditService{
+		repo: repo,
+	}
+}
+
+// GetList журнал изменений компании от новых записей к старым
+func (as *AuditService) GetList(ctx context.Context, params domain.AuditParams) ([]domain.AuditEntry, error) {
+	if !params.From.IsZero() && !params.To.IsZero() && !params.From.Before(params.To) {
+		return nil, &domain.ValidationError{Violations: []domain.FieldViolation{
+			{Field: "to", Description: "must be after from"},
+		}}
+	}
+
+	return as.repo.Audit.GetList(ctx, params)
+}
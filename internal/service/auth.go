@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"strconv"
+)
+
+type Auth interface {
+	Authenticate(ctx context.Context, token string) (domain.User, error)
+}
+
+type AuthService struct {
+	cfg  *config.Config
+	repo *repository.Repository
+}
+
+func NewAuthService(cfg *config.Config, repo *repository.Repository) *AuthService {
+	return &AuthService{
+		cfg:  cfg,
+		repo: repo,
+	}
+}
+
+// Authenticate проверяет подпись и срок действия токена и загружает пользователя из sub,
+// компания и секции берутся из базы, а не из токена, чтобы отзыв прав действовал сразу
+func (as *AuthService) Authenticate(ctx context.Context, token string) (domain.User, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+
+	if as.cfg.Auth.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(as.cfg.Auth.Issuer))
+	}
+
+	var claims jwt.RegisteredClaims
+	if _, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return []byte(as.cfg.Auth.SigningKey), nil
+	}, opts...); err != nil {
+		return domain.User{}, fmt.Errorf("%w: %v", domain.ErrUnauthenticated, err)
+	}
+
+	userId, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil || userId <= 0 {
+		return domain.User{}, fmt.Errorf("%w: invalid subject", domain.ErrUnauthenticated)
+	}
+
+	user, err := as.repo.Users.GetById(ctx, userId)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return domain.User{}, fmt.Errorf("%w: user not found", domain.ErrUnauthenticated)
+		}
+
+		return domain.User{}, err
+	}
+
+	if !user.IsActive || !user.IsApproved {
+		return domain.User{}, fmt.Errorf("%w: user is not active", domain.ErrUnauthenticated)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/nats-io/nats.go"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"strconv"
+	"time"
+)
+
+type Events interface {
+	Emit(ctx context.Context, eventType string, companyId, entityId int64, data interface{}) error
+	PublishPending(ctx context.Context) error
+	CleanupOutbox(ctx context.Context) error
+}
+
+type EventService struct {
+	cfg  *config.Config
+	repo *repository.Repository
+	nc   *nats.Conn
+}
+
+func NewEventService(cfg *config.Config, repo *repository.Repository, nc *nats.Conn) *EventService {
+	return &EventService{
+		cfg:  cfg,
+		repo: repo,
+		nc:   nc,
+	}
+}
+
+// Emit сохраняет событие в outbox. Вызывается внутри repo.Tx.WithinTx вместе с изменением,
+// поэтому событие не теряется при недоступном NATS и не публикуется при откате изменения.
+func (es *EventService) Emit(ctx context.Context, eventType string, companyId, entityId int64, data interface{}) error {
+	if !es.cfg.Events.Enabled {
+		return nil
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = es.repo.Outbox.Add(ctx, domain.Event{
+		Type:       eventType,
+		Version:    domain.EventVersion,
+		CompanyID:  companyId,
+		EntityID:   entityId,
+		OccurredAt: time.Now(),
+		Data:       payload,
+	})
+
+	return err
+}
+
+// PublishPending публикует накопленные в outbox события в NATS пачками до опустошения очереди.
+// Доставка не реже одного раза: id события передается в заголовке Nats-Msg-Id для дедупликации.
+func (es *EventService) PublishPending(ctx context.Context) error {
+	if es.nc == nil {
+		return errors.New("nats connection is not set")
+	}
+
+	for ctx.Err() == nil {
+		n, err := es.repo.Outbox.PublishPending(ctx, es.cfg.Events.BatchSize, es.publish)
+		if err != nil {
+			return err
+		}
+
+		if int64(n) < es.cfg.Events.BatchSize {
+			return nil
+		}
+	}
+
+	return ctx.Err()
+}
+
+func (es *EventService) publish(events []domain.Event) error {
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+
+		msg := nats.NewMsg(es.cfg.Events.SubjectPrefix + "." + e.Type)
+		msg.Header.Set(nats.MsgIdHdr, strconv.FormatInt(e.ID, 10))
+		msg.Data = data
+
+		if err = es.nc.PublishMsg(msg); err != nil {
+			return err
+		}
+	}
+
+	// во время переподключения Publish только буферизует сообщения, доставка подтверждается Flush
+	return es.nc.FlushTimeout(es.cfg.Events.PublishTimeout)
+}
+
+// CleanupOutbox удаляет из outbox события старше срока хранения: опубликованные в NATS и,
+// при включенной аналитике, выгруженные в ClickHouse
+func (es *EventService) CleanupOutbox(ctx context.Context) error {
+	n, err := es.repo.Outbox.DeleteProcessed(ctx, time.Now().Add(-es.cfg.OutboxCleanup.Retention), es.cfg.Analytics.Enabled)
+	if n > 0 {
+		logger.Info(fmt.Sprintf("outbox: %d processed events removed", n))
+	}
+
+	return err
+}
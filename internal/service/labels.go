@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/label"
+)
+
+const (
+	qrCodeSize = 512 // сторона PNG с QR-кодом в пикселях
+	maxLabels  = 500 // этикеток в одном документе
+)
+
+type Labels interface {
+	GetQRCode(ctx context.Context, id, companyId int64) ([]byte, string, error)
+	GetLabels(ctx context.Context, ids []int64, companyId int64) ([]byte, error)
+	Scan(ctx context.Context, payload string, companyId int64) (domain.Material, error)
+}
+
+type LabelService struct {
+	repo *repository.Repository
+}
+
+func NewLabelService(repo *repository.Repository) *LabelService {
+	return &LabelService{
+		repo: repo,
+	}
+}
+
+// GetQRCode PNG с QR-кодом купленной партии и закодированный в нем текст
+func (ls *LabelService) GetQRCode(ctx context.Context, id, companyId int64) ([]byte, string, error) {
+	material, err := ls.repo.Materials.GetPurchasedById(ctx, id, companyId)
+	if err != nil {
+		return nil, "", err
+	}
+
+	payload, err := domain.QRInfo{ID: material.ID, ItemID: material.ItemID}.Payload()
+	if err != nil {
+		return nil, "", err
+	}
+
+	png, err := label.QRCode(payload, qrCodeSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return png, payload, nil
+}
+
+// GetLabels PDF с этикетками купленных партий в порядке ids, повторный id печатает еще одну копию
+func (ls *LabelService) GetLabels(ctx context.Context, ids []int64, companyId int64) ([]byte, error) {
+	if len(ids) == 0 || len(ids) > maxLabels {
+		return nil, &domain.ValidationError{Violations: []domain.FieldViolation{
+			{Field: "ids", Description: fmt.Sprintf("must contain from 1 to %d materials", maxLabels)},
+		}}
+	}
+
+	materials := make(map[int64]domain.Material, len(ids))
+	labels := make([]label.Label, 0, len(ids))
+	for _, id := range ids {
+		material, ok := materials[id]
+		if !ok {
+			var err error
+			if material, err = ls.repo.Materials.GetPurchasedById(ctx, id, companyId); err != nil {
+				return nil, fmt.Errorf("material %d: %w", id, err)
+			}
+			materials[id] = material
+		}
+
+		payload, err := domain.QRInfo{ID: material.ID, ItemID: material.ItemID}.Payload()
+		if err != nil {
+			return nil, err
+		}
+
+		labels = append(labels, label.Label{
+			Payload:          payload,
+			Name:             material.Name,
+			Article:          material.Article,
+			WarehouseSection: material.WarehouseSection,
+			Location:         material.Location,
+		})
+	}
+
+	return label.PDF(labels)
+}
+
+// Scan находит купленную партию по тексту отсканированного QR-кода. Код чужой компании,
+// архивной партии или партии, у которой с тех пор сменился товар, не разрешается.
+func (ls *LabelService) Scan(ctx context.Context, payload string, companyId int64) (domain.Material, error) {
+	qr, err := domain.ParseQRInfo(payload)
+	if err != nil {
+		return domain.Material{}, &domain.ValidationError{Violations: []domain.FieldViolation{
+			{Field: "payload", Description: "is not a material qr code"},
+		}}
+	}
+
+	material, err := ls.repo.Materials.GetPurchasedById(ctx, qr.ID, companyId)
+	if err != nil {
+		return domain.Material{}, err
+	}
+
+	if material.ItemID != qr.ItemID {
+		return domain.Material{}, domain.ErrMaterialNotFound
+	}
+
+	return material, nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type StorageLocations interface {
+	Create(ctx context.Context, location domain.StorageLocation) (int64, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.StorageLocation, error)
+	Update(ctx context.Context, location domain.StorageLocation) error
+	Delete(ctx context.Context, id, companyId int64) error
+	GetList(ctx context.Context, params domain.StorageLocationParams) ([]domain.StorageLocation, error)
+}
+
+type StorageLocationService struct {
+	repo *repository.Repository
+}
+
+func NewStorageLocationService(repo *repository.Repository) *StorageLocationService {
+	return &StorageLocationService{
+		repo: repo,
+	}
+}
+
+// Create заводит место хранения: зону на складе или место внутри родителя уровнем выше на том же складе
+func (ls *StorageLocationService) Create(ctx context.Context, location domain.StorageLocation) (int64, error) {
+	if err := validate(location, locationRules); err != nil {
+		return 0, err
+	}
+
+	var id int64
+	if err := ls.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := ls.repo.Warehouse.GetById(ctx, location.WarehouseID, location.CompanyID); err != nil {
+			return err
+		}
+
+		parentKind := domain.LocationParentKind[location.Kind]
+		if parentKind == "" {
+			if location.ParentID != 0 {
+				return fmt.Errorf("%w: %s can't have a parent", domain.ErrInvalidLocation, location.Kind)
+			}
+		} else {
+			parent, err := ls.repo.Locations.GetById(ctx, location.ParentID, location.CompanyID)
+			if err != nil {
+				return err
+			}
+
+			if parent.Kind != parentKind {
+				return fmt.Errorf("%w: %s must be placed in a %s", domain.ErrInvalidLocation, location.Kind, parentKind)
+			}
+
+			if parent.WarehouseID != location.WarehouseID {
+				return fmt.Errorf("%w: parent belongs to another warehouse", domain.ErrInvalidLocation)
+			}
+		}
+
+		var err error
+		id, err = ls.repo.Locations.Create(ctx, location)
+		return err
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (ls *StorageLocationService) GetById(ctx context.Context, id, companyId int64) (domain.StorageLocation, error) {
+	return ls.repo.Locations.GetById(ctx, id, companyId)
+}
+
+// Update меняет код, название и вместимость места. Уменьшение вместимости ниже заполненности
+// не запрещено: размещенные партии остаются на месте, новые в него не принимаются.
+func (ls *StorageLocationService) Update(ctx context.Context, location domain.StorageLocation) error {
+	if err := validate(location, locationRules); err != nil {
+		return err
+	}
+
+	return ls.repo.Locations.Update(ctx, location)
+}
+
+func (ls *StorageLocationService) Delete(ctx context.Context, id, companyId int64) error {
+	return ls.repo.Locations.Delete(ctx, id, companyId)
+}
+
+func (ls *StorageLocationService) GetList(ctx context.Context, params domain.StorageLocationParams) ([]domain.StorageLocation, error) {
+	return ls.repo.Locations.GetList(ctx, params)
+}
@@ -2,17 +2,21 @@ package service
 
 import (
 	"context"
+	"errors"
 	"github.com/rusystem/crm-warehouse/internal/repository"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
 )
 
 type Category interface {
 	Create(ctx context.Context, category domain.MaterialCategory) (int64, error)
-	GetById(ctx context.Context, id, companyId int64) (domain.MaterialCategory, error)
+	GetById(ctx context.Context, id, companyId int64, includeDeleted bool) (domain.MaterialCategory, error)
 	Update(ctx context.Context, category domain.MaterialCategory) error
 	Delete(ctx context.Context, id, companyId int64) error
 	List(ctx context.Context, param domain.Param) ([]domain.MaterialCategory, error)
 	Search(ctx context.Context, param domain.Param) ([]domain.MaterialCategory, error)
+	Restore(ctx context.Context, id, companyId int64) error
+	Move(ctx context.Context, id, companyId, parentId int64) error
+	Reparent(ctx context.Context, fromId, companyId, parentId int64) (int64, error)
 }
 
 type MaterialCategoryService struct {
@@ -25,16 +29,59 @@ func NewMaterialCategoryService(repo *repository.Repository) *MaterialCategorySe
 	}
 }
 
+// Create создает категорию, пустой slug генерируется из названия
 func (mc *MaterialCategoryService) Create(ctx context.Context, category domain.MaterialCategory) (int64, error) {
-	return mc.repo.Category.Create(ctx, category)
+	if err := validate(category, categoryRules); err != nil {
+		return 0, err
+	}
+
+	var id int64
+	if err := mc.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if category.Slug == "" {
+			if category.Slug, err = uniqueSlug(ctx, mc.repo.Category, category.Name, category.CompanyID); err != nil {
+				return err
+			}
+		}
+
+		id, err = mc.repo.Category.Create(ctx, category)
+		return err
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
 }
 
-func (mc *MaterialCategoryService) GetById(ctx context.Context, id, companyId int64) (domain.MaterialCategory, error) {
-	return mc.repo.Category.GetById(ctx, id, companyId)
+// GetById возвращает категорию, удаленную - только с includeDeleted
+func (mc *MaterialCategoryService) GetById(ctx context.Context, id, companyId int64, includeDeleted bool) (domain.MaterialCategory, error) {
+	category, err := mc.repo.Category.GetById(ctx, id, companyId)
+	if includeDeleted && errors.Is(err, domain.ErrCategoryNotFound) {
+		return mc.repo.Category.GetDeletedById(ctx, id, companyId)
+	}
+
+	return category, err
 }
 
+// Update изменяет категорию, пустой slug оставляет прежним
 func (mc *MaterialCategoryService) Update(ctx context.Context, category domain.MaterialCategory) error {
-	return mc.repo.Category.Update(ctx, category)
+	if err := validate(category, categoryRules); err != nil {
+		return err
+	}
+
+	if category.Slug != "" {
+		return mc.repo.Category.Update(ctx, category)
+	}
+
+	return mc.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		current, err := mc.repo.Category.GetById(ctx, category.ID, category.CompanyID)
+		if err != nil {
+			return err
+		}
+
+		category.Slug = current.Slug
+		return mc.repo.Category.Update(ctx, category)
+	})
 }
 
 func (mc *MaterialCategoryService) Delete(ctx context.Context, id, companyId int64) error {
@@ -48,3 +95,29 @@ func (mc *MaterialCategoryService) List(ctx context.Context, param domain.Param)
 func (mc *MaterialCategoryService) Search(ctx context.Context, param domain.Param) ([]domain.MaterialCategory, error) {
 	return mc.repo.Category.Search(ctx, param)
 }
+
+func (mc *MaterialCategoryService) Restore(ctx context.Context, id, companyId int64) error {
+	return mc.repo.Category.Restore(ctx, id, companyId)
+}
+
+// Move переносит категорию вместе с вложенными под parentId, 0 - в корень
+func (mc *MaterialCategoryService) Move(ctx context.Context, id, companyId, parentId int64) error {
+	if parentId < 0 {
+		return &domain.ValidationError{Violations: []domain.FieldViolation{
+			{Field: "parent_id", Description: "must not be negative"},
+		}}
+	}
+
+	return mc.repo.Category.Move(ctx, id, companyId, parentId)
+}
+
+// Reparent переносит вложенные категории fromId под parentId, 0 - в корень, и возвращает их количество
+func (mc *MaterialCategoryService) Reparent(ctx context.Context, fromId, companyId, parentId int64) (int64, error) {
+	if parentId < 0 {
+		return 0, &domain.ValidationError{Violations: []domain.FieldViolation{
+			{Field: "parent_id", Description: "must not be negative"},
+		}}
+	}
+
+	return mc.repo.Category.Reparent(ctx, fromId, companyId, parentId)
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/config"
 	"github.com/rusystem/crm-warehouse/internal/repository"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
 )
@@ -9,110 +11,455 @@ import (
 type Material interface {
 	CreatePlanning(ctx context.Context, material domain.Material) (int64, error)
 	UpdatePlanning(ctx context.Context, material domain.Material) error
-	DeletePlanning(ctx context.Context, id int64) error
-	GetPlanningById(ctx context.Context, id int64) (domain.Material, error)
-	GetPlanningList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error)
-	MovePlanningToPurchased(ctx context.Context, id int64) (int64, int64, error)
+	DeletePlanning(ctx context.Context, id, companyId int64) error
+	GetPlanningById(ctx context.Context, id, companyId int64) (domain.Material, error)
+	GetPlanningList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error)
+	MovePlanningToPurchased(ctx context.Context, id, companyId int64) (int64, int64, error)
 
 	CreatePurchased(ctx context.Context, material domain.Material) (int64, int64, error)
 	UpdatePurchased(ctx context.Context, material domain.Material) error
-	DeletePurchased(ctx context.Context, id int64) error
-	GetPurchasedById(ctx context.Context, id int64) (domain.Material, error)
-	GetPurchasedList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error)
-	MovePurchasedToArchive(ctx context.Context, id int64) error
-
-	GetPlanningArchiveById(ctx context.Context, id int64) (domain.Material, error)
-	GetPurchasedArchiveById(ctx context.Context, id int64) (domain.Material, error)
-	GetPlanningArchiveList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error)
-	GetPurchasedArchiveList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error)
-	DeletePlanningArchive(ctx context.Context, id int64) error
-	DeletePurchasedArchive(ctx context.Context, id int64) error
+	DeletePurchased(ctx context.Context, id, companyId int64) error
+	GetPurchasedById(ctx context.Context, id, companyId int64) (domain.Material, error)
+	GetPurchasedList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error)
+	MovePurchasedToArchive(ctx context.Context, id, companyId int64) error
+	PutAway(ctx context.Context, id, companyId, binId int64) error
+
+	GetPlanningArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error)
+	GetPurchasedArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error)
+	GetPlanningArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error)
+	GetPurchasedArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error)
+	DeletePlanningArchive(ctx context.Context, id, companyId int64) error
+	DeletePurchasedArchive(ctx context.Context, id, companyId int64) error
 
 	Search(ctx context.Context, param domain.Param) ([]domain.Material, error)
+
+	GetExpiring(ctx context.Context, params domain.ExpirationParams) ([]domain.Material, error)
+	QuarantineExpired(ctx context.Context, companyId int64) (int64, error)
+	SuggestFefo(ctx context.Context, companyId, itemId, warehouseId, quantity int64) (domain.FefoSuggestion, error)
 }
 
 type MaterialService struct {
-	repo *repository.Repository
+	cfg    *config.Config
+	repo   *repository.Repository
+	events Events
 }
 
-func NewMaterialService(repo *repository.Repository) *MaterialService {
+func NewMaterialService(cfg *config.Config, repo *repository.Repository, events Events) *MaterialService {
 	return &MaterialService{
-		repo: repo,
+		cfg:    cfg,
+		repo:   repo,
+		events: events,
 	}
 }
 
 func (ms *MaterialService) CreatePlanning(ctx context.Context, material domain.Material) (int64, error) {
-	return ms.repo.Materials.CreatePlanning(ctx, material)
+	if err := validate(material, materialRules); err != nil {
+		return 0, err
+	}
+
+	var id int64
+	if err := ms.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := checkReferences(ctx, ms.repo, &material, domain.Material{}); err != nil {
+			return err
+		}
+
+		var err error
+		if id, err = ms.repo.Materials.CreatePlanning(ctx, material); err != nil {
+			return err
+		}
+
+		material.ID = id
+		return ms.events.Emit(ctx, domain.EventMaterialPlanningCreated, material.CompanyID, id, material)
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
 }
 
 func (ms *MaterialService) UpdatePlanning(ctx context.Context, material domain.Material) error {
-	return ms.repo.Materials.UpdatePlanning(ctx, material)
+	if err := validate(material, materialRules); err != nil {
+		return err
+	}
+
+	return ms.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		previous, err := ms.repo.Materials.GetPlanningById(ctx, material.ID, material.CompanyID)
+		if err != nil {
+			return err
+		}
+
+		if err = checkReferences(ctx, ms.repo, &material, previous); err != nil {
+			return err
+		}
+
+		if err = ms.repo.Materials.UpdatePlanning(ctx, material); err != nil {
+			return err
+		}
+
+		return ms.events.Emit(ctx, domain.EventMaterialPlanningUpdated, material.CompanyID, material.ID, material)
+	})
 }
 
-func (ms *MaterialService) DeletePlanning(ctx context.Context, id int64) error {
-	return ms.repo.Materials.DeletePlanning(ctx, id)
+func (ms *MaterialService) DeletePlanning(ctx context.Context, id, companyId int64) error {
+	return ms.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		material, err := ms.repo.Materials.GetPlanningById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		if err = ms.repo.Materials.DeletePlanning(ctx, id, companyId); err != nil {
+			return err
+		}
+
+		return ms.events.Emit(ctx, domain.EventMaterialPlanningDeleted, companyId, id, material)
+	})
 }
 
-func (ms *MaterialService) GetPlanningById(ctx context.Context, id int64) (domain.Material, error) {
-	return ms.repo.Materials.GetPlanningById(ctx, id)
+func (ms *MaterialService) GetPlanningById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return ms.repo.Materials.GetPlanningById(ctx, id, companyId)
 }
 
-func (ms *MaterialService) GetPlanningList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error) {
+func (ms *MaterialService) GetPlanningList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
+	if err := validate(params, materialParamsRules); err != nil {
+		return domain.MaterialPage{}, err
+	}
+
 	return ms.repo.Materials.GetPlanningList(ctx, params)
 }
 
-func (ms *MaterialService) MovePlanningToPurchased(ctx context.Context, id int64) (int64, int64, error) {
-	return ms.repo.Materials.MovePlanningToPurchased(ctx, id)
+// MovePlanningToPurchased переносит материал в купленные, ссылки материала проверяются заново:
+// закупка у неактивного или удаленного поставщика не проводится
+func (ms *MaterialService) MovePlanningToPurchased(ctx context.Context, id, companyId int64) (int64, int64, error) {
+	var newId, itemId int64
+	if err := ms.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		planning, err := ms.repo.Materials.GetPlanningById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		if err = checkReferences(ctx, ms.repo, &planning, domain.Material{}); err != nil {
+			return err
+		}
+
+		if newId, itemId, err = ms.repo.Materials.MovePlanningToPurchased(ctx, id, companyId); err != nil {
+			return err
+		}
+
+		material, err := ms.repo.Materials.GetPurchasedById(ctx, newId, companyId)
+		if err != nil {
+			return err
+		}
+
+		if err = syncOccupancy(ctx, ms.repo, ms.events, companyId, material.WarehouseID); err != nil {
+			return err
+		}
+
+		return ms.events.Emit(ctx, domain.EventMaterialMovedToPurchased, companyId, newId,
+			domain.MaterialMovedData{FromID: id, Material: material})
+	}); err != nil {
+		return 0, 0, err
+	}
+
+	return newId, itemId, nil
 }
 
 func (ms *MaterialService) CreatePurchased(ctx context.Context, material domain.Material) (int64, int64, error) {
-	return ms.repo.Materials.CreatePurchased(ctx, material)
+	if err := validate(material, materialRules); err != nil {
+		return 0, 0, err
+	}
+
+	var id, itemId int64
+	if err := ms.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := checkReferences(ctx, ms.repo, &material, domain.Material{}); err != nil {
+			return err
+		}
+
+		if err := ms.placeInBin(ctx, &material, domain.Material{}); err != nil {
+			return err
+		}
+
+		var err error
+		if id, itemId, err = ms.repo.Materials.CreatePurchased(ctx, material); err != nil {
+			return err
+		}
+
+		if err = syncOccupancy(ctx, ms.repo, ms.events, material.CompanyID, material.WarehouseID); err != nil {
+			return err
+		}
+
+		material.ID, material.ItemID = id, itemId
+		return ms.events.Emit(ctx, domain.EventMaterialPurchasedCreated, material.CompanyID, id, material)
+	}); err != nil {
+		return 0, 0, err
+	}
+
+	return id, itemId, nil
 }
 
 func (ms *MaterialService) UpdatePurchased(ctx context.Context, material domain.Material) error {
-	return ms.repo.Materials.UpdatePurchased(ctx, material)
+	if err := validate(material, materialRules); err != nil {
+		return err
+	}
+
+	return ms.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		previous, err := ms.repo.Materials.GetPurchasedById(ctx, material.ID, material.CompanyID)
+		if err != nil {
+			return err
+		}
+
+		if err = checkReferences(ctx, ms.repo, &material, previous); err != nil {
+			return err
+		}
+
+		if err = ms.placeInBin(ctx, &material, previous); err != nil {
+			return err
+		}
+
+		if err = ms.repo.Materials.UpdatePurchased(ctx, material); err != nil {
+			return err
+		}
+
+		// склад принимает объем, если партия переехала на него или выросла
+		var received int64
+		if material.WarehouseID != previous.WarehouseID || material.Volume > previous.Volume {
+			received = material.WarehouseID
+		}
+
+		if err = syncOccupancy(ctx, ms.repo, ms.events, material.CompanyID, received,
+			previous.WarehouseID, material.WarehouseID); err != nil {
+			return err
+		}
+
+		return ms.events.Emit(ctx, domain.EventMaterialPurchasedUpdated, material.CompanyID, material.ID, material)
+	})
 }
 
-func (ms *MaterialService) DeletePurchased(ctx context.Context, id int64) error {
-	return ms.repo.Materials.DeletePurchased(ctx, id)
+func (ms *MaterialService) DeletePurchased(ctx context.Context, id, companyId int64) error {
+	return ms.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		material, err := ms.repo.Materials.GetPurchasedById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		if err = ms.repo.Materials.DeletePurchased(ctx, id, companyId); err != nil {
+			return err
+		}
+
+		if err = syncOccupancy(ctx, ms.repo, ms.events, companyId, 0, material.WarehouseID); err != nil {
+			return err
+		}
+
+		return ms.events.Emit(ctx, domain.EventMaterialPurchasedDeleted, companyId, id, material)
+	})
 }
 
-func (ms *MaterialService) GetPurchasedById(ctx context.Context, id int64) (domain.Material, error) {
-	return ms.repo.Materials.GetPurchasedById(ctx, id)
+func (ms *MaterialService) GetPurchasedById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return ms.repo.Materials.GetPurchasedById(ctx, id, companyId)
 }
 
-func (ms *MaterialService) GetPurchasedList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error) {
+func (ms *MaterialService) GetPurchasedList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
+	if err := validate(params, materialParamsRules); err != nil {
+		return domain.MaterialPage{}, err
+	}
+
 	return ms.repo.Materials.GetPurchasedList(ctx, params)
 }
 
-func (ms *MaterialService) MovePurchasedToArchive(ctx context.Context, id int64) error {
-	return ms.repo.Materials.MovePurchasedToArchive(ctx, id)
+func (ms *MaterialService) MovePurchasedToArchive(ctx context.Context, id, companyId int64) error {
+	return ms.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		material, err := ms.repo.Materials.GetPurchasedById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		if err = ms.repo.Materials.MovePurchasedToArchive(ctx, id, companyId); err != nil {
+			return err
+		}
+
+		if err = syncOccupancy(ctx, ms.repo, ms.events, companyId, 0, material.WarehouseID); err != nil {
+			return err
+		}
+
+		return ms.events.Emit(ctx, domain.EventMaterialMovedToArchive, companyId, id,
+			domain.MaterialMovedData{FromID: id, Material: material})
+	})
+}
+
+// PutAway размещает купленную партию в ячейке склада партии
+func (ms *MaterialService) PutAway(ctx context.Context, id, companyId, binId int64) error {
+	return ms.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		material, err := ms.repo.Materials.GetPurchasedById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		previous := material
+		material.BinID = binId
+
+		if err = ms.placeInBin(ctx, &material, previous); err != nil {
+			return err
+		}
+
+		if err = ms.repo.Materials.PutAway(ctx, id, companyId, binId, material.WarehouseSection, material.Location); err != nil {
+			return err
+		}
+
+		return ms.events.Emit(ctx, domain.EventMaterialPurchasedUpdated, companyId, id, material)
+	})
 }
 
-func (ms *MaterialService) GetPlanningArchiveById(ctx context.Context, id int64) (domain.Material, error) {
-	return ms.repo.Materials.GetPlanningArchiveById(ctx, id)
+// placeInBin проверяет ячейку партии и заполняет секцию и место хранения по ее адресу.
+// Вместимость проверяется, только если партия переезжает в ячейку или ее объем растет,
+// иначе правка партии в переполненной ячейке была бы невозможна. Вызывается в транзакции:
+// ячейка и места над ней остаются заблокированными до фиксации.
+func (ms *MaterialService) placeInBin(ctx context.Context, material *domain.Material, previous domain.Material) error {
+	if material.BinID == 0 {
+		return nil
+	}
+
+	target, err := ms.repo.Locations.GetPutAwayTarget(ctx, material.BinID, material.CompanyID, material.ID)
+	if err != nil {
+		return err
+	}
+
+	if target.Bin.Kind != domain.LocationKindBin {
+		return fmt.Errorf("%w: materials can be put away only to a bin", domain.ErrInvalidLocation)
+	}
+
+	if target.Bin.WarehouseID != material.WarehouseID {
+		return fmt.Errorf("%w: bin belongs to another warehouse", domain.ErrInvalidLocation)
+	}
+
+	if material.BinID != previous.BinID || material.Volume > previous.Volume {
+		for _, u := range target.Usage {
+			if u.Occupancy+material.Volume > u.Capacity {
+				return fmt.Errorf("%w: %s %s has %d of %d free", domain.ErrLocationFull,
+					u.Kind, u.Code, max(u.Capacity-u.Occupancy, 0), u.Capacity)
+			}
+		}
+	}
+
+	material.WarehouseSection = target.Zone
+	material.Location = target.Bin.Address
+
+	return nil
 }
 
-func (ms *MaterialService) GetPurchasedArchiveById(ctx context.Context, id int64) (domain.Material, error) {
-	return ms.repo.Materials.GetPurchasedArchiveById(ctx, id)
+func (ms *MaterialService) GetPlanningArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return ms.repo.Materials.GetPlanningArchiveById(ctx, id, companyId)
 }
 
-func (ms *MaterialService) GetPlanningArchiveList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error) {
+func (ms *MaterialService) GetPurchasedArchiveById(ctx context.Context, id, companyId int64) (domain.Material, error) {
+	return ms.repo.Materials.GetPurchasedArchiveById(ctx, id, companyId)
+}
+
+func (ms *MaterialService) GetPlanningArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
+	if err := validate(params, materialParamsRules); err != nil {
+		return domain.MaterialPage{}, err
+	}
+
 	return ms.repo.Materials.GetPlanningArchiveList(ctx, params)
 }
 
-func (ms *MaterialService) GetPurchasedArchiveList(ctx context.Context, params domain.MaterialParams) ([]domain.Material, error) {
+func (ms *MaterialService) GetPurchasedArchiveList(ctx context.Context, params domain.MaterialParams) (domain.MaterialPage, error) {
+	if err := validate(params, materialParamsRules); err != nil {
+		return domain.MaterialPage{}, err
+	}
+
 	return ms.repo.Materials.GetPurchasedArchiveList(ctx, params)
 }
 
-func (ms *MaterialService) DeletePlanningArchive(ctx context.Context, id int64) error {
-	return ms.repo.Materials.DeletePlanningArchive(ctx, id)
+func (ms *MaterialService) DeletePlanningArchive(ctx context.Context, id, companyId int64) error {
+	return ms.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		material, err := ms.repo.Materials.GetPlanningArchiveById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		if err = ms.repo.Materials.DeletePlanningArchive(ctx, id, companyId); err != nil {
+			return err
+		}
+
+		return ms.events.Emit(ctx, domain.EventMaterialPlanningArchiveDeleted, companyId, id, material)
+	})
 }
 
-func (ms *MaterialService) DeletePurchasedArchive(ctx context.Context, id int64) error {
-	return ms.repo.Materials.DeletePurchasedArchive(ctx, id)
+func (ms *MaterialService) DeletePurchasedArchive(ctx context.Context, id, companyId int64) error {
+	return ms.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		material, err := ms.repo.Materials.GetPurchasedArchiveById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		if err = ms.repo.Materials.DeletePurchasedArchive(ctx, id, companyId); err != nil {
+			return err
+		}
+
+		return ms.events.Emit(ctx, domain.EventMaterialPurchasedArchiveDeleted, companyId, id, material)
+	})
 }
 
 func (ms *MaterialService) Search(ctx context.Context, param domain.Param) ([]domain.Material, error) {
 	return ms.repo.Materials.Search(ctx, param)
 }
+
+// GetExpiring партии, срок годности которых истекает в пределах окна, без окна используется окно из конфига
+func (ms *MaterialService) GetExpiring(ctx context.Context, params domain.ExpirationParams) ([]domain.Material, error) {
+	if params.Within < 0 {
+		return nil, &domain.ValidationError{Violations: []domain.FieldViolation{
+			{Field: "within_days", Description: "must not be negative"},
+		}}
+	}
+
+	if params.Within == 0 {
+		params.Within = ms.cfg.Expiration.Window
+	}
+
+	return ms.repo.Materials.GetExpiring(ctx, params)
+}
+
+func (ms *MaterialService) QuarantineExpired(ctx context.Context, companyId int64) (int64, error) {
+	return ms.repo.Materials.QuarantineExpired(ctx, companyId)
+}
+
+// SuggestFefo распределяет количество по партиям товара в порядке FEFO.
+// Если доступного остатка не хватает, недостающее количество возвращается в Shortage.
+func (ms *MaterialService) SuggestFefo(ctx context.Context, companyId, itemId, warehouseId, quantity int64) (domain.FefoSuggestion, error) {
+	var violations []domain.FieldViolation
+	if itemId <= 0 {
+		violations = append(violations, domain.FieldViolation{Field: "item_id", Description: "must be positive"})
+	}
+	if quantity <= 0 {
+		violations = append(violations, domain.FieldViolation{Field: "quantity", Description: "must be positive"})
+	}
+	if len(violations) > 0 {
+		return domain.FefoSuggestion{}, &domain.ValidationError{Violations: violations}
+	}
+
+	lots, err := ms.repo.Materials.GetFefoLots(ctx, companyId, itemId, warehouseId)
+	if err != nil {
+		return domain.FefoSuggestion{}, err
+	}
+
+	suggestion := domain.FefoSuggestion{ItemID: itemId, Requested: quantity}
+
+	rest := quantity
+	for _, lot := range lots {
+		if rest == 0 {
+			break
+		}
+
+		if lot.Available <= 0 {
+			continue
+		}
+
+		lot.Quantity = min(lot.Available, rest)
+		rest -= lot.Quantity
+
+		suggestion.Picks = append(suggestion.Picks, lot)
+	}
+
+	suggestion.Shortage = rest
+
+	return suggestion, nil
+}
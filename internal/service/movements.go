@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Movement interface {
+	Create(ctx context.Context, req domain.MovementRequest) ([]domain.Movement, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.Movement, error)
+	GetList(ctx context.Context, params domain.MovementParams) ([]domain.Movement, error)
+	GetStockOnHand(ctx context.Context, params domain.StockParams) (int64, error)
+}
+
+type MovementService struct {
+	repo   *repository.Repository
+	events Events
+}
+
+func NewMovementService(repo *repository.Repository, events Events) *MovementService {
+	return &MovementService{
+		repo:   repo,
+		events: events,
+	}
+}
+
+func (ms *MovementService) Create(ctx context.Context, req domain.MovementRequest) ([]domain.Movement, error) {
+	switch req.Type {
+	case domain.MovementTypeReceipt, domain.MovementTypeIssue, domain.MovementTypeWriteOff, domain.MovementTypeTransfer:
+		if req.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: quantity must be positive", domain.ErrInvalidMovement)
+		}
+	case domain.MovementTypeAdjustment:
+		if req.Quantity == 0 {
+			return nil, fmt.Errorf("%w: quantity can`t be zero", domain.ErrInvalidMovement)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown type %q", domain.ErrInvalidMovement, req.Type)
+	}
+
+	var movements []domain.Movement
+	if err := ms.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if movements, err = ms.repo.Movements.Create(ctx, req); err != nil {
+			return err
+		}
+
+		if err = emitStockChanged(ctx, ms.repo, ms.events, req.CompanyID, movements[0].MaterialID); err != nil {
+			return err
+		}
+
+		// объем меняет только перемещение: первое движение - расход со склада партии, второе - приход на склад назначения
+		if req.Type != domain.MovementTypeTransfer {
+			return nil
+		}
+
+		if err = syncOccupancy(ctx, ms.repo, ms.events, req.CompanyID, movements[1].WarehouseID,
+			movements[0].WarehouseID); err != nil {
+			return err
+		}
+
+		// партия перенесена целиком - ее снимок уже опубликован, иначе на складе назначения выделена новая партия
+		if movements[1].MaterialID == movements[0].MaterialID {
+			return nil
+		}
+
+		material, err := ms.repo.Materials.GetPurchasedById(ctx, movements[1].MaterialID, req.CompanyID)
+		if err != nil {
+			return err
+		}
+
+		return ms.events.Emit(ctx, domain.EventMaterialPurchasedCreated, req.CompanyID, material.ID, material)
+	}); err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
+
+func (ms *MovementService) GetById(ctx context.Context, id, companyId int64) (domain.Movement, error) {
+	return ms.repo.Movements.GetById(ctx, id, companyId)
+}
+
+func (ms *MovementService) GetList(ctx context.Context, params domain.MovementParams) ([]domain.Movement, error) {
+	return ms.repo.Movements.GetList(ctx, params)
+}
+
+func (ms *MovementService) GetStockOnHand(ctx context.Context, params domain.StockParams) (int64, error) {
+	return ms.repo.Movements.GetStockOnHand(ctx, params)
+}
+
+// emitStockChanged публикует material.purchased.updated со снимками партий ids, остаток которых изменила
+// проводка. Вызывается в WithinTx после проводки, чтобы событие попало в outbox вместе с ней.
+func emitStockChanged(ctx context.Context, repo *repository.Repository, events Events, companyId int64, ids ...int64) error {
+	for _, id := range ids {
+		material, err := repo.Materials.GetPurchasedById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		if err = events.Emit(ctx, domain.EventMaterialPurchasedUpdated, companyId, id, material); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type PurchaseRequests interface {
+	Import(ctx context.Context, consumer, messageId string, request domain.PurchaseRequest) (int64, bool, error)
+}
+
+type PurchaseRequestService struct {
+	repo     *repository.Repository
+	material Material
+}
+
+func NewPurchaseRequestService(repo *repository.Repository, material Material) *PurchaseRequestService {
+	return &PurchaseRequestService{
+		repo:     repo,
+		material: material,
+	}
+}
+
+// Import создает планируемый материал по заявке на закупку. Сообщение отмечается обработанным в той же
+// транзакции, поэтому повторная доставка возвращает false и не создает дубль.
+func (ps *PurchaseRequestService) Import(ctx context.Context, consumer, messageId string, request domain.PurchaseRequest) (int64, bool, error) {
+	var id int64
+	var fresh bool
+
+	if err := ps.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if fresh, err = ps.repo.Inbox.Claim(ctx, consumer, messageId); err != nil || !fresh {
+			return err
+		}
+
+		id, err = ps.material.CreatePlanning(ctx, request.Material())
+		return err
+	}); err != nil {
+		return 0, false, err
+	}
+
+	return id, fresh, nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"time"
+)
+
+type Purge interface {
+	PurgeDeleted(ctx context.Context) error
+}
+
+type PurgeService struct {
+	cfg  *config.Config
+	repo *repository.Repository
+}
+
+func NewPurgeService(cfg *config.Config, repo *repository.Repository) *PurgeService {
+	return &PurgeService{
+		cfg:  cfg,
+		repo: repo,
+	}
+}
+
+// PurgeDeleted окончательно удаляет поставщиков, склады и категории, удаленные дольше срока хранения.
+// Записи, на которые еще ссылаются материалы или складские операции, остаются до следующего запуска.
+func (ps *PurgeService) PurgeDeleted(ctx context.Context) error {
+	deletedBefore := time.Now().Add(-ps.cfg.Purge.Retention)
+
+	purges := []struct {
+		name  string
+		purge func(ctx context.Context, deletedBefore time.Time) (int64, error)
+	}{
+		{"suppliers", ps.repo.Suppliers.Purge},
+		{"warehouses", ps.repo.Warehouse.Purge},
+		{"material categories", ps.repo.Category.Purge},
+	}
+
+	for _, p := range purges {
+		n, err := p.purge(ctx, deletedBefore)
+		if err != nil {
+			return err
+		}
+
+		if n > 0 {
+			logger.Info(fmt.Sprintf("purge: %d deleted %s removed", n, p.name))
+		}
+	}
+
+	return nil
+}
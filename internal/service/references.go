@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+// checkReferences проверяет, что поставщик, склад и категория материала существуют, не удалены, активны
+// и принадлежат компании материала. Пустая ссылка допустима. Ссылки, не изменившиеся относительно previous,
+// не проверяются, иначе партию поставщика, ставшего неактивным, нельзя было бы поправить.
+// Категория задается category_id или, для старых клиентов, названием: checkReferences заполняет
+// в material второе поле по первому.
+func checkReferences(ctx context.Context, repo *repository.Repository, material *domain.Material, previous domain.Material) error {
+	return references{
+		suppliers:  repo.Suppliers,
+		warehouses: repo.Warehouse,
+		categories: repo.Category,
+	}.check(ctx, material, previous)
+}
+
+// references - справочники, на которые ссылается материал
+type references struct {
+	suppliers interface {
+		GetById(ctx context.Context, id, companyId int64) (domain.Supplier, error)
+	}
+	warehouses interface {
+		GetById(ctx context.Context, id, companyId int64) (domain.Warehouse, error)
+	}
+	categories interface {
+		GetById(ctx context.Context, id, companyId int64) (domain.MaterialCategory, error)
+		GetByName(ctx context.Context, name string, companyId int64) (domain.MaterialCategory, error)
+	}
+}
+
+func (r references) check(ctx context.Context, material *domain.Material, previous domain.Material) error {
+	var violations []domain.FieldViolation
+
+	if id := material.SupplierID; id != 0 && id != previous.SupplierID {
+		supplier, err := r.suppliers.GetById(ctx, id, material.CompanyID)
+		switch {
+		case errors.Is(err, domain.ErrSupplierNotFound):
+			violations = append(violations, domain.FieldViolation{Field: "supplier_id", Description: "supplier not found"})
+		case err != nil:
+			return err
+		case !supplier.IsActive:
+			violations = append(violations, domain.FieldViolation{Field: "supplier_id", Description: "supplier is not active"})
+		}
+	}
+
+	if id := material.WarehouseID; id != 0 && id != previous.WarehouseID {
+		_, err := r.warehouses.GetById(ctx, id, material.CompanyID)
+		switch {
+		case errors.Is(err, domain.ErrWarehouseNotFound):
+			violations = append(violations, domain.FieldViolation{Field: "warehouse_id", Description: "warehouse not found"})
+		case err != nil:
+			return err
+		}
+	}
+
+	switch {
+	case material.CategoryID != 0 && material.CategoryID == previous.CategoryID:
+		material.ProductCategory = previous.ProductCategory
+	case material.CategoryID != 0:
+		category, err := r.categories.GetById(ctx, material.CategoryID, material.CompanyID)
+		switch {
+		case errors.Is(err, domain.ErrCategoryNotFound):
+			violations = append(violations, domain.FieldViolation{Field: "category_id", Description: "category not found"})
+		case err != nil:
+			return err
+		case !category.IsActive:
+			violations = append(violations, domain.FieldViolation{Field: "category_id", Description: "category is not active"})
+		default:
+			material.ProductCategory = category.Name
+		}
+	case material.ProductCategory != "" && material.ProductCategory == previous.ProductCategory:
+		material.CategoryID = previous.CategoryID
+	case material.ProductCategory != "":
+		category, err := r.categories.GetByName(ctx, material.ProductCategory, material.CompanyID)
+		switch {
+		case errors.Is(err, domain.ErrCategoryNotFound):
+			violations = append(violations, domain.FieldViolation{Field: "product_category", Description: "category not found"})
+		case err != nil:
+			return err
+		case !category.IsActive:
+			violations = append(violations, domain.FieldViolation{Field: "product_category", Description: "category is not active"})
+		default:
+			material.CategoryID = category.ID
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &domain.ValidationError{Violations: violations}
+}
+
+// releaseReferences применяет политику удаления к материалам, ссылающимся на поставщика или склад:
+// block - удаление отклоняется, cascade - материалы в планировании и купленные удаляются в той же транзакции
+// с событиями удаления. Вызывается в WithinTx до удаления самой сущности.
+func releaseReferences(ctx context.Context, cfg *config.Config, repo *repository.Repository, material Material,
+	ref domain.MaterialReference) error {
+	refs, err := repo.Materials.GetReferencing(ctx, ref)
+	if err != nil || refs.Empty() {
+		return err
+	}
+
+	if cfg.References.DeletePolicy != config.DeletePolicyCascade {
+		return fmt.Errorf("%w: used by %d planning and %d purchased materials", domain.ErrReferenced,
+			len(refs.Planning), len(refs.Purchased))
+	}
+
+	for _, id := range refs.Planning {
+		if err = material.DeletePlanning(ctx, id, ref.CompanyID); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range refs.Purchased {
+		if err = material.DeletePurchased(ctx, id, ref.CompanyID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
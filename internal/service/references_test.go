@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"reflect"
+	"testing"
+)
+
+var errStorage = errors.New("connection refused")
+
+type fakeSuppliers map[int64]domain.Supplier
+
+func (fs fakeSuppliers) GetById(_ context.Context, id, companyId int64) (domain.Supplier, error) {
+	if id == 500 {
+		return domain.Supplier{}, errStorage
+	}
+
+	supplier, ok := fs[id]
+	if !ok || supplier.CompanyID != companyId {
+		return domain.Supplier{}, domain.ErrSupplierNotFound
+	}
+
+	return supplier, nil
+}
+
+type fakeWarehouses map[int64]domain.Warehouse
+
+func (fw fakeWarehouses) GetById(_ context.Context, id, companyId int64) (domain.Warehouse, error) {
+	warehouse, ok := fw[id]
+	if !ok || warehouse.CompanyID != companyId {
+		return domain.Warehouse{}, domain.ErrWarehouseNotFound
+	}
+
+	return warehouse, nil
+}
+
+type fakeCategories map[int64]domain.MaterialCategory
+
+func (fc fakeCategories) GetById(_ context.Context, id, companyId int64) (domain.MaterialCategory, error) {
+	category, ok := fc[id]
+	if !ok || category.CompanyID != companyId {
+		return domain.MaterialCategory{}, domain.ErrCategoryNotFound
+	}
+
+	return category, nil
+}
+
+func (fc fakeCategories) GetByName(_ context.Context, name string, companyId int64) (domain.MaterialCategory, error) {
+	for _, category := range fc {
+		if category.Name == name && category.CompanyID == companyId {
+			return category, nil
+		}
+	}
+
+	return domain.MaterialCategory{}, domain.ErrCategoryNotFound
+}
+
+func TestCheckReferences(t *testing.T) {
+	refs := references{
+		suppliers: fakeSuppliers{
+			1: {ID: 1, CompanyID: 1, IsActive: true},
+			2: {ID: 2, CompanyID: 1},
+			3: {ID: 3, CompanyID: 2, IsActive: true},
+		},
+		warehouses: fakeWarehouses{
+			1: {ID: 1, CompanyID: 1},
+			3: {ID: 3, CompanyID: 2},
+		},
+		categories: fakeCategories{
+			1: {ID: 1, CompanyID: 1, Name: "Крепеж", IsActive: true},
+			2: {ID: 2, CompanyID: 1, Name: "Архив"},
+			3: {ID: 3, CompanyID: 2, Name: "Чужая", IsActive: true},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		material     domain.Material
+		previous     domain.Material
+		want         []domain.FieldViolation
+		wantErr      error
+		wantCategory int64
+		wantName     string
+	}{
+		{
+			name:     "empty references",
+			material: domain.Material{CompanyID: 1},
+		},
+		{
+			name:         "valid references",
+			material:     domain.Material{CompanyID: 1, SupplierID: 1, WarehouseID: 1, CategoryID: 1},
+			wantCategory: 1,
+			wantName:     "Крепеж",
+		},
+		{
+			name:     "supplier not found",
+			material: domain.Material{CompanyID: 1, SupplierID: 9},
+			want:     []domain.FieldViolation{{Field: "supplier_id", Description: "supplier not found"}},
+		},
+		{
+			name:     "supplier of other company",
+			material: domain.Material{CompanyID: 1, SupplierID: 3},
+			want:     []domain.FieldViolation{{Field: "supplier_id", Description: "supplier not found"}},
+		},
+		{
+			name:     "supplier not active",
+			material: domain.Material{CompanyID: 1, SupplierID: 2},
+			want:     []domain.FieldViolation{{Field: "supplier_id", Description: "supplier is not active"}},
+		},
+		{
+			name:     "unchanged inactive supplier",
+			material: domain.Material{CompanyID: 1, SupplierID: 2},
+			previous: domain.Material{CompanyID: 1, SupplierID: 2},
+		},
+		{
+			name:     "warehouse of other company",
+			material: domain.Material{CompanyID: 1, WarehouseID: 3},
+			want:     []domain.FieldViolation{{Field: "warehouse_id", Description: "warehouse not found"}},
+		},
+		{
+			name:         "category not found",
+			material:     domain.Material{CompanyID: 1, CategoryID: 9},
+			want:         []domain.FieldViolation{{Field: "category_id", Description: "category not found"}},
+			wantCategory: 9,
+		},
+		{
+			name:         "category not active",
+			material:     domain.Material{CompanyID: 1, CategoryID: 2},
+			want:         []domain.FieldViolation{{Field: "category_id", Description: "category is not active"}},
+			wantCategory: 2,
+		},
+		{
+			name:         "unchanged category keeps name",
+			material:     domain.Material{CompanyID: 1, CategoryID: 2},
+			previous:     domain.Material{CompanyID: 1, CategoryID: 2, ProductCategory: "Архив"},
+			wantCategory: 2,
+			wantName:     "Архив",
+		},
+		{
+			name:         "category by name",
+			material:     domain.Material{CompanyID: 1, ProductCategory: "Крепеж"},
+			wantCategory: 1,
+			wantName:     "Крепеж",
+		},
+		{
+			name:     "category name of other company",
+			material: domain.Material{CompanyID: 1, ProductCategory: "Чужая"},
+			want:     []domain.FieldViolation{{Field: "product_category", Description: "category not found"}},
+			wantName: "Чужая",
+		},
+		{
+			name:     "category name not active",
+			material: domain.Material{CompanyID: 1, ProductCategory: "Архив"},
+			want:     []domain.FieldViolation{{Field: "product_category", Description: "category is not active"}},
+			wantName: "Архив",
+		},
+		{
+			name:         "unchanged category name keeps id",
+			material:     domain.Material{CompanyID: 1, ProductCategory: "Архив"},
+			previous:     domain.Material{CompanyID: 1, CategoryID: 2, ProductCategory: "Архив"},
+			wantCategory: 2,
+			wantName:     "Архив",
+		},
+		{
+			name:     "all violations together",
+			material: domain.Material{CompanyID: 1, SupplierID: 2, WarehouseID: 9, CategoryID: 9},
+			want: []domain.FieldViolation{
+				{Field: "supplier_id", Description: "supplier is not active"},
+				{Field: "warehouse_id", Description: "warehouse not found"},
+				{Field: "category_id", Description: "category not found"},
+			},
+			wantCategory: 9,
+		},
+		{
+			name:     "storage error",
+			material: domain.Material{CompanyID: 1, SupplierID: 500},
+			wantErr:  errStorage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			material := tt.material
+			err := refs.check(context.Background(), &material, tt.previous)
+
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("check() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			case tt.want == nil:
+				if err != nil {
+					t.Fatalf("check() error = %v", err)
+				}
+			default:
+				var verr *domain.ValidationError
+				if !errors.As(err, &verr) || !errors.Is(err, domain.ErrInvalidArgument) {
+					t.Fatalf("check() error = %v, want validation error", err)
+				}
+
+				if !reflect.DeepEqual(verr.Violations, tt.want) {
+					t.Errorf("check() violations = %v, want %v", verr.Violations, tt.want)
+				}
+			}
+
+			if material.CategoryID != tt.wantCategory || material.ProductCategory != tt.wantName {
+				t.Errorf("category = %d %q, want %d %q", material.CategoryID, material.ProductCategory,
+					tt.wantCategory, tt.wantName)
+			}
+		})
+	}
+}
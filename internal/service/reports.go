@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"time"
+)
+
+type Reports interface {
+	Export(ctx context.Context) error
+
+	GetStockOnDate(ctx context.Context, params domain.StockOnDateParams) ([]domain.StockBalance, error)
+	GetCategoryTurnover(ctx context.Context, params domain.PeriodParams) ([]domain.CategoryTurnover, error)
+	GetSupplierSpend(ctx context.Context, params domain.PeriodParams) ([]domain.SupplierSpend, error)
+}
+
+type ReportService struct {
+	cfg  *config.Config
+	repo *repository.Repository
+}
+
+func NewReportService(cfg *config.Config, repo *repository.Repository) *ReportService {
+	return &ReportService{
+		cfg:  cfg,
+		repo: repo,
+	}
+}
+
+// Export выгружает в ClickHouse события материалов из outbox и журнал движений пачками до опустошения очередей.
+// Выгрузка не реже одного раза, повторы схлопываются в ClickHouse по id события и движения.
+func (rs *ReportService) Export(ctx context.Context) error {
+	if rs.repo.Analytics == nil {
+		return domain.ErrAnalyticsDisabled
+	}
+
+	for ctx.Err() == nil {
+		n, err := rs.repo.Analytics.ExportMaterialEvents(ctx, rs.cfg.Analytics.BatchSize, func(events []domain.Event) error {
+			facts := make([]domain.MaterialFact, 0, len(events))
+			for _, e := range events {
+				f, err := materialFact(e)
+				if err != nil {
+					return err
+				}
+				facts = append(facts, f)
+			}
+
+			return rs.repo.Analytics.InsertMaterialFacts(ctx, facts)
+		})
+		if err != nil {
+			return fmt.Errorf("material events: %w", err)
+		}
+
+		if int64(n) < rs.cfg.Analytics.BatchSize {
+			break
+		}
+	}
+
+	for ctx.Err() == nil {
+		n, err := rs.repo.Analytics.ExportMovements(ctx, rs.cfg.Analytics.BatchSize, func(movements []domain.MovementFact) error {
+			return rs.repo.Analytics.InsertMovementFacts(ctx, movements)
+		})
+		if err != nil {
+			return fmt.Errorf("stock movements: %w", err)
+		}
+
+		if int64(n) < rs.cfg.Analytics.BatchSize {
+			return nil
+		}
+	}
+
+	return ctx.Err()
+}
+
+// materialFact достает снимок материала из события: события переноса хранят его в MaterialMovedData
+func materialFact(e domain.Event) (domain.MaterialFact, error) {
+	f := domain.MaterialFact{
+		EventID:    e.ID,
+		EventType:  e.Type,
+		Payload:    e.Data,
+		OccurredAt: e.OccurredAt,
+	}
+
+	var err error
+	switch e.Type {
+	case domain.EventMaterialMovedToPurchased, domain.EventMaterialMovedToArchive:
+		var moved domain.MaterialMovedData
+		err = json.Unmarshal(e.Data, &moved)
+		f.Material = moved.Material
+	default:
+		err = json.Unmarshal(e.Data, &f.Material)
+	}
+	if err != nil {
+		return domain.MaterialFact{}, fmt.Errorf("event %d: %w", e.ID, err)
+	}
+
+	f.Material.ID = e.EntityID
+	f.Material.CompanyID = e.CompanyID
+
+	return f, nil
+}
+
+// GetStockOnDate остатки на конец дня Date
+func (rs *ReportService) GetStockOnDate(ctx context.Context, params domain.StockOnDateParams) ([]domain.StockBalance, error) {
+	if rs.repo.Analytics == nil {
+		return nil, domain.ErrAnalyticsDisabled
+	}
+
+	if params.Date.IsZero() {
+		return nil, &domain.ValidationError{Violations: []domain.FieldViolation{
+			{Field: "date", Description: "must be set"},
+		}}
+	}
+
+	y, m, d := params.Date.Date()
+	params.Date = time.Date(y, m, d, 0, 0, 0, 0, params.Date.Location()).AddDate(0, 0, 1)
+
+	return rs.repo.Analytics.GetStockOnDate(ctx, params)
+}
+
+func (rs *ReportService) GetCategoryTurnover(ctx context.Context, params domain.PeriodParams) ([]domain.CategoryTurnover, error) {
+	if rs.repo.Analytics == nil {
+		return nil, domain.ErrAnalyticsDisabled
+	}
+
+	if err := validatePeriod(params); err != nil {
+		return nil, err
+	}
+
+	return rs.repo.Analytics.GetCategoryTurnover(ctx, params)
+}
+
+func (rs *ReportService) GetSupplierSpend(ctx context.Context, params domain.PeriodParams) ([]domain.SupplierSpend, error) {
+	if rs.repo.Analytics == nil {
+		return nil, domain.ErrAnalyticsDisabled
+	}
+
+	if err := validatePeriod(params); err != nil {
+		return nil, err
+	}
+
+	return rs.repo.Analytics.GetSupplierSpend(ctx, params)
+}
+
+func validatePeriod(params domain.PeriodParams) error {
+	var violations []domain.FieldViolation
+	if params.From.IsZero() {
+		violations = append(violations, domain.FieldViolation{Field: "from", Description: "must be set"})
+	}
+	if params.To.IsZero() {
+		violations = append(violations, domain.FieldViolation{Field: "to", Description: "must be set"})
+	}
+	if len(violations) == 0 && !params.From.Before(params.To) {
+		violations = append(violations, domain.FieldViolation{Field: "to", Description: "must be after from"})
+	}
+	if len(violations) > 0 {
+		return &domain.ValidationError{Violations: violations}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"time"
+)
+
+type Reservation interface {
+	Reserve(ctx context.Context, reservation domain.Reservation) (int64, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.Reservation, error)
+	GetList(ctx context.Context, params domain.ReservationParams) ([]domain.Reservation, error)
+	Release(ctx context.Context, id, companyId int64) error
+	Consume(ctx context.Context, id, companyId, quantity, userId int64) (domain.Movement, error)
+	GetAvailability(ctx context.Context, materialId, companyId int64) (domain.Availability, error)
+}
+
+type ReservationService struct {
+	repo   *repository.Repository
+	events Events
+}
+
+func NewReservationService(repo *repository.Repository, events Events) *ReservationService {
+	return &ReservationService{
+		repo:   repo,
+		events: events,
+	}
+}
+
+func (rs *ReservationService) Reserve(ctx context.Context, reservation domain.Reservation) (int64, error) {
+	if reservation.MaterialID <= 0 {
+		return 0, fmt.Errorf("%w: material id must be positive", domain.ErrInvalidReservation)
+	}
+
+	if reservation.Quantity <= 0 {
+		return 0, fmt.Errorf("%w: quantity must be positive", domain.ErrInvalidReservation)
+	}
+
+	if !reservation.ExpiresAt.IsZero() && !reservation.ExpiresAt.After(time.Now()) {
+		return 0, fmt.Errorf("%w: expiration must be in the future", domain.ErrInvalidReservation)
+	}
+
+	var id int64
+	if err := rs.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		id, err = rs.repo.Reservations.Create(ctx, reservation)
+		return err
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (rs *ReservationService) GetById(ctx context.Context, id, companyId int64) (domain.Reservation, error) {
+	return rs.repo.Reservations.GetById(ctx, id, companyId)
+}
+
+func (rs *ReservationService) GetList(ctx context.Context, params domain.ReservationParams) ([]domain.Reservation, error) {
+	return rs.repo.Reservations.GetList(ctx, params)
+}
+
+func (rs *ReservationService) Release(ctx context.Context, id, companyId int64) error {
+	return rs.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		return rs.repo.Reservations.Release(ctx, id, companyId)
+	})
+}
+
+func (rs *ReservationService) Consume(ctx context.Context, id, companyId, quantity, userId int64) (domain.Movement, error) {
+	if quantity <= 0 {
+		return domain.Movement{}, fmt.Errorf("%w: quantity must be positive", domain.ErrInvalidReservation)
+	}
+
+	var movement domain.Movement
+	if err := rs.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if movement, err = rs.repo.Reservations.Consume(ctx, id, companyId, quantity, userId); err != nil {
+			return err
+		}
+
+		// отпуск по резерву уменьшает остаток партии так же, как отпуск через журнал движений
+		return emitStockChanged(ctx, rs.repo, rs.events, companyId, movement.MaterialID)
+	}); err != nil {
+		return domain.Movement{}, err
+	}
+
+	return movement, nil
+}
+
+func (rs *ReservationService) GetAvailability(ctx context.Context, materialId, companyId int64) (domain.Availability, error) {
+	return rs.repo.Reservations.GetAvailability(ctx, materialId, companyId)
+}
@@ -2,21 +2,51 @@ package service
 
 import (
 	"github.com/nats-io/nats.go"
+	"github.com/rusystem/crm-warehouse/internal/config"
 	"github.com/rusystem/crm-warehouse/internal/repository"
 )
 
 type Service struct {
-	Supplier  Supplier
-	Warehouse Warehouse
-	Material  Material
-	Category  Category
+	Supplier         Supplier
+	Warehouse        Warehouse
+	Material         Material
+	Category         Category
+	Movement         Movement
+	Transfer         Transfer
+	Reservation      Reservation
+	Auth             Auth
+	Alerts           Alerts
+	Events           Events
+	PurchaseRequests PurchaseRequests
+	Reports          Reports
+	Labels           Labels
+	Locations        StorageLocations
+	Stocktake        Stocktake
+	Audit            Audit
+	Purge            Purge
 }
 
-func New(repo *repository.Repository, nc *nats.Conn) *Service {
+func New(cfg *config.Config, repo *repository.Repository, nc *nats.Conn, tg TelegramSender) *Service {
+	events := NewEventService(cfg, repo, nc)
+	material := NewMaterialService(cfg, repo, events)
+
 	return &Service{
-		Supplier:  NewSupplierService(repo),
-		Warehouse: NewWarehouseService(repo),
-		Material:  NewMaterialService(repo),
-		Category:  NewMaterialCategoryService(repo),
+		Supplier:         NewSupplierService(cfg, repo, events, material),
+		Warehouse:        NewWarehouseService(cfg, repo, events, material),
+		Material:         material,
+		Category:         NewMaterialCategoryService(repo),
+		Movement:         NewMovementService(repo, events),
+		Transfer:         NewTransferService(repo, events),
+		Reservation:      NewReservationService(repo, events),
+		Auth:             NewAuthService(cfg, repo),
+		Alerts:           NewAlertService(cfg, repo, nc, tg),
+		Events:           events,
+		PurchaseRequests: NewPurchaseRequestService(repo, material),
+		Reports:          NewReportService(cfg, repo),
+		Labels:           NewLabelService(repo),
+		Locations:        NewStorageLocationService(repo),
+		Stocktake:        NewStocktakeService(repo),
+		Audit:            NewAuditService(repo),
+		Purge:            NewPurgeService(cfg, repo),
 	}
 }
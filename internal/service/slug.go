@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// slugMaxLen - длина slug без суффикса уникальности, чтобы slug с суффиксом помещался в VARCHAR(255)
+const slugMaxLen = 240
+
+// slugChecker проверяет, занят ли slug категорией компании
+type slugChecker interface {
+	SlugExists(ctx context.Context, slug string, companyId int64) (bool, error)
+}
+
+var translit = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z", 'и': "i",
+	'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+	'у': "u", 'ф': "f", 'х': "h", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "sch", 'ъ': "", 'ы': "y", 'ь': "",
+	'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+// slugify переводит название в slug: кириллица транслитерируется, остальные символы кроме латиницы
+// и цифр заменяются дефисом
+func slugify(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z' || r >= '0' && r <= '9':
+			b.WriteRune(r)
+			dash = false
+		case translit[r] != "":
+			b.WriteString(translit[r])
+			dash = false
+		case r == 'ъ' || r == 'ь':
+		default:
+			if !dash && b.Len() > 0 {
+				b.WriteByte('-')
+				dash = true
+			}
+		}
+	}
+
+	// slug состоит только из ASCII, поэтому обрезается по байтам
+	slug := b.String()
+	if len(slug) > slugMaxLen {
+		slug = slug[:slugMaxLen]
+	}
+
+	return strings.Trim(slug, "-")
+}
+
+// validSlug - только строчная латиница, цифры и дефисы
+func validSlug(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// uniqueSlug генерирует slug из названия, уникальный среди категорий компании: при совпадении
+// добавляется суффикс -2, -3 и т.д. Гонку двух созданий закрывает уникальный индекс.
+func uniqueSlug(ctx context.Context, categories slugChecker, name string, companyId int64) (string, error) {
+	base := slugify(name)
+	if base == "" {
+		base = "category"
+	}
+
+	slug := base
+	for i := 2; ; i++ {
+		exists, err := categories.SlugExists(ctx, slug, companyId)
+		if err != nil || !exists {
+			return slug, err
+		}
+
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+}
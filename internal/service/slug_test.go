@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"latin", "Bolts", "bolts"},
+		{"cyrillic", "Крепеж", "krepezh"},
+		{"multi-letter transliteration", "Щётки и шайбы", "schetki-i-shayby"},
+		{"hard and soft signs dropped", "Подъезд, мебель", "podezd-mebel"},
+		{"digits kept", "Болт М8 x 40", "bolt-m8-x-40"},
+		{"separators collapsed", "  Краски --- / лаки  ", "kraski-laki"},
+		{"unsupported letters", "Çelik Ürünler", "elik-r-nler"},
+		{"only symbols", "!!! ???", ""},
+		{"empty", "", ""},
+		{"truncated", strings.Repeat("a", slugMaxLen+10), strings.Repeat("a", slugMaxLen)},
+		{"truncated on dash", strings.Repeat("a", slugMaxLen-1) + " b", strings.Repeat("a", slugMaxLen-1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slugify(tt.input); got != tt.want {
+				t.Errorf("slugify(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidSlug(t *testing.T) {
+	tests := []struct {
+		slug string
+		want bool
+	}{
+		{"bolts", true},
+		{"bolt-m8-2", true},
+		{"", true},
+		{"Bolts", false},
+		{"bolt_m8", false},
+		{"bolt m8", false},
+		{"болты", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.slug, func(t *testing.T) {
+			if got := validSlug(tt.slug); got != tt.want {
+				t.Errorf("validSlug(%q) = %v, want %v", tt.slug, got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeSlugs - занятые slug по компаниям
+type fakeSlugs map[int64][]string
+
+func (fs fakeSlugs) SlugExists(_ context.Context, slug string, companyId int64) (bool, error) {
+	if slug == "broken" {
+		return false, errStorage
+	}
+
+	for _, s := range fs[companyId] {
+		if s == slug {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func TestUniqueSlug(t *testing.T) {
+	taken := fakeSlugs{
+		1: {"krepezh", "krepezh-2", "category"},
+		2: {"kraski"},
+	}
+
+	tests := []struct {
+		name      string
+		input     string
+		companyId int64
+		want      string
+		wantErr   error
+	}{
+		{"free", "Краски", 1, "kraski", nil},
+		{"taken by other company", "Краски", 2, "kraski-2", nil},
+		{"next free suffix", "Крепеж", 1, "krepezh-3", nil},
+		{"no letters", "???", 1, "category-2", nil},
+		{"storage error", "Broken", 1, "", errStorage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uniqueSlug(context.Background(), taken, tt.input, tt.companyId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("uniqueSlug() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got != tt.want {
+				t.Errorf("uniqueSlug(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
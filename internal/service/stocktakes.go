@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Stocktake interface {
+	Open(ctx context.Context, stocktake domain.Stocktake) (int64, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.Stocktake, error)
+	GetList(ctx context.Context, params domain.StocktakeParams) ([]domain.Stocktake, error)
+	Count(ctx context.Context, req domain.StocktakeCountRequest) (domain.StocktakeLine, error)
+	GetCounts(ctx context.Context, id, companyId int64) ([]domain.StocktakeCount, error)
+	GetVariance(ctx context.Context, id, companyId int64) (domain.StocktakeVariance, error)
+	Approve(ctx context.Context, id, companyId, userId int64) ([]domain.Movement, error)
+	Cancel(ctx context.Context, id, companyId int64) error
+}
+
+type StocktakeService struct {
+	repo *repository.Repository
+}
+
+func NewStocktakeService(repo *repository.Repository) *StocktakeService {
+	return &StocktakeService{
+		repo: repo,
+	}
+}
+
+// Open открывает инвентаризацию склада или зоны склада
+func (ss *StocktakeService) Open(ctx context.Context, stocktake domain.Stocktake) (int64, error) {
+	var violations []domain.FieldViolation
+	if stocktake.CompanyID <= 0 {
+		violations = append(violations, domain.FieldViolation{Field: "company_id", Description: "must be positive"})
+	}
+	if stocktake.WarehouseID <= 0 {
+		violations = append(violations, domain.FieldViolation{Field: "warehouse_id", Description: "must be positive"})
+	}
+	if stocktake.ZoneID < 0 {
+		violations = append(violations, domain.FieldViolation{Field: "zone_id", Description: "must not be negative"})
+	}
+	if len(violations) > 0 {
+		return 0, &domain.ValidationError{Violations: violations}
+	}
+
+	var id int64
+	if err := ss.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		if stocktake.ZoneID != 0 {
+			zone, err := ss.repo.Locations.GetById(ctx, stocktake.ZoneID, stocktake.CompanyID)
+			if err != nil {
+				return err
+			}
+
+			if zone.Kind != domain.LocationKindZone || zone.WarehouseID != stocktake.WarehouseID {
+				return fmt.Errorf("%w: location %d is not a zone of the warehouse", domain.ErrInvalidStocktake, zone.ID)
+			}
+		}
+
+		var err error
+		id, err = ss.repo.Stocktakes.Open(ctx, stocktake)
+		return err
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (ss *StocktakeService) GetById(ctx context.Context, id, companyId int64) (domain.Stocktake, error) {
+	return ss.repo.Stocktakes.GetById(ctx, id, companyId)
+}
+
+func (ss *StocktakeService) GetList(ctx context.Context, params domain.StocktakeParams) ([]domain.Stocktake, error) {
+	return ss.repo.Stocktakes.GetList(ctx, params)
+}
+
+// Count записывает посчитанный остаток партии. Партия задается ровно одним способом:
+// id партии, id товара или текстом отсканированного QR-кода.
+func (ss *StocktakeService) Count(ctx context.Context, req domain.StocktakeCountRequest) (domain.StocktakeLine, error) {
+	var violations []domain.FieldViolation
+	if req.Quantity < 0 {
+		violations = append(violations, domain.FieldViolation{Field: "quantity", Description: "must not be negative"})
+	}
+
+	var given int
+	for _, set := range []bool{req.MaterialID != 0, req.ItemID != 0, req.QRPayload != ""} {
+		if set {
+			given++
+		}
+	}
+	if given != 1 {
+		violations = append(violations, domain.FieldViolation{
+			Field: "material_id", Description: "exactly one of material_id, item_id or qr_payload must be set",
+		})
+	}
+	if len(violations) > 0 {
+		return domain.StocktakeLine{}, &domain.ValidationError{Violations: violations}
+	}
+
+	source := domain.CountSourceMaterial
+	switch {
+	case req.ItemID != 0:
+		source = domain.CountSourceItem
+	case req.QRPayload != "":
+		qr, err := domain.ParseQRInfo(req.QRPayload)
+		if err != nil {
+			return domain.StocktakeLine{}, &domain.ValidationError{Violations: []domain.FieldViolation{
+				{Field: "qr_payload", Description: "is not a material qr code"},
+			}}
+		}
+
+		// товар из кода тоже сверяется: код партии, у которой с тех пор сменился товар, не подходит
+		req.MaterialID, req.ItemID = qr.ID, qr.ItemID
+		source = domain.CountSourceQR
+	}
+
+	return ss.repo.Stocktakes.Count(ctx, req, source)
+}
+
+func (ss *StocktakeService) GetCounts(ctx context.Context, id, companyId int64) ([]domain.StocktakeCount, error) {
+	return ss.repo.Stocktakes.GetCounts(ctx, id, companyId)
+}
+
+// GetVariance отчет о расхождениях по посчитанным партиям, стоимость считается по цене на момент открытия
+func (ss *StocktakeService) GetVariance(ctx context.Context, id, companyId int64) (domain.StocktakeVariance, error) {
+	stocktake, err := ss.repo.Stocktakes.GetById(ctx, id, companyId)
+	if err != nil {
+		return domain.StocktakeVariance{}, err
+	}
+
+	report := domain.StocktakeVariance{StocktakeID: stocktake.ID, TotalLines: int64(len(stocktake.Lines))}
+
+	for _, line := range stocktake.Lines {
+		if !line.IsCounted {
+			continue
+		}
+		report.CountedLines++
+
+		variance := line.Variance()
+		switch {
+		case variance > 0:
+			report.Surplus += variance
+			report.SurplusValue += float64(variance) * line.Price
+		case variance < 0:
+			report.Shortage -= variance
+			report.ShortageValue -= float64(variance) * line.Price
+		default:
+			continue
+		}
+
+		report.Lines = append(report.Lines, line)
+	}
+
+	return report, nil
+}
+
+// Approve проводит расхождения корректировками и закрывает инвентаризацию
+func (ss *StocktakeService) Approve(ctx context.Context, id, companyId, userId int64) ([]domain.Movement, error) {
+	return ss.repo.Stocktakes.Approve(ctx, id, companyId, userId)
+}
+
+func (ss *StocktakeService) Cancel(ctx context.Context, id, companyId int64) error {
+	return ss.repo.Stocktakes.Cancel(ctx, id, companyId)
+}
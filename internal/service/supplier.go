@@ -2,44 +2,120 @@ package service
 
 import (
 	"context"
+	"errors"
+	"github.com/rusystem/crm-warehouse/internal/config"
 	"github.com/rusystem/crm-warehouse/internal/repository"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
 )
 
 type Supplier interface {
 	Create(ctx context.Context, supplier domain.Supplier) (int64, error)
-	GetById(ctx context.Context, id int64) (domain.Supplier, error)
+	GetById(ctx context.Context, id, companyId int64, includeDeleted bool) (domain.Supplier, error)
 	Update(ctx context.Context, supplier domain.Supplier) error
-	Delete(ctx context.Context, id int64) error
-	GetListByCompanyId(ctx context.Context, id int64) ([]domain.Supplier, error)
+	Delete(ctx context.Context, id, companyId int64) error
+	GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Supplier, error)
+	Restore(ctx context.Context, id, companyId int64) error
 }
 
 type SupplierService struct {
-	repo *repository.Repository
+	cfg      *config.Config
+	repo     *repository.Repository
+	events   Events
+	material Material
 }
 
-func NewSupplierService(repo *repository.Repository) *SupplierService {
+func NewSupplierService(cfg *config.Config, repo *repository.Repository, events Events, material Material) *SupplierService {
 	return &SupplierService{
-		repo: repo,
+		cfg:      cfg,
+		repo:     repo,
+		events:   events,
+		material: material,
 	}
 }
 
 func (ss *SupplierService) Create(ctx context.Context, supplier domain.Supplier) (int64, error) {
-	return ss.repo.Suppliers.Create(ctx, supplier)
+	if err := validate(supplier, supplierRules); err != nil {
+		return 0, err
+	}
+
+	var id int64
+	if err := ss.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if id, err = ss.repo.Suppliers.Create(ctx, supplier); err != nil {
+			return err
+		}
+
+		supplier.ID = id
+		return ss.events.Emit(ctx, domain.EventSupplierCreated, supplier.CompanyID, id, supplier)
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
 }
 
-func (ss *SupplierService) GetById(ctx context.Context, id int64) (domain.Supplier, error) {
-	return ss.repo.Suppliers.GetById(ctx, id)
+// GetById возвращает поставщика, удаленного - только с includeDeleted
+func (ss *SupplierService) GetById(ctx context.Context, id, companyId int64, includeDeleted bool) (domain.Supplier, error) {
+	supplier, err := ss.repo.Suppliers.GetById(ctx, id, companyId)
+	if includeDeleted && errors.Is(err, domain.ErrSupplierNotFound) {
+		return ss.repo.Suppliers.GetDeletedById(ctx, id, companyId)
+	}
+
+	return supplier, err
 }
 
 func (ss *SupplierService) Update(ctx context.Context, supplier domain.Supplier) error {
-	return ss.repo.Suppliers.Update(ctx, supplier)
+	if err := validate(supplier, supplierRules); err != nil {
+		return err
+	}
+
+	return ss.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := ss.repo.Suppliers.Update(ctx, supplier); err != nil {
+			return err
+		}
+
+		return ss.events.Emit(ctx, domain.EventSupplierUpdated, supplier.CompanyID, supplier.ID, supplier)
+	})
+}
+
+// Delete мягко удаляет поставщика. Материалы в планировании и купленные, ссылающиеся на него,
+// блокируют удаление или удаляются вместе с ним по политике references.delete_policy,
+// архив сохраняет ссылку до восстановления или очистки.
+func (ss *SupplierService) Delete(ctx context.Context, id, companyId int64) error {
+	return ss.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		supplier, err := ss.repo.Suppliers.GetById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		if err = releaseReferences(ctx, ss.cfg, ss.repo, ss.material,
+			domain.MaterialReference{CompanyID: companyId, SupplierID: id}); err != nil {
+			return err
+		}
+
+		if err = ss.repo.Suppliers.Delete(ctx, id, companyId); err != nil {
+			return err
+		}
+
+		return ss.events.Emit(ctx, domain.EventSupplierDeleted, companyId, id, supplier)
+	})
 }
 
-func (ss *SupplierService) Delete(ctx context.Context, id int64) error {
-	return ss.repo.Suppliers.Delete(ctx, id)
+func (ss *SupplierService) GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Supplier, error) {
+	return ss.repo.Suppliers.GetListByCompanyId(ctx, id, includeDeleted)
 }
 
-func (ss *SupplierService) GetListByCompanyId(ctx context.Context, id int64) ([]domain.Supplier, error) {
-	return ss.repo.Suppliers.GetListByCompanyId(ctx, id)
+func (ss *SupplierService) Restore(ctx context.Context, id, companyId int64) error {
+	return ss.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := ss.repo.Suppliers.Restore(ctx, id, companyId); err != nil {
+			return err
+		}
+
+		supplier, err := ss.repo.Suppliers.GetById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		return ss.events.Emit(ctx, domain.EventSupplierRestored, companyId, id, supplier)
+	})
 }
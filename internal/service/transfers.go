@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/repository"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+)
+
+type Transfer interface {
+	Create(ctx context.Context, order domain.TransferOrder) (int64, error)
+	GetById(ctx context.Context, id, companyId int64) (domain.TransferOrder, error)
+	GetList(ctx context.Context, params domain.TransferOrderParams) ([]domain.TransferOrder, error)
+	Ship(ctx context.Context, id, companyId, userId int64) error
+	Receive(ctx context.Context, id, companyId, userId int64, receipts []domain.TransferReceipt) error
+	Cancel(ctx context.Context, id, companyId int64) error
+	GetInTransit(ctx context.Context, params domain.InTransitParams) (int64, error)
+}
+
+type TransferService struct {
+	repo   *repository.Repository
+	events Events
+}
+
+func NewTransferService(repo *repository.Repository, events Events) *TransferService {
+	return &TransferService{
+		repo:   repo,
+		events: events,
+	}
+}
+
+func (ts *TransferService) Create(ctx context.Context, order domain.TransferOrder) (int64, error) {
+	if order.SourceWarehouseID == order.DestinationWarehouseID {
+		return 0, fmt.Errorf("%w: source and destination warehouses must differ", domain.ErrInvalidTransferOrder)
+	}
+
+	if len(order.Items) == 0 {
+		return 0, fmt.Errorf("%w: order has no items", domain.ErrInvalidTransferOrder)
+	}
+
+	seen := make(map[int64]struct{}, len(order.Items))
+	for _, item := range order.Items {
+		if item.Quantity <= 0 {
+			return 0, fmt.Errorf("%w: quantity must be positive", domain.ErrInvalidTransferOrder)
+		}
+
+		if _, ok := seen[item.MaterialID]; ok {
+			return 0, fmt.Errorf("%w: material %d is listed twice", domain.ErrInvalidTransferOrder, item.MaterialID)
+		}
+		seen[item.MaterialID] = struct{}{}
+	}
+
+	return ts.repo.Transfers.Create(ctx, order)
+}
+
+func (ts *TransferService) GetById(ctx context.Context, id, companyId int64) (domain.TransferOrder, error) {
+	return ts.repo.Transfers.GetById(ctx, id, companyId)
+}
+
+func (ts *TransferService) GetList(ctx context.Context, params domain.TransferOrderParams) ([]domain.TransferOrder, error) {
+	return ts.repo.Transfers.GetList(ctx, params)
+}
+
+func (ts *TransferService) Ship(ctx context.Context, id, companyId, userId int64) error {
+	return ts.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := ts.repo.Transfers.Ship(ctx, id, companyId, userId); err != nil {
+			return err
+		}
+
+		order, err := ts.repo.Transfers.GetById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		return syncOccupancy(ctx, ts.repo, ts.events, companyId, 0, order.SourceWarehouseID)
+	})
+}
+
+func (ts *TransferService) Receive(ctx context.Context, id, companyId, userId int64, receipts []domain.TransferReceipt) error {
+	if len(receipts) == 0 {
+		return fmt.Errorf("%w: no items to receive", domain.ErrInvalidTransferOrder)
+	}
+
+	return ts.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := ts.repo.Transfers.Receive(ctx, id, companyId, userId, receipts); err != nil {
+			return err
+		}
+
+		order, err := ts.repo.Transfers.GetById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		return syncOccupancy(ctx, ts.repo, ts.events, companyId, order.DestinationWarehouseID)
+	})
+}
+
+func (ts *TransferService) Cancel(ctx context.Context, id, companyId int64) error {
+	return ts.repo.Transfers.Cancel(ctx, id, companyId)
+}
+
+func (ts *TransferService) GetInTransit(ctx context.Context, params domain.InTransitParams) (int64, error) {
+	return ts.repo.Transfers.GetInTransit(ctx, params)
+}
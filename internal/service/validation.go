@@ -0,0 +1,144 @@
+package service
+
+import (
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+)
+
+// fieldRule - правило валидации одного поля, valid возвращает false при нарушении
+type fieldRule[T any] struct {
+	field       string
+	description string
+	valid       func(v T) bool
+}
+
+// validate проверяет все правила и возвращает *domain.ValidationError со всеми нарушениями
+func validate[T any](v T, rules []fieldRule[T]) error {
+	var violations []domain.FieldViolation
+	for _, r := range rules {
+		if !r.valid(v) {
+			violations = append(violations, domain.FieldViolation{Field: r.field, Description: r.description})
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &domain.ValidationError{Violations: violations}
+}
+
+func notBlank(s string) bool {
+	return strings.TrimSpace(s) != ""
+}
+
+// maxLen - ограничение VARCHAR в символах, а не байтах
+func maxLen(s string, n int) bool {
+	return utf8.RuneCountInString(s) <= n
+}
+
+func optionalEmail(s string) bool {
+	if s == "" {
+		return true
+	}
+
+	_, err := mail.ParseAddress(s)
+	return err == nil
+}
+
+var materialRules = []fieldRule[domain.Material]{
+	{"name", "must not be empty", func(m domain.Material) bool { return notBlank(m.Name) }},
+	{"name", "must be at most 255 characters", func(m domain.Material) bool { return maxLen(m.Name, 255) }},
+	{"company_id", "must be positive", func(m domain.Material) bool { return m.CompanyID > 0 }},
+	{"warehouse_id", "must not be negative", func(m domain.Material) bool { return m.WarehouseID >= 0 }},
+	{"supplier_id", "must not be negative", func(m domain.Material) bool { return m.SupplierID >= 0 }},
+	{"bin_id", "must not be negative", func(m domain.Material) bool { return m.BinID >= 0 }},
+	{"category_id", "must not be negative", func(m domain.Material) bool { return m.CategoryID >= 0 }},
+	{"total_quantity", "must not be negative", func(m domain.Material) bool { return m.TotalQuantity >= 0 }},
+	{"volume", "must not be negative", func(m domain.Material) bool { return m.Volume >= 0 }},
+	{"price_without_vat", "must not be negative", func(m domain.Material) bool { return m.PriceWithoutVAT >= 0 }},
+	{"total_without_vat", "must not be negative", func(m domain.Material) bool { return m.TotalWithoutVAT >= 0 }},
+	{"min_stock_level", "must not be negative", func(m domain.Material) bool { return m.MinStockLevel >= 0 }},
+	{"storage_cost", "must not be negative", func(m domain.Material) bool { return m.StorageCost >= 0 }},
+	{"unit", "must be at most 50 characters", func(m domain.Material) bool { return maxLen(m.Unit, 50) }},
+	{"status", "must be at most 50 characters", func(m domain.Material) bool { return maxLen(m.Status, 50) }},
+}
+
+var materialParamsRules = []fieldRule[domain.MaterialParams]{
+	{"limit", "must be positive", func(p domain.MaterialParams) bool { return p.Limit > 0 }},
+	{"offset", "must not be negative", func(p domain.MaterialParams) bool { return p.Offset >= 0 }},
+	{"offset", "must be zero with cursor", func(p domain.MaterialParams) bool { return p.Cursor == "" || p.Offset == 0 }},
+	{"sort", "must contain known fields without repeats", func(p domain.MaterialParams) bool {
+		seen := make(map[string]bool, len(p.Sort))
+		for _, s := range p.Sort {
+			if !domain.MaterialSortFields[s.Field] || seen[s.Field] {
+				return false
+			}
+			seen[s.Field] = true
+		}
+
+		return true
+	}},
+	{"filter.received_to", "must be after received_from", func(p domain.MaterialParams) bool {
+		f := p.Filter
+		return f.ReceivedFrom.IsZero() || f.ReceivedTo.IsZero() || f.ReceivedTo.After(f.ReceivedFrom)
+	}},
+	{"filter.expiration_to", "must be after expiration_from", func(p domain.MaterialParams) bool {
+		f := p.Filter
+		return f.ExpirationFrom.IsZero() || f.ExpirationTo.IsZero() || f.ExpirationTo.After(f.ExpirationFrom)
+	}},
+	{"filter.quantity_max", "must not be less than quantity_min", func(p domain.MaterialParams) bool {
+		f := p.Filter
+		return f.QuantityMin == nil || f.QuantityMax == nil || *f.QuantityMax >= *f.QuantityMin
+	}},
+}
+
+var supplierRules = []fieldRule[domain.Supplier]{
+	{"name", "must not be empty", func(s domain.Supplier) bool { return notBlank(s.Name) }},
+	{"name", "must be at most 255 characters", func(s domain.Supplier) bool { return maxLen(s.Name, 255) }},
+	{"company_id", "must be positive", func(s domain.Supplier) bool { return s.CompanyID > 0 }},
+	{"email", "must be a valid email address", func(s domain.Supplier) bool { return optionalEmail(s.Email) }},
+	{"phone", "must be at most 50 characters", func(s domain.Supplier) bool { return maxLen(s.Phone, 50) }},
+	{"tax_id", "must be at most 50 characters", func(s domain.Supplier) bool { return maxLen(s.TaxID, 50) }},
+	{"product_types", "must not be negative", func(s domain.Supplier) bool { return s.ProductTypes >= 0 }},
+	{"purchase_amount", "must not be negative", func(s domain.Supplier) bool { return s.PurchaseAmount >= 0 }},
+}
+
+var warehouseRules = []fieldRule[domain.Warehouse]{
+	{"name", "must not be empty", func(w domain.Warehouse) bool { return notBlank(w.Name) }},
+	{"name", "must be at most 255 characters", func(w domain.Warehouse) bool { return maxLen(w.Name, 255) }},
+	{"company_id", "must be positive", func(w domain.Warehouse) bool { return w.CompanyID > 0 }},
+	{"email", "must be a valid email address", func(w domain.Warehouse) bool { return optionalEmail(w.Email) }},
+	{"phone", "must be at most 50 characters", func(w domain.Warehouse) bool { return maxLen(w.Phone, 50) }},
+	{"max_capacity", "must not be negative", func(w domain.Warehouse) bool { return w.MaxCapacity >= 0 }},
+	{"capacity_policy", "must be reject or warn", func(w domain.Warehouse) bool {
+		return w.CapacityPolicy == domain.CapacityPolicyReject || w.CapacityPolicy == domain.CapacityPolicyWarn
+	}},
+}
+
+var categoryRules = []fieldRule[domain.MaterialCategory]{
+	{"name", "must not be empty", func(c domain.MaterialCategory) bool { return notBlank(c.Name) }},
+	{"name", "must be at most 255 characters", func(c domain.MaterialCategory) bool { return maxLen(c.Name, 255) }},
+	{"company_id", "must be positive", func(c domain.MaterialCategory) bool { return c.CompanyID > 0 }},
+	{"slug", "must be at most 255 characters", func(c domain.MaterialCategory) bool { return maxLen(c.Slug, 255) }},
+	{"slug", "must contain only lowercase latin letters, digits and hyphens", func(c domain.MaterialCategory) bool {
+		return validSlug(c.Slug)
+	}},
+	{"parent_id", "must not be negative", func(c domain.MaterialCategory) bool { return c.ParentID >= 0 }},
+	{"img_url", "must be at most 255 characters", func(c domain.MaterialCategory) bool { return maxLen(c.ImgURL, 255) }},
+}
+
+var locationRules = []fieldRule[domain.StorageLocation]{
+	{"company_id", "must be positive", func(l domain.StorageLocation) bool { return l.CompanyID > 0 }},
+	{"warehouse_id", "must be positive", func(l domain.StorageLocation) bool { return l.WarehouseID > 0 }},
+	{"kind", "must be zone, rack, shelf or bin", func(l domain.StorageLocation) bool {
+		_, ok := domain.LocationParentKind[l.Kind]
+		return ok
+	}},
+	{"code", "must not be empty", func(l domain.StorageLocation) bool { return notBlank(l.Code) }},
+	{"code", "must be at most 64 characters", func(l domain.StorageLocation) bool { return maxLen(l.Code, 64) }},
+	{"name", "must be at most 255 characters", func(l domain.StorageLocation) bool { return maxLen(l.Name, 255) }},
+	{"capacity", "must not be negative", func(l domain.StorageLocation) bool { return l.Capacity >= 0 }},
+}
@@ -0,0 +1,361 @@
+package service
+
+import (
+	"errors"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// violations возвращает нарушения из ошибки validate, nil - ошибки нет
+func violations(t *testing.T, err error) []domain.FieldViolation {
+	t.Helper()
+
+	if err == nil {
+		return nil
+	}
+
+	var verr *domain.ValidationError
+	if !errors.As(err, &verr) || !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Fatalf("validate() error = %v, want validation error", err)
+	}
+
+	return verr.Violations
+}
+
+func TestFieldChecks(t *testing.T) {
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"blank", notBlank(" \t\n"), false},
+		{"not blank", notBlank(" a "), true},
+		{"max length in characters", maxLen(strings.Repeat("я", 5), 5), true},
+		{"over max length", maxLen(strings.Repeat("a", 6), 5), false},
+		{"empty email", optionalEmail(""), true},
+		{"valid email", optionalEmail("user@example.com"), true},
+		{"email with name", optionalEmail("Иван <ivan@example.com>"), true},
+		{"invalid email", optionalEmail("user@"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaterialRules(t *testing.T) {
+	valid := domain.Material{Name: "Болт", CompanyID: 1, TotalQuantity: 10, Unit: "шт"}
+
+	tests := []struct {
+		name   string
+		modify func(m *domain.Material)
+		want   []domain.FieldViolation
+	}{
+		{
+			name:   "valid",
+			modify: func(m *domain.Material) {},
+		},
+		{
+			name:   "blank name",
+			modify: func(m *domain.Material) { m.Name = "  " },
+			want:   []domain.FieldViolation{{Field: "name", Description: "must not be empty"}},
+		},
+		{
+			name:   "long name",
+			modify: func(m *domain.Material) { m.Name = strings.Repeat("б", 256) },
+			want:   []domain.FieldViolation{{Field: "name", Description: "must be at most 255 characters"}},
+		},
+		{
+			name:   "name of 255 characters",
+			modify: func(m *domain.Material) { m.Name = strings.Repeat("б", 255) },
+		},
+		{
+			name:   "no company",
+			modify: func(m *domain.Material) { m.CompanyID = 0 },
+			want:   []domain.FieldViolation{{Field: "company_id", Description: "must be positive"}},
+		},
+		{
+			name: "negative values",
+			modify: func(m *domain.Material) {
+				m.WarehouseID = -1
+				m.TotalQuantity = -1
+				m.PriceWithoutVAT = -0.01
+			},
+			want: []domain.FieldViolation{
+				{Field: "warehouse_id", Description: "must not be negative"},
+				{Field: "total_quantity", Description: "must not be negative"},
+				{Field: "price_without_vat", Description: "must not be negative"},
+			},
+		},
+		{
+			name:   "long unit",
+			modify: func(m *domain.Material) { m.Unit = strings.Repeat("a", 51) },
+			want:   []domain.FieldViolation{{Field: "unit", Description: "must be at most 50 characters"}},
+		},
+		{
+			name: "all violations in rule order",
+			modify: func(m *domain.Material) {
+				m.Name = ""
+				m.CompanyID = -1
+				m.Status = strings.Repeat("a", 51)
+			},
+			want: []domain.FieldViolation{
+				{Field: "name", Description: "must not be empty"},
+				{Field: "company_id", Description: "must be positive"},
+				{Field: "status", Description: "must be at most 50 characters"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := valid
+			tt.modify(&m)
+
+			if got := violations(t, validate(m, materialRules)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaterialParamsRules(t *testing.T) {
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	one, two := int64(1), int64(2)
+
+	tests := []struct {
+		name   string
+		params domain.MaterialParams
+		want   []domain.FieldViolation
+	}{
+		{
+			name:   "valid",
+			params: domain.MaterialParams{Limit: 10, Offset: 20, Sort: []domain.MaterialSort{{Field: "name", Desc: true}, {Field: "id"}}},
+		},
+		{
+			name:   "no limit",
+			params: domain.MaterialParams{},
+			want:   []domain.FieldViolation{{Field: "limit", Description: "must be positive"}},
+		},
+		{
+			name:   "negative offset",
+			params: domain.MaterialParams{Limit: 10, Offset: -1},
+			want:   []domain.FieldViolation{{Field: "offset", Description: "must not be negative"}},
+		},
+		{
+			name:   "offset with cursor",
+			params: domain.MaterialParams{Limit: 10, Offset: 5, Cursor: "abc"},
+			want:   []domain.FieldViolation{{Field: "offset", Description: "must be zero with cursor"}},
+		},
+		{
+			name:   "unknown sort field",
+			params: domain.MaterialParams{Limit: 10, Sort: []domain.MaterialSort{{Field: "password"}}},
+			want:   []domain.FieldViolation{{Field: "sort", Description: "must contain known fields without repeats"}},
+		},
+		{
+			name:   "repeated sort field",
+			params: domain.MaterialParams{Limit: 10, Sort: []domain.MaterialSort{{Field: "name"}, {Field: "name", Desc: true}}},
+			want:   []domain.FieldViolation{{Field: "sort", Description: "must contain known fields without repeats"}},
+		},
+		{
+			name:   "received range",
+			params: domain.MaterialParams{Limit: 10, Filter: domain.MaterialFilter{ReceivedFrom: day, ReceivedTo: day.AddDate(0, 0, 1)}},
+		},
+		{
+			name:   "empty received range",
+			params: domain.MaterialParams{Limit: 10, Filter: domain.MaterialFilter{ReceivedFrom: day, ReceivedTo: day}},
+			want:   []domain.FieldViolation{{Field: "filter.received_to", Description: "must be after received_from"}},
+		},
+		{
+			name:   "open expiration range",
+			params: domain.MaterialParams{Limit: 10, Filter: domain.MaterialFilter{ExpirationTo: day}},
+		},
+		{
+			name:   "inverted expiration range",
+			params: domain.MaterialParams{Limit: 10, Filter: domain.MaterialFilter{ExpirationFrom: day, ExpirationTo: day.AddDate(0, 0, -1)}},
+			want:   []domain.FieldViolation{{Field: "filter.expiration_to", Description: "must be after expiration_from"}},
+		},
+		{
+			name:   "equal quantity bounds",
+			params: domain.MaterialParams{Limit: 10, Filter: domain.MaterialFilter{QuantityMin: &one, QuantityMax: &one}},
+		},
+		{
+			name:   "inverted quantity bounds",
+			params: domain.MaterialParams{Limit: 10, Filter: domain.MaterialFilter{QuantityMin: &two, QuantityMax: &one}},
+			want:   []domain.FieldViolation{{Field: "filter.quantity_max", Description: "must not be less than quantity_min"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := violations(t, validate(tt.params, materialParamsRules)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSupplierRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		supplier domain.Supplier
+		want     []domain.FieldViolation
+	}{
+		{
+			name:     "valid",
+			supplier: domain.Supplier{Name: "ООО Крепеж", CompanyID: 1, Email: "sales@example.com"},
+		},
+		{
+			name:     "invalid email",
+			supplier: domain.Supplier{Name: "ООО Крепеж", CompanyID: 1, Email: "sales"},
+			want:     []domain.FieldViolation{{Field: "email", Description: "must be a valid email address"}},
+		},
+		{
+			name:     "long tax id and negative amount",
+			supplier: domain.Supplier{Name: "ООО Крепеж", CompanyID: 1, TaxID: strings.Repeat("1", 51), PurchaseAmount: -1},
+			want: []domain.FieldViolation{
+				{Field: "tax_id", Description: "must be at most 50 characters"},
+				{Field: "purchase_amount", Description: "must not be negative"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := violations(t, validate(tt.supplier, supplierRules)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWarehouseRules(t *testing.T) {
+	tests := []struct {
+		name      string
+		warehouse domain.Warehouse
+		want      []domain.FieldViolation
+	}{
+		{
+			name:      "reject policy",
+			warehouse: domain.Warehouse{Name: "Основной", CompanyID: 1, CapacityPolicy: domain.CapacityPolicyReject},
+		},
+		{
+			name:      "warn policy",
+			warehouse: domain.Warehouse{Name: "Основной", CompanyID: 1, CapacityPolicy: domain.CapacityPolicyWarn},
+		},
+		{
+			name:      "unknown policy",
+			warehouse: domain.Warehouse{Name: "Основной", CompanyID: 1, CapacityPolicy: "ignore"},
+			want:      []domain.FieldViolation{{Field: "capacity_policy", Description: "must be reject or warn"}},
+		},
+		{
+			name:      "negative capacity",
+			warehouse: domain.Warehouse{Name: "Основной", CompanyID: 1, MaxCapacity: -1, CapacityPolicy: domain.CapacityPolicyWarn},
+			want:      []domain.FieldViolation{{Field: "max_capacity", Description: "must not be negative"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := violations(t, validate(tt.warehouse, warehouseRules)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCategoryRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		category domain.MaterialCategory
+		want     []domain.FieldViolation
+	}{
+		{
+			name:     "valid",
+			category: domain.MaterialCategory{Name: "Крепеж", CompanyID: 1, Slug: "krepezh-2", ParentID: 3},
+		},
+		{
+			name:     "slug generated later",
+			category: domain.MaterialCategory{Name: "Крепеж", CompanyID: 1},
+		},
+		{
+			name:     "invalid slug",
+			category: domain.MaterialCategory{Name: "Крепеж", CompanyID: 1, Slug: "Крепеж"},
+			want: []domain.FieldViolation{
+				{Field: "slug", Description: "must contain only lowercase latin letters, digits and hyphens"},
+			},
+		},
+		{
+			name:     "long slug",
+			category: domain.MaterialCategory{Name: "Крепеж", CompanyID: 1, Slug: strings.Repeat("a", 256)},
+			want:     []domain.FieldViolation{{Field: "slug", Description: "must be at most 255 characters"}},
+		},
+		{
+			name:     "no name and company",
+			category: domain.MaterialCategory{Name: " ", Slug: "krepezh"},
+			want: []domain.FieldViolation{
+				{Field: "name", Description: "must not be empty"},
+				{Field: "company_id", Description: "must be positive"},
+			},
+		},
+		{
+			name:     "negative parent",
+			category: domain.MaterialCategory{Name: "Крепеж", CompanyID: 1, ParentID: -1},
+			want:     []domain.FieldViolation{{Field: "parent_id", Description: "must not be negative"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := violations(t, validate(tt.category, categoryRules)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocationRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		location domain.StorageLocation
+		want     []domain.FieldViolation
+	}{
+		{
+			name:     "valid",
+			location: domain.StorageLocation{CompanyID: 1, WarehouseID: 1, Kind: domain.LocationKindBin, Code: "A-01-02"},
+		},
+		{
+			name:     "unknown kind",
+			location: domain.StorageLocation{CompanyID: 1, WarehouseID: 1, Kind: "box", Code: "A-01"},
+			want:     []domain.FieldViolation{{Field: "kind", Description: "must be zone, rack, shelf or bin"}},
+		},
+		{
+			name:     "no warehouse and code",
+			location: domain.StorageLocation{CompanyID: 1, Kind: domain.LocationKindZone},
+			want: []domain.FieldViolation{
+				{Field: "warehouse_id", Description: "must be positive"},
+				{Field: "code", Description: "must not be empty"},
+			},
+		},
+		{
+			name:     "long code",
+			location: domain.StorageLocation{CompanyID: 1, WarehouseID: 1, Kind: domain.LocationKindZone, Code: strings.Repeat("A", 65)},
+			want:     []domain.FieldViolation{{Field: "code", Description: "must be at most 64 characters"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := violations(t, validate(tt.location, locationRules)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
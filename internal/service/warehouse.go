@@ -2,49 +2,181 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/rusystem/crm-warehouse/internal/config"
 	"github.com/rusystem/crm-warehouse/internal/repository"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"go.uber.org/zap"
 )
 
 type Warehouse interface {
 	Create(ctx context.Context, warehouse domain.Warehouse) (int64, error)
-	GetById(ctx context.Context, id int64) (domain.Warehouse, error)
+	GetById(ctx context.Context, id, companyId int64, includeDeleted bool) (domain.Warehouse, error)
 	Update(ctx context.Context, warehouse domain.Warehouse) error
-	Delete(ctx context.Context, id int64) error
-	GetListByCompanyId(ctx context.Context, id int64) ([]domain.Warehouse, error)
+	Delete(ctx context.Context, id, companyId int64) error
+	GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Warehouse, error)
 	GetResponsibleUsers(ctx context.Context, companyId int64) ([]domain.User, error)
+	Restore(ctx context.Context, id, companyId int64) error
 }
 
 type WarehouseService struct {
-	repo *repository.Repository
+	cfg      *config.Config
+	repo     *repository.Repository
+	events   Events
+	material Material
 }
 
-func NewWarehouseService(repo *repository.Repository) *WarehouseService {
+func NewWarehouseService(cfg *config.Config, repo *repository.Repository, events Events, material Material) *WarehouseService {
 	return &WarehouseService{
-		repo: repo,
+		cfg:      cfg,
+		repo:     repo,
+		events:   events,
+		material: material,
 	}
 }
 
+// Create заводит склад, заполненность нового склада нулевая независимо от переданной
 func (ws *WarehouseService) Create(ctx context.Context, warehouse domain.Warehouse) (int64, error) {
-	return ws.repo.Warehouse.Create(ctx, warehouse)
+	if warehouse.CapacityPolicy == "" {
+		warehouse.CapacityPolicy = domain.CapacityPolicyWarn
+	}
+
+	if err := validate(warehouse, warehouseRules); err != nil {
+		return 0, err
+	}
+
+	warehouse.CurrentOccupancy = 0
+
+	var id int64
+	if err := ws.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if id, err = ws.repo.Warehouse.Create(ctx, warehouse); err != nil {
+			return err
+		}
+
+		warehouse.ID = id
+		return ws.events.Emit(ctx, domain.EventWarehouseCreated, warehouse.CompanyID, id, warehouse)
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
 }
 
-func (ws *WarehouseService) GetById(ctx context.Context, id int64) (domain.Warehouse, error) {
-	return ws.repo.Warehouse.GetById(ctx, id)
+// GetById возвращает склад, удаленный - только с includeDeleted
+func (ws *WarehouseService) GetById(ctx context.Context, id, companyId int64, includeDeleted bool) (domain.Warehouse, error) {
+	warehouse, err := ws.repo.Warehouse.GetById(ctx, id, companyId)
+	if includeDeleted && errors.Is(err, domain.ErrWarehouseNotFound) {
+		return ws.repo.Warehouse.GetDeletedById(ctx, id, companyId)
+	}
+
+	return warehouse, err
 }
 
+// Update меняет данные склада. Заполненность считается по партиям и не меняется,
+// без политики вместимости сохраняется текущая
 func (ws *WarehouseService) Update(ctx context.Context, warehouse domain.Warehouse) error {
-	return ws.repo.Warehouse.Update(ctx, warehouse)
+	return ws.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		current, err := ws.repo.Warehouse.GetById(ctx, warehouse.ID, warehouse.CompanyID)
+		if err != nil {
+			return err
+		}
+
+		warehouse.CurrentOccupancy = current.CurrentOccupancy
+		if warehouse.CapacityPolicy == "" {
+			warehouse.CapacityPolicy = current.CapacityPolicy
+		}
+
+		if err = validate(warehouse, warehouseRules); err != nil {
+			return err
+		}
+
+		if err = ws.repo.Warehouse.Update(ctx, warehouse); err != nil {
+			return err
+		}
+
+		return ws.events.Emit(ctx, domain.EventWarehouseUpdated, warehouse.CompanyID, warehouse.ID, warehouse)
+	})
 }
 
-func (ws *WarehouseService) Delete(ctx context.Context, id int64) error {
-	return ws.repo.Warehouse.Delete(ctx, id)
+// Delete мягко удаляет склад, движения на удаленный склад не проводятся. Материалы в планировании
+// и купленные на складе блокируют удаление или удаляются вместе с ним по политике references.delete_policy.
+func (ws *WarehouseService) Delete(ctx context.Context, id, companyId int64) error {
+	return ws.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		warehouse, err := ws.repo.Warehouse.GetById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		if err = releaseReferences(ctx, ws.cfg, ws.repo, ws.material,
+			domain.MaterialReference{CompanyID: companyId, WarehouseID: id}); err != nil {
+			return err
+		}
+
+		if err = ws.repo.Warehouse.Delete(ctx, id, companyId); err != nil {
+			return err
+		}
+
+		return ws.events.Emit(ctx, domain.EventWarehouseDeleted, companyId, id, warehouse)
+	})
 }
 
-func (ws *WarehouseService) GetListByCompanyId(ctx context.Context, id int64) ([]domain.Warehouse, error) {
-	return ws.repo.Warehouse.GetListByCompanyId(ctx, id)
+func (ws *WarehouseService) GetListByCompanyId(ctx context.Context, id int64, includeDeleted bool) ([]domain.Warehouse, error) {
+	return ws.repo.Warehouse.GetListByCompanyId(ctx, id, includeDeleted)
 }
 
 func (ws *WarehouseService) GetResponsibleUsers(ctx context.Context, companyId int64) ([]domain.User, error) {
 	return ws.repo.Warehouse.GetResponsibleUsers(ctx, companyId)
 }
+
+func (ws *WarehouseService) Restore(ctx context.Context, id, companyId int64) error {
+	return ws.repo.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := ws.repo.Warehouse.Restore(ctx, id, companyId); err != nil {
+			return err
+		}
+
+		warehouse, err := ws.repo.Warehouse.GetById(ctx, id, companyId)
+		if err != nil {
+			return err
+		}
+
+		return ws.events.Emit(ctx, domain.EventWarehouseRestored, companyId, id, warehouse)
+	})
+}
+
+// syncOccupancy пересчитывает заполненность складов ids после изменения их партий, вызывается в WithinTx.
+// received - склад, принявший объем, 0 - объем только освобождался. Превышение вместимости склада received
+// по политике reject отменяет изменение, по политике warn изменение проходит с событием warehouse.capacity_exceeded.
+// Освобождение объема не проверяется, иначе с переполненного склада нельзя было бы ничего забрать.
+func syncOccupancy(ctx context.Context, repo *repository.Repository, events Events, companyId, received int64, ids ...int64) error {
+	if received != 0 {
+		ids = append(ids, received)
+	}
+
+	occupancies, err := repo.Warehouse.RefreshOccupancy(ctx, companyId, ids...)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range occupancies {
+		if o.WarehouseID != received || !o.Exceeded() {
+			continue
+		}
+
+		if o.CapacityPolicy == domain.CapacityPolicyReject {
+			return fmt.Errorf("%w: warehouse %d would hold %d of %d", domain.ErrWarehouseFull,
+				o.WarehouseID, o.Occupancy, o.MaxCapacity)
+		}
+
+		logger.Warn("warehouse capacity exceeded", zap.Int64("warehouse_id", o.WarehouseID),
+			zap.Int64("occupancy", o.Occupancy), zap.Int64("max_capacity", o.MaxCapacity))
+
+		if err = events.Emit(ctx, domain.EventWarehouseCapacityExceeded, companyId, o.WarehouseID, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rusystem/crm-warehouse/internal/auth"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/internal/service"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"github.com/rusystem/crm-warehouse/pkg/telegram"
+	"go.uber.org/zap"
+	"strconv"
+	"time"
+)
+
+// Заголовки сообщения в dead-letter subject
+const (
+	deadLetterReasonHeader  = "Dead-Letter-Reason"
+	deadLetterSubjectHeader = "Dead-Letter-Subject"
+)
+
+// PurchaseRequestConsumer - durable потребитель JetStream, создающий планируемые материалы по заявкам на закупку.
+// Сообщение подтверждается только после фиксации транзакции, непригодные к обработке сообщения
+// и сообщения, исчерпавшие MaxDeliver попыток, уходят в DeadLetterSubject.
+type PurchaseRequestConsumer struct {
+	nc      *nats.Conn
+	cfg     config.PurchaseRequests
+	service *service.Service
+	tg      service.TelegramSender
+	cc      jetstream.ConsumeContext
+}
+
+// NewPurchaseRequestConsumer tg - необязательный канал уведомлений о сообщениях, перенесенных в dead letter
+func NewPurchaseRequestConsumer(nc *nats.Conn, cfg config.PurchaseRequests, service *service.Service,
+	tg service.TelegramSender) *PurchaseRequestConsumer {
+	return &PurchaseRequestConsumer{
+		nc:      nc,
+		cfg:     cfg,
+		service: service,
+		tg:      tg,
+	}
+}
+
+// Start создает поток, если его еще нет, и durable потребителя на Subject
+func (pc *PurchaseRequestConsumer) Start(ctx context.Context) error {
+	js, err := jetstream.New(pc.nc)
+	if err != nil {
+		return err
+	}
+
+	if _, err = js.Stream(ctx, pc.cfg.Stream); errors.Is(err, jetstream.ErrStreamNotFound) {
+		_, err = js.CreateStream(ctx, jetstream.StreamConfig{
+			Name:     pc.cfg.Stream,
+			Subjects: []string{pc.cfg.Subject},
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get stream %s: %w", pc.cfg.Stream, err)
+	}
+
+	cons, err := js.CreateOrUpdateConsumer(ctx, pc.cfg.Stream, jetstream.ConsumerConfig{
+		Durable:       pc.cfg.Durable,
+		FilterSubject: pc.cfg.Subject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       pc.cfg.AckWait,
+		MaxDeliver:    pc.cfg.MaxDeliver,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create consumer %s: %w", pc.cfg.Durable, err)
+	}
+
+	if pc.cc, err = cons.Consume(pc.handle); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (pc *PurchaseRequestConsumer) Stop() {
+	if pc.cc != nil {
+		pc.cc.Stop()
+	}
+}
+
+func (pc *PurchaseRequestConsumer) handle(msg jetstream.Msg) {
+	meta, err := msg.Metadata()
+	if err != nil {
+		logger.Error("purchase requests: invalid message metadata", zap.Error(err))
+		pc.deadLetter(msg, err)
+		return
+	}
+
+	messageId := msg.Headers().Get(nats.MsgIdHdr)
+	if messageId == "" {
+		// без Nats-Msg-Id повторная доставка распознается по номеру сообщения в потоке
+		messageId = meta.Stream + ":" + strconv.FormatUint(meta.Sequence.Stream, 10)
+	}
+
+	var request domain.PurchaseRequest
+	if err = json.Unmarshal(msg.Data(), &request); err != nil {
+		pc.deadLetter(msg, fmt.Errorf("%w: invalid purchase request payload: %v", domain.ErrInvalidArgument, err))
+		return
+	}
+
+	// обработка ограничена AckWait, иначе сообщение будет доставлено повторно во время обработки
+	ctx, cancel := context.WithTimeout(context.Background(), pc.cfg.AckWait)
+	defer cancel()
+
+	ctx = auth.WithMethod(ctx, "nats:"+msg.Subject())
+
+	id, fresh, err := pc.service.PurchaseRequests.Import(ctx, pc.cfg.Durable, messageId, request)
+	switch {
+	case err == nil:
+		if fresh {
+			logger.Info("purchase requests: planning material created",
+				zap.String("request_id", request.RequestID), zap.Int64("material_id", id))
+		}
+
+		if err = msg.Ack(); err != nil {
+			logger.Warn("purchase requests: failed to ack", zap.String("message_id", messageId), zap.Error(err))
+		}
+	case permanent(err) || meta.NumDelivered >= uint64(pc.cfg.MaxDeliver):
+		pc.deadLetter(msg, err)
+	default:
+		logger.Warn("purchase requests: import failed, will retry",
+			zap.String("message_id", messageId), zap.Uint64("delivered", meta.NumDelivered), zap.Error(err))
+
+		if err = msg.NakWithDelay(pc.cfg.RetryDelay); err != nil {
+			logger.Warn("purchase requests: failed to nak", zap.String("message_id", messageId), zap.Error(err))
+		}
+	}
+}
+
+// deadLetter публикует сообщение с исходными заголовками в DeadLetterSubject и завершает его доставку.
+// Если опубликовать не удалось, сообщение остается в потоке и будет доставлено повторно.
+func (pc *PurchaseRequestConsumer) deadLetter(msg jetstream.Msg, reason error) {
+	dlq := nats.NewMsg(pc.cfg.DeadLetterSubject)
+	for k, v := range msg.Headers() {
+		dlq.Header[k] = v
+	}
+	dlq.Header.Set(deadLetterReasonHeader, reason.Error())
+	dlq.Header.Set(deadLetterSubjectHeader, msg.Subject())
+	dlq.Data = msg.Data()
+
+	if err := pc.nc.PublishMsg(dlq); err != nil {
+		logger.Error("purchase requests: failed to publish dead letter", zap.Error(err))
+
+		if err = msg.NakWithDelay(pc.cfg.RetryDelay); err != nil {
+			logger.Warn("purchase requests: failed to nak", zap.Error(err))
+		}
+		return
+	}
+
+	logger.Warn("purchase requests: message moved to dead letter subject",
+		zap.String("subject", pc.cfg.DeadLetterSubject), zap.Error(reason))
+
+	if pc.tg != nil {
+		var deliveries uint64
+		if meta, err := msg.Metadata(); err == nil {
+			deliveries = meta.NumDelivered
+		}
+
+		// отброшенное при заполненной очереди сообщение уже записано в лог, сообщение перенесено в dead letter в любом случае
+		_ = pc.tg.Send(telegram.Message{
+			Type:     telegram.TypeDeadLetter,
+			Datetime: time.Now().Format(time.DateTime),
+			Data: telegram.DeadLetter{
+				Subject:    msg.Subject(),
+				MessageID:  msg.Headers().Get(nats.MsgIdHdr),
+				Reason:     reason.Error(),
+				Deliveries: deliveries,
+			},
+		})
+	}
+
+	if err := msg.TermWithReason(reason.Error()); err != nil {
+		logger.Warn("purchase requests: failed to terminate message", zap.Error(err))
+	}
+}
+
+// permanent - ошибки данных заявки, повторная доставка их не исправит
+func permanent(err error) bool {
+	return errors.Is(err, domain.ErrInvalidArgument) || errors.Is(err, domain.ErrAlreadyExists) ||
+		errors.Is(err, domain.ErrReferenced)
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/service"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/audit"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuditHandler struct {
+	service *service.Service
+}
+
+func NewAuditHandler(service *service.Service) *AuditHandler {
+	return &AuditHandler{
+		service: service,
+	}
+}
+
+func (ah *AuditHandler) GetList(ctx context.Context, req *audit.AuditParams) (*audit.AuditList, error) {
+	if req.Limit <= 0 {
+		return nil, invalidArgument("audit, grpc handler - invalid limit")
+	}
+
+	if req.Offset < 0 {
+		return nil, invalidArgument("audit, grpc handler - invalid offset")
+	}
+
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("audit, grpc handler - invalid company id")
+	}
+
+	params := domain.AuditParams{
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+		CompanyId:  req.CompanyId,
+		ActorId:    req.ActorId,
+		EntityType: req.EntityType,
+		EntityId:   req.EntityId,
+		Method:     req.Method,
+		Action:     req.Action,
+	}
+
+	if req.From != nil {
+		params.From = req.From.AsTime()
+	}
+
+	if req.To != nil {
+		params.To = req.To.AsTime()
+	}
+
+	entries, err := ah.service.Audit.GetList(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*audit.AuditEntry, 0, len(entries))
+	for _, e := range entries {
+		changes := make(map[string]*audit.Change, len(e.Changes))
+		for field, c := range e.Changes {
+			changes[field] = &audit.Change{Before: string(c.Before), After: string(c.After)}
+		}
+
+		resp = append(resp, &audit.AuditEntry{
+			Id:         e.ID,
+			CompanyId:  e.CompanyID,
+			ActorId:    e.ActorID,
+			Method:     e.Method,
+			EntityType: e.EntityType,
+			EntityId:   e.EntityID,
+			Action:     e.Action,
+			Changes:    changes,
+			CreatedAt:  timestamppb.New(e.CreatedAt),
+		})
+	}
+
+	return &audit.AuditList{Entries: resp}, nil
+}
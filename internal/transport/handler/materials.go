@@ -3,12 +3,14 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"errors"
+	"fmt"
 	"github.com/rusystem/crm-warehouse/internal/service"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
 	"github.com/rusystem/crm-warehouse/pkg/gen/proto/materials"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"time"
 )
 
 type MaterialsHandler struct {
@@ -22,8 +24,8 @@ func NewMaterialsHandler(service *service.Service) *MaterialsHandler {
 }
 
 func (mh *MaterialsHandler) CreatePlanning(ctx context.Context, material *materials.Material) (*materials.MaterialId, error) {
-	var otherFields map[string]interface{}
-	if err := json.Unmarshal([]byte(material.OtherFields), &otherFields); err != nil {
+	otherFields, err := parseOtherFields(material.OtherFields)
+	if err != nil {
 		return nil, err
 	}
 
@@ -41,36 +43,38 @@ func (mh *MaterialsHandler) CreatePlanning(ctx context.Context, material *materi
 		TotalWithoutVAT:        material.TotalWithoutVat,
 		SupplierID:             material.SupplierId,
 		Location:               material.Location,
-		Contract:               material.Contract.AsTime(),
+		Contract:               asTime(material.Contract),
 		File:                   material.File,
 		Status:                 material.Status,
 		Comments:               material.Comments,
 		Reserve:                material.Reserve,
-		ReceivedDate:           material.ReceivedDate.AsTime(),
-		LastUpdated:            material.LastUpdated.AsTime(),
+		ReceivedDate:           asTime(material.ReceivedDate),
+		LastUpdated:            asTime(material.LastUpdated),
 		MinStockLevel:          material.MinStockLevel,
-		ExpirationDate:         material.ExpirationDate.AsTime(),
+		ExpirationDate:         asTime(material.ExpirationDate),
 		ResponsiblePerson:      material.ResponsiblePerson,
 		StorageCost:            material.StorageCost,
 		WarehouseSection:       material.WarehouseSection,
 		IncomingDeliveryNumber: material.IncomingDeliveryNumber,
 		OtherFields:            otherFields,
 		CompanyID:              material.CompanyId,
+		BinID:                  material.BinId,
+		CategoryID:             material.CategoryId,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &materials.MaterialId{Id: id}, nil
+	return &materials.MaterialId{Id: id, CompanyId: material.CompanyId}, nil
 }
 
 func (mh *MaterialsHandler) UpdatePlanning(ctx context.Context, material *materials.Material) (*emptypb.Empty, error) {
-	var otherFields map[string]interface{}
-	if err := json.Unmarshal([]byte(material.OtherFields), &otherFields); err != nil {
+	otherFields, err := parseOtherFields(material.OtherFields)
+	if err != nil {
 		return nil, err
 	}
 
-	err := mh.service.Material.UpdatePlanning(ctx, domain.Material{
+	err = mh.service.Material.UpdatePlanning(ctx, domain.Material{
 		ID:                     material.Id,
 		WarehouseID:            material.WarehouseId,
 		ItemID:                 material.ItemId,
@@ -85,20 +89,23 @@ func (mh *MaterialsHandler) UpdatePlanning(ctx context.Context, material *materi
 		TotalWithoutVAT:        material.TotalWithoutVat,
 		SupplierID:             material.SupplierId,
 		Location:               material.Location,
-		Contract:               material.Contract.AsTime(),
+		Contract:               asTime(material.Contract),
 		File:                   material.File,
 		Status:                 material.Status,
 		Comments:               material.Comments,
 		Reserve:                material.Reserve,
-		ReceivedDate:           material.ReceivedDate.AsTime(),
-		LastUpdated:            material.LastUpdated.AsTime(),
+		ReceivedDate:           asTime(material.ReceivedDate),
+		LastUpdated:            asTime(material.LastUpdated),
 		MinStockLevel:          material.MinStockLevel,
-		ExpirationDate:         material.ExpirationDate.AsTime(),
+		ExpirationDate:         asTime(material.ExpirationDate),
 		ResponsiblePerson:      material.ResponsiblePerson,
 		StorageCost:            material.StorageCost,
 		WarehouseSection:       material.WarehouseSection,
 		IncomingDeliveryNumber: material.IncomingDeliveryNumber,
 		OtherFields:            otherFields,
+		CompanyID:              material.CompanyId,
+		BinID:                  material.BinId,
+		CategoryID:             material.CategoryId,
 	})
 	if err != nil {
 		return nil, err
@@ -108,7 +115,11 @@ func (mh *MaterialsHandler) UpdatePlanning(ctx context.Context, material *materi
 }
 
 func (mh *MaterialsHandler) DeletePlanning(ctx context.Context, req *materials.MaterialId) (*emptypb.Empty, error) {
-	if err := mh.service.Material.DeletePlanning(ctx, req.Id); err != nil {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	if err := mh.service.Material.DeletePlanning(ctx, req.Id, req.CompanyId); err != nil {
 		return nil, err
 	}
 
@@ -116,7 +127,11 @@ func (mh *MaterialsHandler) DeletePlanning(ctx context.Context, req *materials.M
 }
 
 func (mh *MaterialsHandler) GetPlanning(ctx context.Context, req *materials.MaterialId) (*materials.Material, error) {
-	material, err := mh.service.Material.GetPlanningById(ctx, req.Id)
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	material, err := mh.service.Material.GetPlanningById(ctx, req.Id, req.CompanyId)
 	if err != nil {
 		return nil, err
 	}
@@ -156,34 +171,32 @@ func (mh *MaterialsHandler) GetPlanning(ctx context.Context, req *materials.Mate
 		IncomingDeliveryNumber: material.IncomingDeliveryNumber,
 		OtherFields:            string(otherFieldsJSON),
 		CompanyId:              material.CompanyID,
+		BinId:                  material.BinID,
+		CategoryId:             material.CategoryID,
 	}, nil
 }
 
 func (mh *MaterialsHandler) GetListPlanning(ctx context.Context, req *materials.MaterialParams) (*materials.MaterialList, error) {
 	if req.Limit <= 0 {
-		return nil, errors.New("materials, grpc handler - invalid limit")
+		return nil, invalidArgument("materials, grpc handler - invalid limit")
 	}
 
 	if req.Offset < 0 {
-		return nil, errors.New("materials, grpc handler - invalid offset")
+		return nil, invalidArgument("materials, grpc handler - invalid offset")
 	}
 
 	if req.CompanyId <= 0 {
-		return nil, errors.New("materials, grpc handler - invalid company id")
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
 	}
 
-	mtrls, err := mh.service.Material.GetPlanningList(ctx, domain.MaterialParams{
-		Limit:     req.Limit,
-		Offset:    req.Offset,
-		CompanyId: req.CompanyId,
-	})
+	page, err := mh.service.Material.GetPlanningList(ctx, materialParams(req))
 	if err != nil {
 		return nil, err
 	}
 
-	resp := make([]*materials.Material, 0, len(mtrls))
+	resp := make([]*materials.Material, 0, len(page.Materials))
 
-	for _, mtrl := range mtrls {
+	for _, mtrl := range page.Materials {
 		otherFieldsJSON, err := json.Marshal(mtrl.OtherFields)
 		if err != nil {
 			return nil, err
@@ -219,26 +232,34 @@ func (mh *MaterialsHandler) GetListPlanning(ctx context.Context, req *materials.
 			IncomingDeliveryNumber: mtrl.IncomingDeliveryNumber,
 			OtherFields:            string(otherFieldsJSON),
 			CompanyId:              mtrl.CompanyID,
+			BinId:                  mtrl.BinID,
+			CategoryId:             mtrl.CategoryID,
 		})
 	}
 
 	return &materials.MaterialList{
-		Materials: resp,
+		Materials:  resp,
+		NextCursor: page.NextCursor,
+		Total:      page.Total,
 	}, nil
 }
 
 func (mh *MaterialsHandler) MovePlanningToPurchased(ctx context.Context, req *materials.MaterialId) (*materials.MaterialId, error) {
-	id, itemId, err := mh.service.Material.MovePlanningToPurchased(ctx, req.Id)
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	id, itemId, err := mh.service.Material.MovePlanningToPurchased(ctx, req.Id, req.CompanyId)
 	if err != nil {
 		return nil, err
 	}
 
-	return &materials.MaterialId{Id: id, ItemId: itemId}, nil
+	return &materials.MaterialId{Id: id, ItemId: itemId, CompanyId: req.CompanyId}, nil
 }
 
 func (mh *MaterialsHandler) CreatePurchased(ctx context.Context, material *materials.Material) (*materials.MaterialId, error) {
-	var otherFields map[string]interface{}
-	if err := json.Unmarshal([]byte(material.OtherFields), &otherFields); err != nil {
+	otherFields, err := parseOtherFields(material.OtherFields)
+	if err != nil {
 		return nil, err
 	}
 
@@ -256,36 +277,38 @@ func (mh *MaterialsHandler) CreatePurchased(ctx context.Context, material *mater
 		TotalWithoutVAT:        material.TotalWithoutVat,
 		SupplierID:             material.SupplierId,
 		Location:               material.Location,
-		Contract:               material.Contract.AsTime(),
+		Contract:               asTime(material.Contract),
 		File:                   material.File,
 		Status:                 material.Status,
 		Comments:               material.Comments,
 		Reserve:                material.Reserve,
-		ReceivedDate:           material.ReceivedDate.AsTime(),
-		LastUpdated:            material.LastUpdated.AsTime(),
+		ReceivedDate:           asTime(material.ReceivedDate),
+		LastUpdated:            asTime(material.LastUpdated),
 		MinStockLevel:          material.MinStockLevel,
-		ExpirationDate:         material.ExpirationDate.AsTime(),
+		ExpirationDate:         asTime(material.ExpirationDate),
 		ResponsiblePerson:      material.ResponsiblePerson,
 		StorageCost:            material.StorageCost,
 		WarehouseSection:       material.WarehouseSection,
 		IncomingDeliveryNumber: material.IncomingDeliveryNumber,
 		OtherFields:            otherFields,
 		CompanyID:              material.CompanyId,
+		BinID:                  material.BinId,
+		CategoryID:             material.CategoryId,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &materials.MaterialId{Id: id, ItemId: itemID}, nil
+	return &materials.MaterialId{Id: id, ItemId: itemID, CompanyId: material.CompanyId}, nil
 }
 
 func (mh *MaterialsHandler) UpdatePurchased(ctx context.Context, material *materials.Material) (*emptypb.Empty, error) {
-	var otherFields map[string]interface{}
-	if err := json.Unmarshal([]byte(material.OtherFields), &otherFields); err != nil {
+	otherFields, err := parseOtherFields(material.OtherFields)
+	if err != nil {
 		return nil, err
 	}
 
-	err := mh.service.Material.UpdatePurchased(ctx, domain.Material{
+	err = mh.service.Material.UpdatePurchased(ctx, domain.Material{
 		ID:                     material.Id,
 		WarehouseID:            material.WarehouseId,
 		ItemID:                 material.ItemId,
@@ -300,20 +323,23 @@ func (mh *MaterialsHandler) UpdatePurchased(ctx context.Context, material *mater
 		TotalWithoutVAT:        material.TotalWithoutVat,
 		SupplierID:             material.SupplierId,
 		Location:               material.Location,
-		Contract:               material.Contract.AsTime(),
+		Contract:               asTime(material.Contract),
 		File:                   material.File,
 		Status:                 material.Status,
 		Comments:               material.Comments,
 		Reserve:                material.Reserve,
-		ReceivedDate:           material.ReceivedDate.AsTime(),
-		LastUpdated:            material.LastUpdated.AsTime(),
+		ReceivedDate:           asTime(material.ReceivedDate),
+		LastUpdated:            asTime(material.LastUpdated),
 		MinStockLevel:          material.MinStockLevel,
-		ExpirationDate:         material.ExpirationDate.AsTime(),
+		ExpirationDate:         asTime(material.ExpirationDate),
 		ResponsiblePerson:      material.ResponsiblePerson,
 		StorageCost:            material.StorageCost,
 		WarehouseSection:       material.WarehouseSection,
 		IncomingDeliveryNumber: material.IncomingDeliveryNumber,
 		OtherFields:            otherFields,
+		CompanyID:              material.CompanyId,
+		BinID:                  material.BinId,
+		CategoryID:             material.CategoryId,
 	})
 	if err != nil {
 		return nil, err
@@ -323,7 +349,11 @@ func (mh *MaterialsHandler) UpdatePurchased(ctx context.Context, material *mater
 }
 
 func (mh *MaterialsHandler) DeletePurchased(ctx context.Context, req *materials.MaterialId) (*emptypb.Empty, error) {
-	if err := mh.service.Material.DeletePurchased(ctx, req.Id); err != nil {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	if err := mh.service.Material.DeletePurchased(ctx, req.Id, req.CompanyId); err != nil {
 		return nil, err
 	}
 
@@ -331,7 +361,11 @@ func (mh *MaterialsHandler) DeletePurchased(ctx context.Context, req *materials.
 }
 
 func (mh *MaterialsHandler) GetPurchased(ctx context.Context, req *materials.MaterialId) (*materials.Material, error) {
-	material, err := mh.service.Material.GetPurchasedById(ctx, req.Id)
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	material, err := mh.service.Material.GetPurchasedById(ctx, req.Id, req.CompanyId)
 	if err != nil {
 		return nil, err
 	}
@@ -371,34 +405,32 @@ func (mh *MaterialsHandler) GetPurchased(ctx context.Context, req *materials.Mat
 		IncomingDeliveryNumber: material.IncomingDeliveryNumber,
 		OtherFields:            string(otherFieldsJSON),
 		CompanyId:              material.CompanyID,
+		BinId:                  material.BinID,
+		CategoryId:             material.CategoryID,
 	}, nil
 }
 
 func (mh *MaterialsHandler) GetListPurchased(ctx context.Context, req *materials.MaterialParams) (*materials.MaterialList, error) {
 	if req.Limit <= 0 {
-		return nil, errors.New("materials, grpc handler - invalid limit")
+		return nil, invalidArgument("materials, grpc handler - invalid limit")
 	}
 
 	if req.Offset < 0 {
-		return nil, errors.New("materials, grpc handler - invalid offset")
+		return nil, invalidArgument("materials, grpc handler - invalid offset")
 	}
 
 	if req.CompanyId <= 0 {
-		return nil, errors.New("materials, grpc handler - invalid company id")
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
 	}
 
-	mtrls, err := mh.service.Material.GetPurchasedList(ctx, domain.MaterialParams{
-		Limit:     req.Limit,
-		Offset:    req.Offset,
-		CompanyId: req.CompanyId,
-	})
+	page, err := mh.service.Material.GetPurchasedList(ctx, materialParams(req))
 	if err != nil {
 		return nil, err
 	}
 
-	resp := make([]*materials.Material, 0, len(mtrls))
+	resp := make([]*materials.Material, 0, len(page.Materials))
 
-	for _, mtrl := range mtrls {
+	for _, mtrl := range page.Materials {
 		otherFieldsJSON, err := json.Marshal(mtrl.OtherFields)
 		if err != nil {
 			return nil, err
@@ -434,16 +466,24 @@ func (mh *MaterialsHandler) GetListPurchased(ctx context.Context, req *materials
 			IncomingDeliveryNumber: mtrl.IncomingDeliveryNumber,
 			OtherFields:            string(otherFieldsJSON),
 			CompanyId:              mtrl.CompanyID,
+			BinId:                  mtrl.BinID,
+			CategoryId:             mtrl.CategoryID,
 		})
 	}
 
 	return &materials.MaterialList{
-		Materials: resp,
+		Materials:  resp,
+		NextCursor: page.NextCursor,
+		Total:      page.Total,
 	}, nil
 }
 
 func (mh *MaterialsHandler) MovePurchasedToArchive(ctx context.Context, req *materials.MaterialId) (*emptypb.Empty, error) {
-	if err := mh.service.Material.MovePurchasedToArchive(ctx, req.Id); err != nil {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	if err := mh.service.Material.MovePurchasedToArchive(ctx, req.Id, req.CompanyId); err != nil {
 		return nil, err
 	}
 
@@ -451,7 +491,11 @@ func (mh *MaterialsHandler) MovePurchasedToArchive(ctx context.Context, req *mat
 }
 
 func (mh *MaterialsHandler) GetPlanningArchive(ctx context.Context, req *materials.MaterialId) (*materials.Material, error) {
-	material, err := mh.service.Material.GetPlanningArchiveById(ctx, req.Id)
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	material, err := mh.service.Material.GetPlanningArchiveById(ctx, req.Id, req.CompanyId)
 	if err != nil {
 		return nil, err
 	}
@@ -491,11 +535,17 @@ func (mh *MaterialsHandler) GetPlanningArchive(ctx context.Context, req *materia
 		IncomingDeliveryNumber: material.IncomingDeliveryNumber,
 		OtherFields:            string(otherFieldsJSON),
 		CompanyId:              material.CompanyID,
+		BinId:                  material.BinID,
+		CategoryId:             material.CategoryID,
 	}, nil
 }
 
 func (mh *MaterialsHandler) GetPurchasedArchive(ctx context.Context, req *materials.MaterialId) (*materials.Material, error) {
-	material, err := mh.service.Material.GetPurchasedArchiveById(ctx, req.Id)
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	material, err := mh.service.Material.GetPurchasedArchiveById(ctx, req.Id, req.CompanyId)
 	if err != nil {
 		return nil, err
 	}
@@ -535,34 +585,32 @@ func (mh *MaterialsHandler) GetPurchasedArchive(ctx context.Context, req *materi
 		IncomingDeliveryNumber: material.IncomingDeliveryNumber,
 		OtherFields:            string(otherFieldsJSON),
 		CompanyId:              material.CompanyID,
+		BinId:                  material.BinID,
+		CategoryId:             material.CategoryID,
 	}, nil
 }
 
 func (mh *MaterialsHandler) GetListPlanningArchive(ctx context.Context, req *materials.MaterialParams) (*materials.MaterialList, error) {
 	if req.Limit <= 0 {
-		return nil, errors.New("materials, grpc handler - invalid limit")
+		return nil, invalidArgument("materials, grpc handler - invalid limit")
 	}
 
 	if req.Offset < 0 {
-		return nil, errors.New("materials, grpc handler - invalid offset")
+		return nil, invalidArgument("materials, grpc handler - invalid offset")
 	}
 
 	if req.CompanyId <= 0 {
-		return nil, errors.New("materials, grpc handler - invalid company id")
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
 	}
 
-	mtrls, err := mh.service.Material.GetPlanningArchiveList(ctx, domain.MaterialParams{
-		Limit:     req.Limit,
-		Offset:    req.Offset,
-		CompanyId: req.CompanyId,
-	})
+	page, err := mh.service.Material.GetPlanningArchiveList(ctx, materialParams(req))
 	if err != nil {
 		return nil, err
 	}
 
-	resp := make([]*materials.Material, 0, len(mtrls))
+	resp := make([]*materials.Material, 0, len(page.Materials))
 
-	for _, mtrl := range mtrls {
+	for _, mtrl := range page.Materials {
 		otherFieldsJSON, err := json.Marshal(mtrl.OtherFields)
 		if err != nil {
 			return nil, err
@@ -598,39 +646,39 @@ func (mh *MaterialsHandler) GetListPlanningArchive(ctx context.Context, req *mat
 			IncomingDeliveryNumber: mtrl.IncomingDeliveryNumber,
 			OtherFields:            string(otherFieldsJSON),
 			CompanyId:              mtrl.CompanyID,
+			BinId:                  mtrl.BinID,
+			CategoryId:             mtrl.CategoryID,
 		})
 	}
 
 	return &materials.MaterialList{
-		Materials: resp,
+		Materials:  resp,
+		NextCursor: page.NextCursor,
+		Total:      page.Total,
 	}, nil
 }
 
 func (mh *MaterialsHandler) GetListPurchasedArchive(ctx context.Context, req *materials.MaterialParams) (*materials.MaterialList, error) {
 	if req.Limit <= 0 {
-		return nil, errors.New("materials, grpc handler - invalid limit")
+		return nil, invalidArgument("materials, grpc handler - invalid limit")
 	}
 
 	if req.Offset < 0 {
-		return nil, errors.New("materials, grpc handler - invalid offset")
+		return nil, invalidArgument("materials, grpc handler - invalid offset")
 	}
 
 	if req.CompanyId <= 0 {
-		return nil, errors.New("materials, grpc handler - invalid company id")
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
 	}
 
-	mtrls, err := mh.service.Material.GetPurchasedArchiveList(ctx, domain.MaterialParams{
-		Limit:     req.Limit,
-		Offset:    req.Offset,
-		CompanyId: req.CompanyId,
-	})
+	page, err := mh.service.Material.GetPurchasedArchiveList(ctx, materialParams(req))
 	if err != nil {
 		return nil, err
 	}
 
-	resp := make([]*materials.Material, 0, len(mtrls))
+	resp := make([]*materials.Material, 0, len(page.Materials))
 
-	for _, mtrl := range mtrls {
+	for _, mtrl := range page.Materials {
 		otherFieldsJSON, err := json.Marshal(mtrl.OtherFields)
 		if err != nil {
 			return nil, err
@@ -666,16 +714,24 @@ func (mh *MaterialsHandler) GetListPurchasedArchive(ctx context.Context, req *ma
 			IncomingDeliveryNumber: mtrl.IncomingDeliveryNumber,
 			OtherFields:            string(otherFieldsJSON),
 			CompanyId:              mtrl.CompanyID,
+			BinId:                  mtrl.BinID,
+			CategoryId:             mtrl.CategoryID,
 		})
 	}
 
 	return &materials.MaterialList{
-		Materials: resp,
+		Materials:  resp,
+		NextCursor: page.NextCursor,
+		Total:      page.Total,
 	}, nil
 }
 
 func (mh *MaterialsHandler) DeletePlanningArchive(ctx context.Context, req *materials.MaterialId) (*emptypb.Empty, error) {
-	if err := mh.service.Material.DeletePlanningArchive(ctx, req.Id); err != nil {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	if err := mh.service.Material.DeletePlanningArchive(ctx, req.Id, req.CompanyId); err != nil {
 		return nil, err
 	}
 
@@ -683,7 +739,11 @@ func (mh *MaterialsHandler) DeletePlanningArchive(ctx context.Context, req *mate
 }
 
 func (mh *MaterialsHandler) DeletePurchasedArchive(ctx context.Context, req *materials.MaterialId) (*emptypb.Empty, error) {
-	if err := mh.service.Material.DeletePurchasedArchive(ctx, req.Id); err != nil {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	if err := mh.service.Material.DeletePurchasedArchive(ctx, req.Id, req.CompanyId); err != nil {
 		return nil, err
 	}
 
@@ -692,15 +752,15 @@ func (mh *MaterialsHandler) DeletePurchasedArchive(ctx context.Context, req *mat
 
 func (mh *MaterialsHandler) SearchMaterial(ctx context.Context, req *materials.MaterialParams) (*materials.MaterialList, error) {
 	if req.Limit <= 0 {
-		return nil, errors.New("materials, grpc handler - invalid limit")
+		return nil, invalidArgument("materials, grpc handler - invalid limit")
 	}
 
 	if req.Offset < 0 {
-		return nil, errors.New("materials, grpc handler - invalid offset")
+		return nil, invalidArgument("materials, grpc handler - invalid offset")
 	}
 
 	if req.CompanyId <= 0 {
-		return nil, errors.New("materials, grpc handler - invalid company id")
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
 	}
 
 	mtrls, err := mh.service.Material.Search(ctx, domain.Param{
@@ -751,6 +811,8 @@ func (mh *MaterialsHandler) SearchMaterial(ctx context.Context, req *materials.M
 			IncomingDeliveryNumber: mtrl.IncomingDeliveryNumber,
 			OtherFields:            string(otherFieldsJSON),
 			CompanyId:              mtrl.CompanyID,
+			BinId:                  mtrl.BinID,
+			CategoryId:             mtrl.CategoryID,
 		})
 	}
 
@@ -765,20 +827,21 @@ func (mh *MaterialsHandler) CreateMaterialCategory(ctx context.Context, category
 		CompanyID:   category.CompanyId,
 		Description: category.Description,
 		Slug:        category.Slug,
-		CreatedAt:   category.CreatedAt.AsTime(),
-		UpdatedAt:   category.UpdatedAt.AsTime(),
+		CreatedAt:   asTime(category.CreatedAt),
+		UpdatedAt:   asTime(category.UpdatedAt),
 		IsActive:    category.IsActive,
 		ImgURL:      category.ImgUrl,
+		ParentID:    category.ParentId,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &materials.MaterialCategoryId{Id: id}, nil
+	return &materials.MaterialCategoryId{Id: id, CompanyId: category.CompanyId}, nil
 }
 
 func (mh *MaterialsHandler) GetByIdMaterialCategory(ctx context.Context, req *materials.MaterialCategoryId) (*materials.MaterialCategory, error) {
-	category, err := mh.service.Category.GetById(ctx, req.Id, req.CompanyId)
+	category, err := mh.service.Category.GetById(ctx, req.Id, req.CompanyId, req.IncludeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -793,6 +856,10 @@ func (mh *MaterialsHandler) GetByIdMaterialCategory(ctx context.Context, req *ma
 		UpdatedAt:   timestamppb.New(category.UpdatedAt),
 		IsActive:    category.IsActive,
 		ImgUrl:      category.ImgURL,
+		DeletedAt:   optionalTimestamp(category.DeletedAt),
+		ParentId:    category.ParentID,
+		Path:        category.Path,
+		Depth:       category.Depth,
 	}, nil
 }
 
@@ -803,8 +870,8 @@ func (mh *MaterialsHandler) UpdateMaterialCategory(ctx context.Context, category
 		CompanyID:   category.CompanyId,
 		Description: category.Description,
 		Slug:        category.Slug,
-		CreatedAt:   category.CreatedAt.AsTime(),
-		UpdatedAt:   category.UpdatedAt.AsTime(),
+		CreatedAt:   asTime(category.CreatedAt),
+		UpdatedAt:   asTime(category.UpdatedAt),
 		IsActive:    category.IsActive,
 		ImgURL:      category.ImgUrl,
 	}); err != nil {
@@ -822,24 +889,64 @@ func (mh *MaterialsHandler) DeleteMaterialCategory(ctx context.Context, req *mat
 	return &emptypb.Empty{}, nil
 }
 
+func (mh *MaterialsHandler) RestoreMaterialCategory(ctx context.Context, req *materials.MaterialCategoryId) (*emptypb.Empty, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("material categories, grpc handler - invalid company id")
+	}
+
+	if err := mh.service.Category.Restore(ctx, req.Id, req.CompanyId); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// MoveMaterialCategory переносит категорию вместе с вложенными под другого родителя
+func (mh *MaterialsHandler) MoveMaterialCategory(ctx context.Context, req *materials.MoveCategoryRequest) (*emptypb.Empty, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("material categories, grpc handler - invalid company id")
+	}
+
+	if err := mh.service.Category.Move(ctx, req.Id, req.CompanyId, req.ParentId); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ReparentMaterialCategories переносит вложенные категории одной категории под другого родителя
+func (mh *MaterialsHandler) ReparentMaterialCategories(ctx context.Context, req *materials.ReparentCategoriesRequest) (*materials.ReparentCategoriesResult, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("material categories, grpc handler - invalid company id")
+	}
+
+	count, err := mh.service.Category.Reparent(ctx, req.FromId, req.CompanyId, req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &materials.ReparentCategoriesResult{Count: count}, nil
+}
+
 func (mh *MaterialsHandler) GetListMaterialCategory(ctx context.Context, req *materials.MaterialParams) (*materials.MaterialCategoryList, error) {
 	if req.Limit <= 0 {
-		return nil, errors.New("material categories, grpc handler - invalid limit")
+		return nil, invalidArgument("material categories, grpc handler - invalid limit")
 	}
 
 	if req.Offset < 0 {
-		return nil, errors.New("material categories, grpc handler - invalid offset")
+		return nil, invalidArgument("material categories, grpc handler - invalid offset")
 	}
 
 	if req.CompanyId <= 0 {
-		return nil, errors.New("material categories, grpc handler - invalid company id")
+		return nil, invalidArgument("material categories, grpc handler - invalid company id")
 	}
 
 	categories, err := mh.service.Category.List(ctx, domain.Param{
-		Limit:     req.Limit,
-		Offset:    req.Offset,
-		CompanyId: req.CompanyId,
-		Query:     req.Query,
+		Limit:          req.Limit,
+		Offset:         req.Offset,
+		CompanyId:      req.CompanyId,
+		Query:          req.Query,
+		IncludeDeleted: req.IncludeDeleted,
 	})
 	if err != nil {
 		return nil, err
@@ -858,6 +965,10 @@ func (mh *MaterialsHandler) GetListMaterialCategory(ctx context.Context, req *ma
 			UpdatedAt:   timestamppb.New(c.UpdatedAt),
 			IsActive:    c.IsActive,
 			ImgUrl:      c.ImgURL,
+			DeletedAt:   optionalTimestamp(c.DeletedAt),
+			ParentId:    c.ParentID,
+			Path:        c.Path,
+			Depth:       c.Depth,
 		})
 	}
 
@@ -868,22 +979,23 @@ func (mh *MaterialsHandler) GetListMaterialCategory(ctx context.Context, req *ma
 
 func (mh *MaterialsHandler) SearchMaterialCategory(ctx context.Context, req *materials.MaterialParams) (*materials.MaterialCategoryList, error) {
 	if req.Limit <= 0 {
-		return nil, errors.New("material categories, grpc handler - invalid limit")
+		return nil, invalidArgument("material categories, grpc handler - invalid limit")
 	}
 
 	if req.Offset < 0 {
-		return nil, errors.New("material categories, grpc handler - invalid offset")
+		return nil, invalidArgument("material categories, grpc handler - invalid offset")
 	}
 
 	if req.CompanyId <= 0 {
-		return nil, errors.New("material categories, grpc handler - invalid company id")
+		return nil, invalidArgument("material categories, grpc handler - invalid company id")
 	}
 
 	categories, err := mh.service.Category.Search(ctx, domain.Param{
-		Limit:     req.Limit,
-		Offset:    req.Offset,
-		CompanyId: req.CompanyId,
-		Query:     req.Query,
+		Limit:          req.Limit,
+		Offset:         req.Offset,
+		CompanyId:      req.CompanyId,
+		Query:          req.Query,
+		IncludeDeleted: req.IncludeDeleted,
 	})
 	if err != nil {
 		return nil, err
@@ -902,6 +1014,10 @@ func (mh *MaterialsHandler) SearchMaterialCategory(ctx context.Context, req *mat
 			UpdatedAt:   timestamppb.New(c.UpdatedAt),
 			IsActive:    c.IsActive,
 			ImgUrl:      c.ImgURL,
+			DeletedAt:   optionalTimestamp(c.DeletedAt),
+			ParentId:    c.ParentID,
+			Path:        c.Path,
+			Depth:       c.Depth,
 		})
 	}
 
@@ -909,3 +1025,292 @@ func (mh *MaterialsHandler) SearchMaterialCategory(ctx context.Context, req *mat
 		MaterialCategories: resp,
 	}, nil
 }
+
+func (mh *MaterialsHandler) GetExpiring(ctx context.Context, req *materials.ExpirationParams) (*materials.MaterialList, error) {
+	if req.Limit <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid limit")
+	}
+
+	if req.Offset < 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid offset")
+	}
+
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	if req.WarehouseId < 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid warehouse id")
+	}
+
+	mtrls, err := mh.service.Material.GetExpiring(ctx, domain.ExpirationParams{
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+		CompanyId:   req.CompanyId,
+		WarehouseId: req.WarehouseId,
+		Within:      time.Duration(req.WithinDays) * 24 * time.Hour,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*materials.Material, 0, len(mtrls))
+
+	for _, mtrl := range mtrls {
+		otherFieldsJSON, err := json.Marshal(mtrl.OtherFields)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, &materials.Material{
+			Id:                     mtrl.ID,
+			WarehouseId:            mtrl.WarehouseID,
+			ItemId:                 mtrl.ItemID,
+			Name:                   mtrl.Name,
+			ByInvoice:              mtrl.ByInvoice,
+			Article:                mtrl.Article,
+			ProductCategory:        mtrl.ProductCategory,
+			Unit:                   mtrl.Unit,
+			TotalQuantity:          mtrl.TotalQuantity,
+			Volume:                 mtrl.Volume,
+			PriceWithoutVat:        mtrl.PriceWithoutVAT,
+			TotalWithoutVat:        mtrl.TotalWithoutVAT,
+			SupplierId:             mtrl.SupplierID,
+			Location:               mtrl.Location,
+			Contract:               timestamppb.New(mtrl.Contract),
+			File:                   mtrl.File,
+			Status:                 mtrl.Status,
+			Comments:               mtrl.Comments,
+			Reserve:                mtrl.Reserve,
+			ReceivedDate:           timestamppb.New(mtrl.ReceivedDate),
+			LastUpdated:            timestamppb.New(mtrl.LastUpdated),
+			MinStockLevel:          mtrl.MinStockLevel,
+			ExpirationDate:         timestamppb.New(mtrl.ExpirationDate),
+			ResponsiblePerson:      mtrl.ResponsiblePerson,
+			StorageCost:            mtrl.StorageCost,
+			WarehouseSection:       mtrl.WarehouseSection,
+			IncomingDeliveryNumber: mtrl.IncomingDeliveryNumber,
+			OtherFields:            string(otherFieldsJSON),
+			CompanyId:              mtrl.CompanyID,
+			BinId:                  mtrl.BinID,
+			CategoryId:             mtrl.CategoryID,
+		})
+	}
+
+	return &materials.MaterialList{
+		Materials: resp,
+	}, nil
+}
+
+func (mh *MaterialsHandler) QuarantineExpired(ctx context.Context, req *materials.QuarantineRequest) (*materials.QuarantineResult, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	count, err := mh.service.Material.QuarantineExpired(ctx, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &materials.QuarantineResult{Count: count}, nil
+}
+
+func (mh *MaterialsHandler) SuggestFefo(ctx context.Context, req *materials.FefoRequest) (*materials.FefoSuggestion, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	if req.WarehouseId < 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid warehouse id")
+	}
+
+	suggestion, err := mh.service.Material.SuggestFefo(ctx, req.CompanyId, req.ItemId, req.WarehouseId, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	picks := make([]*materials.FefoPick, 0, len(suggestion.Picks))
+	for _, pick := range suggestion.Picks {
+		p := &materials.FefoPick{
+			MaterialId:  pick.MaterialID,
+			WarehouseId: pick.WarehouseID,
+			Quantity:    pick.Quantity,
+			Available:   pick.Available,
+		}
+
+		if !pick.ExpirationDate.IsZero() {
+			p.ExpirationDate = timestamppb.New(pick.ExpirationDate)
+		}
+
+		picks = append(picks, p)
+	}
+
+	return &materials.FefoSuggestion{
+		ItemId:    suggestion.ItemID,
+		Requested: suggestion.Requested,
+		Picks:     picks,
+		Shortage:  suggestion.Shortage,
+	}, nil
+}
+
+func (mh *MaterialsHandler) GetQRCode(ctx context.Context, req *materials.MaterialId) (*materials.QRCode, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	png, payload, err := mh.service.Labels.GetQRCode(ctx, req.Id, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &materials.QRCode{
+		Png:     png,
+		Payload: payload,
+	}, nil
+}
+
+func (mh *MaterialsHandler) GetLabels(ctx context.Context, req *materials.LabelsRequest) (*materials.LabelsDocument, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	pdf, err := mh.service.Labels.GetLabels(ctx, req.Ids, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &materials.LabelsDocument{Pdf: pdf}, nil
+}
+
+func (mh *MaterialsHandler) ScanQR(ctx context.Context, req *materials.ScanRequest) (*materials.Material, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	material, err := mh.service.Labels.Scan(ctx, req.Payload, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	otherFieldsJSON, err := json.Marshal(material.OtherFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &materials.Material{
+		Id:                     material.ID,
+		WarehouseId:            material.WarehouseID,
+		ItemId:                 material.ItemID,
+		Name:                   material.Name,
+		ByInvoice:              material.ByInvoice,
+		Article:                material.Article,
+		ProductCategory:        material.ProductCategory,
+		Unit:                   material.Unit,
+		TotalQuantity:          material.TotalQuantity,
+		Volume:                 material.Volume,
+		PriceWithoutVat:        material.PriceWithoutVAT,
+		TotalWithoutVat:        material.TotalWithoutVAT,
+		SupplierId:             material.SupplierID,
+		Location:               material.Location,
+		Contract:               timestamppb.New(material.Contract),
+		File:                   material.File,
+		Status:                 material.Status,
+		Comments:               material.Comments,
+		Reserve:                material.Reserve,
+		ReceivedDate:           timestamppb.New(material.ReceivedDate),
+		LastUpdated:            timestamppb.New(material.LastUpdated),
+		MinStockLevel:          material.MinStockLevel,
+		ExpirationDate:         timestamppb.New(material.ExpirationDate),
+		ResponsiblePerson:      material.ResponsiblePerson,
+		StorageCost:            material.StorageCost,
+		WarehouseSection:       material.WarehouseSection,
+		IncomingDeliveryNumber: material.IncomingDeliveryNumber,
+		OtherFields:            string(otherFieldsJSON),
+		CompanyId:              material.CompanyID,
+		BinId:                  material.BinID,
+		CategoryId:             material.CategoryID,
+	}, nil
+}
+
+func (mh *MaterialsHandler) PutAway(ctx context.Context, req *materials.PutAwayRequest) (*emptypb.Empty, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid company id")
+	}
+
+	if req.BinId <= 0 {
+		return nil, invalidArgument("materials, grpc handler - invalid bin id")
+	}
+
+	if err := mh.service.Material.PutAway(ctx, req.Id, req.CompanyId, req.BinId); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// invalidArgument - ошибка валидации запроса, отдается клиенту как InvalidArgument
+func invalidArgument(msg string) error {
+	return fmt.Errorf("%w: %s", domain.ErrInvalidArgument, msg)
+}
+
+// parseOtherFields разбирает JSON дополнительных полей, пустая строка - пустой набор полей
+func parseOtherFields(s string) (map[string]interface{}, error) {
+	otherFields := map[string]interface{}{}
+	if strings.TrimSpace(s) == "" {
+		return otherFields, nil
+	}
+
+	if err := json.Unmarshal([]byte(s), &otherFields); err != nil {
+		return nil, &domain.ValidationError{Violations: []domain.FieldViolation{
+			{Field: "other_fields", Description: "must be a JSON object"},
+		}}
+	}
+
+	return otherFields, nil
+}
+
+// materialParams переводит запрос списка материалов, CategoryId запроса учитывается, если категория
+// не задана в фильтре
+func materialParams(req *materials.MaterialParams) domain.MaterialParams {
+	params := domain.MaterialParams{
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+		CompanyId: req.CompanyId,
+		Cursor:    req.Cursor,
+	}
+
+	if f := req.Filter; f != nil {
+		params.Filter = domain.MaterialFilter{
+			WarehouseId:    f.WarehouseId,
+			SupplierId:     f.SupplierId,
+			CategoryId:     f.CategoryId,
+			Status:         f.Status,
+			ReceivedFrom:   asTime(f.ReceivedFrom),
+			ReceivedTo:     asTime(f.ReceivedTo),
+			ExpirationFrom: asTime(f.ExpirationFrom),
+			ExpirationTo:   asTime(f.ExpirationTo),
+			QuantityMin:    f.QuantityMin,
+			QuantityMax:    f.QuantityMax,
+			OtherFields:    f.OtherFields,
+		}
+	}
+
+	if params.Filter.CategoryId == 0 {
+		params.Filter.CategoryId = req.CategoryId
+	}
+
+	for _, sort := range req.Sort {
+		params.Sort = append(params.Sort, domain.MaterialSort{Field: sort.Field, Desc: sort.Desc})
+	}
+
+	return params
+}
+
+// asTime возвращает нулевое время для незаполненной даты вместо 1970-01-01
+func asTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
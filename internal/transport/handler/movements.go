@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/service"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/movements"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type MovementsHandler struct {
+	service *service.Service
+}
+
+func NewMovementsHandler(service *service.Service) *MovementsHandler {
+	return &MovementsHandler{
+		service: service,
+	}
+}
+
+func (mh *MovementsHandler) Create(ctx context.Context, req *movements.MovementRequest) (*movements.MovementList, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("movements, grpc handler - invalid company id")
+	}
+
+	mvs, err := mh.service.Movement.Create(ctx, domain.MovementRequest{
+		CompanyID:     req.CompanyId,
+		MaterialID:    req.MaterialId,
+		Type:          req.Type,
+		Quantity:      req.Quantity,
+		ToWarehouseID: req.ToWarehouseId,
+		Reference:     req.Reference,
+		Comment:       req.Comment,
+		CreatedBy:     req.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*movements.Movement, 0, len(mvs))
+	for _, m := range mvs {
+		resp = append(resp, toMovementProto(m))
+	}
+
+	return &movements.MovementList{Movements: resp}, nil
+}
+
+func (mh *MovementsHandler) GetById(ctx context.Context, req *movements.MovementId) (*movements.Movement, error) {
+	m, err := mh.service.Movement.GetById(ctx, req.Id, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMovementProto(m), nil
+}
+
+func (mh *MovementsHandler) GetList(ctx context.Context, req *movements.MovementParams) (*movements.MovementList, error) {
+	if req.Limit <= 0 {
+		return nil, invalidArgument("movements, grpc handler - invalid limit")
+	}
+
+	if req.Offset < 0 {
+		return nil, invalidArgument("movements, grpc handler - invalid offset")
+	}
+
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("movements, grpc handler - invalid company id")
+	}
+
+	mvs, err := mh.service.Movement.GetList(ctx, domain.MovementParams{
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+		CompanyId:   req.CompanyId,
+		MaterialId:  req.MaterialId,
+		ItemId:      req.ItemId,
+		WarehouseId: req.WarehouseId,
+		Type:        req.Type,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*movements.Movement, 0, len(mvs))
+	for _, m := range mvs {
+		resp = append(resp, toMovementProto(m))
+	}
+
+	return &movements.MovementList{Movements: resp}, nil
+}
+
+func (mh *MovementsHandler) GetStockOnHand(ctx context.Context, req *movements.StockParams) (*movements.StockOnHand, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("movements, grpc handler - invalid company id")
+	}
+
+	quantity, err := mh.service.Movement.GetStockOnHand(ctx, domain.StockParams{
+		CompanyId:   req.CompanyId,
+		MaterialId:  req.MaterialId,
+		ItemId:      req.ItemId,
+		WarehouseId: req.WarehouseId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &movements.StockOnHand{Quantity: quantity}, nil
+}
+
+func toMovementProto(m domain.Movement) *movements.Movement {
+	return &movements.Movement{
+		Id:                m.ID,
+		CompanyId:         m.CompanyID,
+		MaterialId:        m.MaterialID,
+		ItemId:            m.ItemID,
+		WarehouseId:       m.WarehouseID,
+		Type:              m.Type,
+		Quantity:          m.Quantity,
+		BalanceAfter:      m.BalanceAfter,
+		RelatedMovementId: m.RelatedMovementID,
+		Reference:         m.Reference,
+		Comment:           m.Comment,
+		CreatedBy:         m.CreatedBy,
+		CreatedAt:         timestamppb.New(m.CreatedAt),
+	}
+}
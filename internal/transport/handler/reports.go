@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/service"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/reports"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ReportsHandler struct {
+	service *service.Service
+}
+
+func NewReportsHandler(service *service.Service) *ReportsHandler {
+	return &ReportsHandler{
+		service: service,
+	}
+}
+
+func (rh *ReportsHandler) GetStockOnDate(ctx context.Context, req *reports.StockOnDateRequest) (*reports.StockBalanceList, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("reports, grpc handler - invalid company id")
+	}
+
+	list, err := rh.service.Reports.GetStockOnDate(ctx, domain.StockOnDateParams{
+		CompanyId:   req.CompanyId,
+		WarehouseId: req.WarehouseId,
+		Date:        asTime(req.Date),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*reports.StockBalance, 0, len(list))
+	for _, b := range list {
+		resp = append(resp, &reports.StockBalance{
+			ItemId:      b.ItemID,
+			WarehouseId: b.WarehouseID,
+			Category:    b.Category,
+			Quantity:    b.Quantity,
+			Value:       b.Value,
+		})
+	}
+
+	return &reports.StockBalanceList{Balances: resp}, nil
+}
+
+func (rh *ReportsHandler) GetCategoryTurnover(ctx context.Context, req *reports.PeriodRequest) (*reports.CategoryTurnoverList, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("reports, grpc handler - invalid company id")
+	}
+
+	list, err := rh.service.Reports.GetCategoryTurnover(ctx, toPeriodParams(req))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*reports.CategoryTurnover, 0, len(list))
+	for _, t := range list {
+		resp = append(resp, &reports.CategoryTurnover{
+			Category: t.Category,
+			Opening:  t.Opening,
+			Received: t.Received,
+			Issued:   t.Issued,
+			Closing:  t.Closing,
+			Turnover: t.Turnover,
+		})
+	}
+
+	return &reports.CategoryTurnoverList{Categories: resp}, nil
+}
+
+func (rh *ReportsHandler) GetSupplierSpend(ctx context.Context, req *reports.PeriodRequest) (*reports.SupplierSpendList, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("reports, grpc handler - invalid company id")
+	}
+
+	list, err := rh.service.Reports.GetSupplierSpend(ctx, toPeriodParams(req))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*reports.SupplierSpend, 0, len(list))
+	for _, s := range list {
+		resp = append(resp, &reports.SupplierSpend{
+			SupplierId: s.SupplierID,
+			Month:      timestamppb.New(s.Month),
+			Lots:       s.Lots,
+			Quantity:   s.Quantity,
+			Amount:     s.Amount,
+		})
+	}
+
+	return &reports.SupplierSpendList{Suppliers: resp}, nil
+}
+
+func toPeriodParams(req *reports.PeriodRequest) domain.PeriodParams {
+	return domain.PeriodParams{
+		CompanyId: req.CompanyId,
+		From:      asTime(req.From),
+		To:        asTime(req.To),
+	}
+}
@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/auth"
+	"github.com/rusystem/crm-warehouse/internal/service"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/reservations"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ReservationsHandler struct {
+	service *service.Service
+}
+
+func NewReservationsHandler(service *service.Service) *ReservationsHandler {
+	return &ReservationsHandler{
+		service: service,
+	}
+}
+
+func (rh *ReservationsHandler) Reserve(ctx context.Context, req *reservations.Reservation) (*reservations.ReservationId, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("reservations, grpc handler - invalid company id")
+	}
+
+	id, err := rh.service.Reservation.Reserve(ctx, domain.Reservation{
+		CompanyID:      req.CompanyId,
+		MaterialID:     req.MaterialId,
+		Quantity:       req.Quantity,
+		OrderReference: req.OrderReference,
+		OwnerID:        userOrDefault(ctx, req.OwnerId),
+		Comment:        req.Comment,
+		ExpiresAt:      asTime(req.ExpiresAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &reservations.ReservationId{Id: id, CompanyId: req.CompanyId}, nil
+}
+
+func (rh *ReservationsHandler) GetById(ctx context.Context, req *reservations.ReservationId) (*reservations.Reservation, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("reservations, grpc handler - invalid company id")
+	}
+
+	r, err := rh.service.Reservation.GetById(ctx, req.Id, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toReservationProto(r), nil
+}
+
+func (rh *ReservationsHandler) GetList(ctx context.Context, req *reservations.ReservationParams) (*reservations.ReservationList, error) {
+	if req.Limit <= 0 {
+		return nil, invalidArgument("reservations, grpc handler - invalid limit")
+	}
+
+	if req.Offset < 0 {
+		return nil, invalidArgument("reservations, grpc handler - invalid offset")
+	}
+
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("reservations, grpc handler - invalid company id")
+	}
+
+	list, err := rh.service.Reservation.GetList(ctx, domain.ReservationParams{
+		Limit:          req.Limit,
+		Offset:         req.Offset,
+		CompanyId:      req.CompanyId,
+		MaterialId:     req.MaterialId,
+		OrderReference: req.OrderReference,
+		Status:         req.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*reservations.Reservation, 0, len(list))
+	for _, r := range list {
+		resp = append(resp, toReservationProto(r))
+	}
+
+	return &reservations.ReservationList{Reservations: resp}, nil
+}
+
+func (rh *ReservationsHandler) Release(ctx context.Context, req *reservations.ReservationId) (*emptypb.Empty, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("reservations, grpc handler - invalid company id")
+	}
+
+	if err := rh.service.Reservation.Release(ctx, req.Id, req.CompanyId); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (rh *ReservationsHandler) Consume(ctx context.Context, req *reservations.ConsumeRequest) (*reservations.ConsumeResult, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("reservations, grpc handler - invalid company id")
+	}
+
+	m, err := rh.service.Reservation.Consume(ctx, req.Id, req.CompanyId, req.Quantity, userOrDefault(ctx, req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &reservations.ConsumeResult{MovementId: m.ID, BalanceAfter: m.BalanceAfter}, nil
+}
+
+func (rh *ReservationsHandler) GetAvailability(ctx context.Context, req *reservations.AvailabilityRequest) (*reservations.Availability, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("reservations, grpc handler - invalid company id")
+	}
+
+	a, err := rh.service.Reservation.GetAvailability(ctx, req.MaterialId, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reservations.Availability{
+		MaterialId: a.MaterialID,
+		OnHand:     a.OnHand,
+		Reserved:   a.Reserved,
+		Available:  a.Available,
+	}, nil
+}
+
+func toReservationProto(r domain.Reservation) *reservations.Reservation {
+	return &reservations.Reservation{
+		Id:               r.ID,
+		CompanyId:        r.CompanyID,
+		MaterialId:       r.MaterialID,
+		ItemId:           r.ItemID,
+		WarehouseId:      r.WarehouseID,
+		Quantity:         r.Quantity,
+		ConsumedQuantity: r.ConsumedQuantity,
+		Remaining:        r.Remaining(),
+		OrderReference:   r.OrderReference,
+		OwnerId:          r.OwnerID,
+		Status:           r.Status,
+		Comment:          r.Comment,
+		ExpiresAt:        optionalTimestamp(r.ExpiresAt),
+		CreatedAt:        timestamppb.New(r.CreatedAt),
+		UpdatedAt:        timestamppb.New(r.UpdatedAt),
+		ClosedAt:         optionalTimestamp(r.ClosedAt),
+	}
+}
+
+// userOrDefault подставляет автора операции из токена, если он не передан в запросе
+func userOrDefault(ctx context.Context, userId int64) int64 {
+	if userId != 0 {
+		return userId
+	}
+
+	if user, ok := auth.UserFromContext(ctx); ok {
+		return user.ID
+	}
+
+	return 0
+}
@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/service"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/stocktakes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type StocktakesHandler struct {
+	service *service.Service
+}
+
+func NewStocktakesHandler(service *service.Service) *StocktakesHandler {
+	return &StocktakesHandler{
+		service: service,
+	}
+}
+
+func (sh *StocktakesHandler) Open(ctx context.Context, req *stocktakes.Stocktake) (*stocktakes.StocktakeId, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("stocktakes, grpc handler - invalid company id")
+	}
+
+	id, err := sh.service.Stocktake.Open(ctx, domain.Stocktake{
+		CompanyID:   req.CompanyId,
+		WarehouseID: req.WarehouseId,
+		ZoneID:      req.ZoneId,
+		Comment:     req.Comment,
+		CreatedBy:   req.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &stocktakes.StocktakeId{Id: id, CompanyId: req.CompanyId}, nil
+}
+
+func (sh *StocktakesHandler) GetById(ctx context.Context, req *stocktakes.StocktakeId) (*stocktakes.Stocktake, error) {
+	stocktake, err := sh.service.Stocktake.GetById(ctx, req.Id, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toStocktakeProto(stocktake), nil
+}
+
+func (sh *StocktakesHandler) GetList(ctx context.Context, req *stocktakes.StocktakeParams) (*stocktakes.StocktakeList, error) {
+	if req.Limit <= 0 {
+		return nil, invalidArgument("stocktakes, grpc handler - invalid limit")
+	}
+
+	if req.Offset < 0 {
+		return nil, invalidArgument("stocktakes, grpc handler - invalid offset")
+	}
+
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("stocktakes, grpc handler - invalid company id")
+	}
+
+	list, err := sh.service.Stocktake.GetList(ctx, domain.StocktakeParams{
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+		CompanyId:   req.CompanyId,
+		WarehouseId: req.WarehouseId,
+		Status:      req.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*stocktakes.Stocktake, 0, len(list))
+	for _, s := range list {
+		resp = append(resp, toStocktakeProto(s))
+	}
+
+	return &stocktakes.StocktakeList{Stocktakes: resp}, nil
+}
+
+func (sh *StocktakesHandler) Count(ctx context.Context, req *stocktakes.CountRequest) (*stocktakes.StocktakeLine, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("stocktakes, grpc handler - invalid company id")
+	}
+
+	line, err := sh.service.Stocktake.Count(ctx, domain.StocktakeCountRequest{
+		StocktakeID: req.Id,
+		CompanyID:   req.CompanyId,
+		MaterialID:  req.MaterialId,
+		ItemID:      req.ItemId,
+		QRPayload:   req.QrPayload,
+		Quantity:    req.Quantity,
+		CountedBy:   req.UserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toStocktakeLineProto(line), nil
+}
+
+func (sh *StocktakesHandler) GetCounts(ctx context.Context, req *stocktakes.StocktakeId) (*stocktakes.CountList, error) {
+	counts, err := sh.service.Stocktake.GetCounts(ctx, req.Id, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*stocktakes.Count, 0, len(counts))
+	for _, c := range counts {
+		resp = append(resp, &stocktakes.Count{
+			Id:          c.ID,
+			StocktakeId: c.StocktakeID,
+			LineId:      c.LineID,
+			MaterialId:  c.MaterialID,
+			Quantity:    c.Quantity,
+			Source:      c.Source,
+			CountedBy:   c.CountedBy,
+			CountedAt:   timestamppb.New(c.CountedAt),
+		})
+	}
+
+	return &stocktakes.CountList{Counts: resp}, nil
+}
+
+func (sh *StocktakesHandler) GetVariance(ctx context.Context, req *stocktakes.StocktakeId) (*stocktakes.VarianceReport, error) {
+	report, err := sh.service.Stocktake.GetVariance(ctx, req.Id, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]*stocktakes.StocktakeLine, 0, len(report.Lines))
+	for _, line := range report.Lines {
+		lines = append(lines, toStocktakeLineProto(line))
+	}
+
+	return &stocktakes.VarianceReport{
+		StocktakeId:   report.StocktakeID,
+		Lines:         lines,
+		TotalLines:    report.TotalLines,
+		CountedLines:  report.CountedLines,
+		Surplus:       report.Surplus,
+		Shortage:      report.Shortage,
+		SurplusValue:  report.SurplusValue,
+		ShortageValue: report.ShortageValue,
+	}, nil
+}
+
+func (sh *StocktakesHandler) Approve(ctx context.Context, req *stocktakes.ApproveRequest) (*stocktakes.ApproveResult, error) {
+	movements, err := sh.service.Stocktake.Approve(ctx, req.Id, req.CompanyId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(movements))
+	for _, m := range movements {
+		ids = append(ids, m.ID)
+	}
+
+	return &stocktakes.ApproveResult{MovementIds: ids}, nil
+}
+
+func (sh *StocktakesHandler) Cancel(ctx context.Context, req *stocktakes.StocktakeId) (*emptypb.Empty, error) {
+	if err := sh.service.Stocktake.Cancel(ctx, req.Id, req.CompanyId); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func toStocktakeProto(s domain.Stocktake) *stocktakes.Stocktake {
+	lines := make([]*stocktakes.StocktakeLine, 0, len(s.Lines))
+	for _, line := range s.Lines {
+		lines = append(lines, toStocktakeLineProto(line))
+	}
+
+	return &stocktakes.Stocktake{
+		Id:          s.ID,
+		CompanyId:   s.CompanyID,
+		WarehouseId: s.WarehouseID,
+		ZoneId:      s.ZoneID,
+		Status:      s.Status,
+		Comment:     s.Comment,
+		CreatedBy:   s.CreatedBy,
+		ApprovedBy:  s.ApprovedBy,
+		CreatedAt:   timestamppb.New(s.CreatedAt),
+		UpdatedAt:   timestamppb.New(s.UpdatedAt),
+		ClosedAt:    optionalTimestamp(s.ClosedAt),
+		Lines:       lines,
+	}
+}
+
+func toStocktakeLineProto(line domain.StocktakeLine) *stocktakes.StocktakeLine {
+	return &stocktakes.StocktakeLine{
+		Id:          line.ID,
+		StocktakeId: line.StocktakeID,
+		MaterialId:  line.MaterialID,
+		ItemId:      line.ItemID,
+		Name:        line.Name,
+		Location:    line.Location,
+		Price:       line.Price,
+		Expected:    line.Expected,
+		Counted:     line.Counted,
+		IsCounted:   line.IsCounted,
+		Variance:    line.Variance(),
+		CountedBy:   line.CountedBy,
+		CountedAt:   optionalTimestamp(line.CountedAt),
+	}
+}
@@ -3,12 +3,9 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"github.com/rusystem/crm-warehouse/internal/service"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
 	"github.com/rusystem/crm-warehouse/pkg/gen/proto/supplier"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -24,13 +21,13 @@ func NewSupplierHandler(service *service.Service) *SupplierHandler {
 }
 
 func (sh *SupplierHandler) GetById(ctx context.Context, id *supplier.SupplierId) (*supplier.Supplier, error) {
-	spl, err := sh.service.Supplier.GetById(ctx, id.Id)
-	if err != nil {
-		if errors.Is(err, domain.ErrSupplierNotFound) {
-			return nil, status.Errorf(codes.NotFound, "supplier with ID %d not found", id.Id)
-		}
+	if id.CompanyId <= 0 {
+		return nil, invalidArgument("supplier, grpc handler - invalid company id")
+	}
 
-		return nil, status.Errorf(codes.Internal, "internal server error - %v", err)
+	spl, err := sh.service.Supplier.GetById(ctx, id.Id, id.CompanyId, id.IncludeDeleted)
+	if err != nil {
+		return nil, err
 	}
 
 	otherFieldsJSON, err := json.Marshal(spl.OtherFields)
@@ -64,12 +61,13 @@ func (sh *SupplierHandler) GetById(ctx context.Context, id *supplier.SupplierId)
 		IsActive:          spl.IsActive,
 		OtherFields:       string(otherFieldsJSON),
 		CompanyId:         spl.CompanyID,
+		DeletedAt:         optionalTimestamp(spl.DeletedAt),
 	}, nil
 }
 
 func (sh *SupplierHandler) Create(ctx context.Context, spl *supplier.Supplier) (*supplier.SupplierId, error) {
-	var otherFields map[string]interface{}
-	if err := json.Unmarshal([]byte(spl.OtherFields), &otherFields); err != nil {
+	otherFields, err := parseOtherFields(spl.OtherFields)
+	if err != nil {
 		return nil, err
 	}
 
@@ -94,7 +92,7 @@ func (sh *SupplierHandler) Create(ctx context.Context, spl *supplier.Supplier) (
 		Region:            spl.Region,
 		TaxID:             spl.TaxId,
 		BankDetails:       spl.BankDetails,
-		RegistrationDate:  spl.RegistrationDate.AsTime(),
+		RegistrationDate:  asTime(spl.RegistrationDate),
 		PaymentTerms:      spl.PaymentTerms,
 		IsActive:          spl.IsActive,
 		OtherFields:       otherFields,
@@ -104,12 +102,12 @@ func (sh *SupplierHandler) Create(ctx context.Context, spl *supplier.Supplier) (
 		return nil, err
 	}
 
-	return &supplier.SupplierId{Id: id}, nil
+	return &supplier.SupplierId{Id: id, CompanyId: spl.CompanyId}, nil
 }
 
 func (sh *SupplierHandler) Update(ctx context.Context, spl *supplier.Supplier) (*emptypb.Empty, error) {
-	var otherFields map[string]interface{}
-	if err := json.Unmarshal([]byte(spl.OtherFields), &otherFields); err != nil {
+	otherFields, err := parseOtherFields(spl.OtherFields)
+	if err != nil {
 		return nil, err
 	}
 
@@ -134,7 +132,7 @@ func (sh *SupplierHandler) Update(ctx context.Context, spl *supplier.Supplier) (
 		Region:            spl.Region,
 		TaxID:             spl.TaxId,
 		BankDetails:       spl.BankDetails,
-		RegistrationDate:  spl.RegistrationDate.AsTime(),
+		RegistrationDate:  asTime(spl.RegistrationDate),
 		PaymentTerms:      spl.PaymentTerms,
 		IsActive:          spl.IsActive,
 		OtherFields:       otherFields,
@@ -147,7 +145,11 @@ func (sh *SupplierHandler) Update(ctx context.Context, spl *supplier.Supplier) (
 }
 
 func (sh *SupplierHandler) Delete(ctx context.Context, req *supplier.SupplierId) (*emptypb.Empty, error) {
-	if err := sh.service.Supplier.Delete(ctx, req.Id); err != nil {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("supplier, grpc handler - invalid company id")
+	}
+
+	if err := sh.service.Supplier.Delete(ctx, req.Id, req.CompanyId); err != nil {
 		return nil, err
 	}
 
@@ -155,7 +157,7 @@ func (sh *SupplierHandler) Delete(ctx context.Context, req *supplier.SupplierId)
 }
 
 func (sh *SupplierHandler) GetList(ctx context.Context, id *supplier.SupplierCompanyId) (*supplier.SupplierList, error) {
-	suppliers, err := sh.service.Supplier.GetListByCompanyId(ctx, id.Id)
+	suppliers, err := sh.service.Supplier.GetListByCompanyId(ctx, id.Id, id.IncludeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -193,8 +195,21 @@ func (sh *SupplierHandler) GetList(ctx context.Context, id *supplier.SupplierCom
 			IsActive:          s.IsActive,
 			OtherFields:       string(otherFieldsJSON),
 			CompanyId:         s.CompanyID,
+			DeletedAt:         optionalTimestamp(s.DeletedAt),
 		})
 	}
 
 	return &supplier.SupplierList{Suppliers: resp}, nil
 }
+
+func (sh *SupplierHandler) Restore(ctx context.Context, req *supplier.SupplierId) (*emptypb.Empty, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("supplier, grpc handler - invalid company id")
+	}
+
+	if err := sh.service.Supplier.Restore(ctx, req.Id, req.CompanyId); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/internal/service"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/transfers"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+type TransfersHandler struct {
+	service *service.Service
+}
+
+func NewTransfersHandler(service *service.Service) *TransfersHandler {
+	return &TransfersHandler{
+		service: service,
+	}
+}
+
+func (th *TransfersHandler) Create(ctx context.Context, req *transfers.TransferOrder) (*transfers.TransferOrderId, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("transfers, grpc handler - invalid company id")
+	}
+
+	items := make([]domain.TransferOrderItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, domain.TransferOrderItem{
+			MaterialID: item.MaterialId,
+			Quantity:   item.Quantity,
+		})
+	}
+
+	id, err := th.service.Transfer.Create(ctx, domain.TransferOrder{
+		CompanyID:              req.CompanyId,
+		SourceWarehouseID:      req.SourceWarehouseId,
+		DestinationWarehouseID: req.DestinationWarehouseId,
+		Comment:                req.Comment,
+		CreatedBy:              req.CreatedBy,
+		Items:                  items,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &transfers.TransferOrderId{Id: id, CompanyId: req.CompanyId}, nil
+}
+
+func (th *TransfersHandler) GetById(ctx context.Context, req *transfers.TransferOrderId) (*transfers.TransferOrder, error) {
+	order, err := th.service.Transfer.GetById(ctx, req.Id, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTransferOrderProto(order), nil
+}
+
+func (th *TransfersHandler) GetList(ctx context.Context, req *transfers.TransferOrderParams) (*transfers.TransferOrderList, error) {
+	if req.Limit <= 0 {
+		return nil, invalidArgument("transfers, grpc handler - invalid limit")
+	}
+
+	if req.Offset < 0 {
+		return nil, invalidArgument("transfers, grpc handler - invalid offset")
+	}
+
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("transfers, grpc handler - invalid company id")
+	}
+
+	orders, err := th.service.Transfer.GetList(ctx, domain.TransferOrderParams{
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+		CompanyId:   req.CompanyId,
+		WarehouseId: req.WarehouseId,
+		Status:      req.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*transfers.TransferOrder, 0, len(orders))
+	for _, order := range orders {
+		resp = append(resp, toTransferOrderProto(order))
+	}
+
+	return &transfers.TransferOrderList{Orders: resp}, nil
+}
+
+func (th *TransfersHandler) Ship(ctx context.Context, req *transfers.ShipRequest) (*emptypb.Empty, error) {
+	if err := th.service.Transfer.Ship(ctx, req.Id, req.CompanyId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (th *TransfersHandler) Receive(ctx context.Context, req *transfers.ReceiveRequest) (*emptypb.Empty, error) {
+	receipts := make([]domain.TransferReceipt, 0, len(req.Receipts))
+	for _, r := range req.Receipts {
+		receipts = append(receipts, domain.TransferReceipt{
+			ItemID:   r.ItemId,
+			Quantity: r.Quantity,
+		})
+	}
+
+	if err := th.service.Transfer.Receive(ctx, req.Id, req.CompanyId, req.UserId, receipts); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (th *TransfersHandler) Cancel(ctx context.Context, req *transfers.TransferOrderId) (*emptypb.Empty, error) {
+	if err := th.service.Transfer.Cancel(ctx, req.Id, req.CompanyId); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (th *TransfersHandler) GetInTransit(ctx context.Context, req *transfers.InTransitParams) (*transfers.InTransit, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("transfers, grpc handler - invalid company id")
+	}
+
+	quantity, err := th.service.Transfer.GetInTransit(ctx, domain.InTransitParams{
+		CompanyId:   req.CompanyId,
+		WarehouseId: req.WarehouseId,
+		ItemId:      req.ItemId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &transfers.InTransit{Quantity: quantity}, nil
+}
+
+func toTransferOrderProto(order domain.TransferOrder) *transfers.TransferOrder {
+	items := make([]*transfers.TransferOrderItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, &transfers.TransferOrderItem{
+			Id:                    item.ID,
+			TransferOrderId:       item.TransferOrderID,
+			MaterialId:            item.MaterialID,
+			ItemId:                item.ItemID,
+			DestinationMaterialId: item.DestinationMaterialID,
+			Quantity:              item.Quantity,
+			ShippedQuantity:       item.ShippedQuantity,
+			ReceivedQuantity:      item.ReceivedQuantity,
+			InTransit:             item.InTransit(),
+		})
+	}
+
+	return &transfers.TransferOrder{
+		Id:                     order.ID,
+		CompanyId:              order.CompanyID,
+		SourceWarehouseId:      order.SourceWarehouseID,
+		DestinationWarehouseId: order.DestinationWarehouseID,
+		Status:                 order.Status,
+		Comment:                order.Comment,
+		CreatedBy:              order.CreatedBy,
+		CreatedAt:              timestamppb.New(order.CreatedAt),
+		UpdatedAt:              timestamppb.New(order.UpdatedAt),
+		ShippedAt:              optionalTimestamp(order.ShippedAt),
+		ReceivedAt:             optionalTimestamp(order.ReceivedAt),
+		Items:                  items,
+	}
+}
+
+// optionalTimestamp не заполняет поле для событий, которые еще не произошли
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
@@ -3,12 +3,9 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"github.com/rusystem/crm-warehouse/internal/service"
 	"github.com/rusystem/crm-warehouse/pkg/domain"
 	"github.com/rusystem/crm-warehouse/pkg/gen/proto/warehouse"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -24,13 +21,13 @@ func NewWarehouseHandler(service *service.Service) *WarehouseHandler {
 }
 
 func (wh *WarehouseHandler) GetById(ctx context.Context, id *warehouse.WarehouseId) (*warehouse.Warehouse, error) {
-	whs, err := wh.service.Warehouse.GetById(ctx, id.Id)
-	if err != nil {
-		if errors.Is(err, domain.ErrWarehouseNotFound) {
-			return nil, status.Errorf(codes.NotFound, "warehouse with ID %d not found", id.Id)
-		}
+	if id.CompanyId <= 0 {
+		return nil, invalidArgument("warehouse, grpc handler - invalid company id")
+	}
 
-		return nil, status.Errorf(codes.Internal, "internal server error - %v", err)
+	whs, err := wh.service.Warehouse.GetById(ctx, id.Id, id.CompanyId, id.IncludeDeleted)
+	if err != nil {
+		return nil, err
 	}
 
 	otherFieldsJSON, err := json.Marshal(whs.OtherFields)
@@ -47,15 +44,17 @@ func (wh *WarehouseHandler) GetById(ctx context.Context, id *warehouse.Warehouse
 		Email:             whs.Email,
 		MaxCapacity:       whs.MaxCapacity,
 		CurrentOccupancy:  whs.CurrentOccupancy,
+		CapacityPolicy:    whs.CapacityPolicy,
 		OtherFields:       string(otherFieldsJSON),
 		Country:           whs.Country,
 		CompanyId:         whs.CompanyID,
+		DeletedAt:         optionalTimestamp(whs.DeletedAt),
 	}, nil
 }
 
 func (wh *WarehouseHandler) Create(ctx context.Context, whs *warehouse.Warehouse) (*warehouse.WarehouseId, error) {
-	var otherFields map[string]interface{}
-	if err := json.Unmarshal([]byte(whs.OtherFields), &otherFields); err != nil {
+	otherFields, err := parseOtherFields(whs.OtherFields)
+	if err != nil {
 		return nil, err
 	}
 
@@ -67,7 +66,7 @@ func (wh *WarehouseHandler) Create(ctx context.Context, whs *warehouse.Warehouse
 		Phone:             whs.Phone,
 		Email:             whs.Email,
 		MaxCapacity:       whs.MaxCapacity,
-		CurrentOccupancy:  whs.CurrentOccupancy,
+		CapacityPolicy:    whs.CapacityPolicy,
 		OtherFields:       otherFields,
 		Country:           whs.Country,
 		CompanyID:         whs.CompanyId,
@@ -76,12 +75,12 @@ func (wh *WarehouseHandler) Create(ctx context.Context, whs *warehouse.Warehouse
 		return nil, err
 	}
 
-	return &warehouse.WarehouseId{Id: id}, nil
+	return &warehouse.WarehouseId{Id: id, CompanyId: whs.CompanyId}, nil
 }
 
 func (wh *WarehouseHandler) Update(ctx context.Context, whs *warehouse.Warehouse) (*emptypb.Empty, error) {
-	var otherFields map[string]interface{}
-	if err := json.Unmarshal([]byte(whs.OtherFields), &otherFields); err != nil {
+	otherFields, err := parseOtherFields(whs.OtherFields)
+	if err != nil {
 		return nil, err
 	}
 
@@ -93,9 +92,10 @@ func (wh *WarehouseHandler) Update(ctx context.Context, whs *warehouse.Warehouse
 		Phone:             whs.Phone,
 		Email:             whs.Email,
 		MaxCapacity:       whs.MaxCapacity,
-		CurrentOccupancy:  whs.CurrentOccupancy,
+		CapacityPolicy:    whs.CapacityPolicy,
 		OtherFields:       otherFields,
 		Country:           whs.Country,
+		CompanyID:         whs.CompanyId,
 	}); err != nil {
 		return nil, err
 	}
@@ -104,7 +104,11 @@ func (wh *WarehouseHandler) Update(ctx context.Context, whs *warehouse.Warehouse
 }
 
 func (wh *WarehouseHandler) Delete(ctx context.Context, req *warehouse.WarehouseId) (*emptypb.Empty, error) {
-	if err := wh.service.Warehouse.Delete(ctx, req.Id); err != nil {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("warehouse, grpc handler - invalid company id")
+	}
+
+	if err := wh.service.Warehouse.Delete(ctx, req.Id, req.CompanyId); err != nil {
 		return nil, err
 	}
 
@@ -112,7 +116,7 @@ func (wh *WarehouseHandler) Delete(ctx context.Context, req *warehouse.Warehouse
 }
 
 func (wh *WarehouseHandler) GetList(ctx context.Context, req *warehouse.WarehouseCompanyId) (*warehouse.WarehouseList, error) {
-	warehouses, err := wh.service.Warehouse.GetListByCompanyId(ctx, req.Id)
+	warehouses, err := wh.service.Warehouse.GetListByCompanyId(ctx, req.Id, req.IncludeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -133,15 +137,29 @@ func (wh *WarehouseHandler) GetList(ctx context.Context, req *warehouse.Warehous
 			Email:             w.Email,
 			MaxCapacity:       w.MaxCapacity,
 			CurrentOccupancy:  w.CurrentOccupancy,
+			CapacityPolicy:    w.CapacityPolicy,
 			OtherFields:       string(otherFieldsJSON),
 			Country:           w.Country,
 			CompanyId:         w.CompanyID,
+			DeletedAt:         optionalTimestamp(w.DeletedAt),
 		})
 	}
 
 	return &warehouse.WarehouseList{Warehouses: resp}, nil
 }
 
+func (wh *WarehouseHandler) Restore(ctx context.Context, req *warehouse.WarehouseId) (*emptypb.Empty, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("warehouse, grpc handler - invalid company id")
+	}
+
+	if err := wh.service.Warehouse.Restore(ctx, req.Id, req.CompanyId); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (wh *WarehouseHandler) GetResponsibleUsers(ctx context.Context, req *warehouse.WarehouseCompanyId) (*warehouse.UserList, error) {
 	u, err := wh.service.Warehouse.GetResponsibleUsers(ctx, req.Id)
 	if err != nil {
@@ -174,3 +192,116 @@ func (wh *WarehouseHandler) GetResponsibleUsers(ctx context.Context, req *wareho
 
 	return &warehouse.UserList{Users: users}, nil
 }
+
+func (wh *WarehouseHandler) CreateLocation(ctx context.Context, req *warehouse.StorageLocation) (*warehouse.LocationId, error) {
+	id, err := wh.service.Locations.Create(ctx, domain.StorageLocation{
+		CompanyID:   req.CompanyId,
+		WarehouseID: req.WarehouseId,
+		ParentID:    req.ParentId,
+		Kind:        req.Kind,
+		Code:        req.Code,
+		Name:        req.Name,
+		Capacity:    req.Capacity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &warehouse.LocationId{Id: id, CompanyId: req.CompanyId}, nil
+}
+
+func (wh *WarehouseHandler) GetLocation(ctx context.Context, req *warehouse.LocationId) (*warehouse.StorageLocation, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("warehouse, grpc handler - invalid company id")
+	}
+
+	location, err := wh.service.Locations.GetById(ctx, req.Id, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toLocationProto(location), nil
+}
+
+func (wh *WarehouseHandler) UpdateLocation(ctx context.Context, req *warehouse.StorageLocation) (*emptypb.Empty, error) {
+	if err := wh.service.Locations.Update(ctx, domain.StorageLocation{
+		ID:          req.Id,
+		CompanyID:   req.CompanyId,
+		WarehouseID: req.WarehouseId,
+		ParentID:    req.ParentId,
+		Kind:        req.Kind,
+		Code:        req.Code,
+		Name:        req.Name,
+		Capacity:    req.Capacity,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (wh *WarehouseHandler) DeleteLocation(ctx context.Context, req *warehouse.LocationId) (*emptypb.Empty, error) {
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("warehouse, grpc handler - invalid company id")
+	}
+
+	if err := wh.service.Locations.Delete(ctx, req.Id, req.CompanyId); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (wh *WarehouseHandler) GetListLocations(ctx context.Context, req *warehouse.LocationParams) (*warehouse.StorageLocationList, error) {
+	if req.Limit <= 0 {
+		return nil, invalidArgument("warehouse, grpc handler - invalid limit")
+	}
+
+	if req.Offset < 0 {
+		return nil, invalidArgument("warehouse, grpc handler - invalid offset")
+	}
+
+	if req.CompanyId <= 0 {
+		return nil, invalidArgument("warehouse, grpc handler - invalid company id")
+	}
+
+	if req.WarehouseId <= 0 {
+		return nil, invalidArgument("warehouse, grpc handler - invalid warehouse id")
+	}
+
+	locations, err := wh.service.Locations.GetList(ctx, domain.StorageLocationParams{
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+		CompanyId:   req.CompanyId,
+		WarehouseId: req.WarehouseId,
+		ParentId:    req.ParentId,
+		Kind:        req.Kind,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*warehouse.StorageLocation, 0, len(locations))
+	for _, l := range locations {
+		resp = append(resp, toLocationProto(l))
+	}
+
+	return &warehouse.StorageLocationList{Locations: resp}, nil
+}
+
+func toLocationProto(l domain.StorageLocation) *warehouse.StorageLocation {
+	return &warehouse.StorageLocation{
+		Id:          l.ID,
+		CompanyId:   l.CompanyID,
+		WarehouseId: l.WarehouseID,
+		ParentId:    l.ParentID,
+		Kind:        l.Kind,
+		Code:        l.Code,
+		Name:        l.Name,
+		Capacity:    l.Capacity,
+		Occupancy:   l.Occupancy,
+		Address:     l.Address,
+		CreatedAt:   timestamppb.New(l.CreatedAt),
+		UpdatedAt:   timestamppb.New(l.UpdatedAt),
+	}
+}
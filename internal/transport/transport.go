@@ -6,15 +6,27 @@ import (
 )
 
 type Handler struct {
-	Warehouse *handler.WarehouseHandler
-	Supplier  *handler.SupplierHandler
-	Materials *handler.MaterialsHandler
+	Warehouse    *handler.WarehouseHandler
+	Supplier     *handler.SupplierHandler
+	Materials    *handler.MaterialsHandler
+	Movements    *handler.MovementsHandler
+	Transfers    *handler.TransfersHandler
+	Reservations *handler.ReservationsHandler
+	Reports      *handler.ReportsHandler
+	Stocktakes   *handler.StocktakesHandler
+	Audit        *handler.AuditHandler
 }
 
 func New(service *service.Service) *Handler {
 	return &Handler{
-		Warehouse: handler.NewWarehouseHandler(service),
-		Supplier:  handler.NewSupplierHandler(service),
-		Materials: handler.NewMaterialsHandler(service),
+		Warehouse:    handler.NewWarehouseHandler(service),
+		Supplier:     handler.NewSupplierHandler(service),
+		Materials:    handler.NewMaterialsHandler(service),
+		Movements:    handler.NewMovementsHandler(service),
+		Transfers:    handler.NewTransfersHandler(service),
+		Reservations: handler.NewReservationsHandler(service),
+		Reports:      handler.NewReportsHandler(service),
+		Stocktakes:   handler.NewStocktakesHandler(service),
+		Audit:        handler.NewAuditHandler(service),
 	}
 }
@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"go.uber.org/zap"
+	"time"
+)
+
+// Job периодическая фоновая задача сервиса
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Start запускает задачи в отдельных горутинах до отмены ctx.
+// Первый запуск выполняется сразу, ошибки запуска логируются и не останавливают задачу.
+func Start(ctx context.Context, jobs ...Job) {
+	for _, job := range jobs {
+		go run(ctx, job)
+	}
+}
+
+func run(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job.Run(ctx); err != nil && ctx.Err() == nil {
+			logger.Error("worker: job failed", zap.String("job", job.Name), zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/audit"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuditClient struct {
+	conn        *grpc.ClientConn
+	auditClient audit.AuditServiceClient
+}
+
+func NewAuditClient(addr string) (*AuditClient, error) {
+	opt := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(errorInterceptor),
+	}
+
+	conn, err := grpc.Dial(addr, opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditClient{
+		conn:        conn,
+		auditClient: audit.NewAuditServiceClient(conn),
+	}, nil
+}
+
+func (ac *AuditClient) Close() error {
+	return ac.conn.Close()
+}
+
+func (ac *AuditClient) GetList(ctx context.Context, params domain.AuditParams) ([]domain.AuditEntry, error) {
+	req := &audit.AuditParams{
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+		CompanyId:  params.CompanyId,
+		ActorId:    params.ActorId,
+		EntityType: params.EntityType,
+		EntityId:   params.EntityId,
+		Method:     params.Method,
+		Action:     params.Action,
+	}
+
+	if !params.From.IsZero() {
+		req.From = timestamppb.New(params.From)
+	}
+
+	if !params.To.IsZero() {
+		req.To = timestamppb.New(params.To)
+	}
+
+	resp, err := ac.auditClient.GetList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]domain.AuditEntry, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		changes := make(map[string]domain.AuditChange, len(e.Changes))
+		for field, c := range e.Changes {
+			changes[field] = domain.AuditChange{Before: rawJSON(c.Before), After: rawJSON(c.After)}
+		}
+
+		entries = append(entries, domain.AuditEntry{
+			ID:         e.Id,
+			CompanyID:  e.CompanyId,
+			ActorID:    e.ActorId,
+			Method:     e.Method,
+			EntityType: e.EntityType,
+			EntityID:   e.EntityId,
+			Action:     e.Action,
+			Changes:    changes,
+			CreatedAt:  e.CreatedAt.AsTime(),
+		})
+	}
+
+	return entries, nil
+}
+
+func rawJSON(s string) json.RawMessage {
+	if s == "" {
+		return nil
+	}
+
+	return json.RawMessage(s)
+}
@@ -0,0 +1,11 @@
+package grpc
+
+import (
+	"context"
+	"google.golang.org/grpc/metadata"
+)
+
+// WithToken добавляет bearer токен пользователя к исходящему вызову
+func WithToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Error - ошибка сервера с доменной причиной, errors.Is сравнивает ее с ошибками domain,
+// а status.Code по-прежнему возвращает код grpc
+type Error struct {
+	status *status.Status
+	err    error
+}
+
+func (e *Error) Error() string {
+	return e.status.Message()
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+func (e *Error) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// errorInterceptor переводит ошибки вызова через FromStatus
+func errorInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return FromStatus(invoker(ctx, method, req, reply, cc, opts...))
+}
+
+// FromStatus превращает статус с ErrorInfo в Error, остальные ошибки возвращает как есть.
+// Используется и клиентом NATS, ответы которого несут тот же статус.
+func FromStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	var domainErr error
+	var violations []domain.FieldViolation
+	for _, d := range st.Details() {
+		switch detail := d.(type) {
+		case *errdetails.ErrorInfo:
+			if detail.Domain != domain.ErrorDomain {
+				continue
+			}
+
+			if e, ok := domain.ErrorByReason(detail.Reason); ok {
+				domainErr = e
+			}
+		case *errdetails.BadRequest:
+			for _, v := range detail.FieldViolations {
+				violations = append(violations, domain.FieldViolation{Field: v.Field, Description: v.Description})
+			}
+		}
+	}
+
+	// нарушения по полям доступны через errors.As(err, *domain.ValidationError)
+	if len(violations) > 0 {
+		return &Error{status: st, err: &domain.ValidationError{Violations: violations}}
+	}
+
+	if domainErr != nil {
+		return &Error{status: st, err: domainErr}
+	}
+
+	return err
+}
@@ -42,6 +42,8 @@ type Material struct {
 	IncomingDeliveryNumber string                 `json:"incoming_delivery_number"` // Входящий номер поставки
 	OtherFields            map[string]interface{} `json:"other_fields"`             // Дополнительные пользовательские поля
 	CompanyID              int64                  `json:"company_id"`               // Кабинет компании к кому привязан товар
+	BinID                  int64                  `json:"bin_id"`                   // Ячейка склада с купленной партией, 0 - не размещена
+	CategoryID             int64                  `json:"category_id"`              // Категория материала, 0 - без категории
 }
 
 type MaterialCategory struct {
@@ -54,13 +56,28 @@ type MaterialCategory struct {
 	UpdatedAt   time.Time `json:"updated_at"`
 	IsActive    bool      `json:"is_active"`
 	ImgURL      string    `json:"img_url"`
+	DeletedAt   time.Time `json:"deleted_at"` // Дата удаления, нулевая - категория не удалена
+	ParentID    int64     `json:"parent_id"`  // Родительская категория, 0 - корневая
+	Path        string    `json:"path"`       // id категорий от корня до самой категории через '/'
+	Depth       int64     `json:"depth"`      // Глубина в дереве, у корневых 0
 }
 
 type MaterialParams struct {
-	Limit     int64
-	Offset    int64
-	CompanyId int64
-	Query     string
+	Limit          int64
+	Offset         int64
+	CompanyId      int64
+	Query          string
+	IncludeDeleted bool                  // Для списка и поиска категорий: включать удаленные
+	Filter         domain.MaterialFilter // Для списков материалов
+	Sort           []domain.MaterialSort // Для списков материалов, пусто - по id
+	Cursor         string                // Для списков материалов: NextCursor предыдущей страницы
+}
+
+// MaterialPage страница списка материалов
+type MaterialPage struct {
+	Materials  []Material
+	NextCursor string // Пусто - страница последняя
+	Total      int64  // Количество материалов по фильтру во всем списке
 }
 
 type MaterialsClient struct {
@@ -71,6 +88,7 @@ type MaterialsClient struct {
 func NewMaterialsClient(addr string) (*MaterialsClient, error) {
 	opt := []grpc.DialOption{
 		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(errorInterceptor),
 	}
 
 	conn, err := grpc.Dial(addr, opt...)
@@ -122,6 +140,8 @@ func (mc *MaterialsClient) CreatePlanning(ctx context.Context, material Material
 		IncomingDeliveryNumber: material.IncomingDeliveryNumber,
 		OtherFields:            string(otherFieldsJSON),
 		CompanyId:              material.CompanyID,
+		BinId:                  material.BinID,
+		CategoryId:             material.CategoryID,
 	})
 	if err != nil {
 		return 0, err
@@ -164,6 +184,9 @@ func (mc *MaterialsClient) UpdatePlanningById(ctx context.Context, material Mate
 		WarehouseSection:       material.WarehouseSection,
 		IncomingDeliveryNumber: material.IncomingDeliveryNumber,
 		OtherFields:            string(otherFieldsJSON),
+		CompanyId:              material.CompanyID,
+		BinId:                  material.BinID,
+		CategoryId:             material.CategoryID,
 	})
 	if err != nil {
 		return err
@@ -172,22 +195,18 @@ func (mc *MaterialsClient) UpdatePlanningById(ctx context.Context, material Mate
 	return nil
 }
 
-func (mc *MaterialsClient) DeletePlanningById(ctx context.Context, id int64) error {
-	_, err := mc.materialsClient.DeletePlanning(ctx, &materials.MaterialId{Id: id})
+func (mc *MaterialsClient) DeletePlanningById(ctx context.Context, id, companyId int64) error {
+	_, err := mc.materialsClient.DeletePlanning(ctx, &materials.MaterialId{Id: id, CompanyId: companyId})
 	return err
 }
 
-func (mc *MaterialsClient) GetPlanningById(ctx context.Context, id int64) (Material, error) {
+func (mc *MaterialsClient) GetPlanningById(ctx context.Context, id, companyId int64) (Material, error) {
 	if id <= 0 {
 		return Material{}, errors.New("materials, grpc client - invalid id")
 	}
 
-	resp, err := mc.materialsClient.GetPlanning(ctx, &materials.MaterialId{Id: id})
+	resp, err := mc.materialsClient.GetPlanning(ctx, &materials.MaterialId{Id: id, CompanyId: companyId})
 	if err != nil {
-		if err.Error() == "rpc error: code = Unknown desc = sql: no rows in result set" {
-			return Material{}, domain.ErrMaterialNotFound
-		}
-
 		return Material{}, err
 	}
 
@@ -226,28 +245,25 @@ func (mc *MaterialsClient) GetPlanningById(ctx context.Context, id int64) (Mater
 		IncomingDeliveryNumber: resp.IncomingDeliveryNumber,
 		OtherFields:            otherFields,
 		CompanyID:              resp.CompanyId,
+		BinID:                  resp.BinId,
+		CategoryID:             resp.CategoryId,
 	}, nil
 }
 
-func (mc *MaterialsClient) GetListPlanning(ctx context.Context, params MaterialParams) ([]Material, error) {
-	var mtrls []Material
-
-	resp, err := mc.materialsClient.GetListPlanning(ctx, &materials.MaterialParams{
-		Limit:     params.Limit,
-		Offset:    params.Offset,
-		CompanyId: params.CompanyId,
-	})
+func (mc *MaterialsClient) GetListPlanning(ctx context.Context, params MaterialParams) (MaterialPage, error) {
+	resp, err := mc.materialsClient.GetListPlanning(ctx, materialParamsProto(params))
 	if err != nil {
-		return nil, err
+		return MaterialPage{}, err
 	}
 
+	page := MaterialPage{NextCursor: resp.NextCursor, Total: resp.Total}
 	for _, mtrl := range resp.Materials {
 		var otherFields map[string]interface{}
 		if err = json.Unmarshal([]byte(mtrl.OtherFields), &otherFields); err != nil {
-			return nil, err
+			return MaterialPage{}, err
 		}
 
-		mtrls = append(mtrls, Material{
+		page.Materials = append(page.Materials, Material{
 			ID:                     mtrl.Id,
 			WarehouseID:            mtrl.WarehouseId,
 			ItemID:                 mtrl.ItemId,
@@ -277,14 +293,45 @@ func (mc *MaterialsClient) GetListPlanning(ctx context.Context, params MaterialP
 			IncomingDeliveryNumber: mtrl.IncomingDeliveryNumber,
 			OtherFields:            otherFields,
 			CompanyID:              mtrl.CompanyId,
+			BinID:                  mtrl.BinId,
+			CategoryID:             mtrl.CategoryId,
 		})
 	}
 
-	return mtrls, nil
+	return page, nil
 }
 
-func (mc *MaterialsClient) MovePlanningToPurchased(ctx context.Context, id int64) (int64, int64, error) {
-	resp, err := mc.materialsClient.MovePlanningToPurchased(ctx, &materials.MaterialId{Id: id})
+func materialParamsProto(params MaterialParams) *materials.MaterialParams {
+	f := params.Filter
+	req := &materials.MaterialParams{
+		Limit:     params.Limit,
+		Offset:    params.Offset,
+		CompanyId: params.CompanyId,
+		Filter: &materials.MaterialFilter{
+			WarehouseId:    f.WarehouseId,
+			SupplierId:     f.SupplierId,
+			CategoryId:     f.CategoryId,
+			Status:         f.Status,
+			ReceivedFrom:   optionalTimestamp(f.ReceivedFrom),
+			ReceivedTo:     optionalTimestamp(f.ReceivedTo),
+			ExpirationFrom: optionalTimestamp(f.ExpirationFrom),
+			ExpirationTo:   optionalTimestamp(f.ExpirationTo),
+			QuantityMin:    f.QuantityMin,
+			QuantityMax:    f.QuantityMax,
+			OtherFields:    f.OtherFields,
+		},
+		Cursor: params.Cursor,
+	}
+
+	for _, sort := range params.Sort {
+		req.Sort = append(req.Sort, &materials.MaterialSort{Field: sort.Field, Desc: sort.Desc})
+	}
+
+	return req
+}
+
+func (mc *MaterialsClient) MovePlanningToPurchased(ctx context.Context, id, companyId int64) (int64, int64, error) {
+	resp, err := mc.materialsClient.MovePlanningToPurchased(ctx, &materials.MaterialId{Id: id, CompanyId: companyId})
 	if err != nil {
 		return 0, 0, err
 	}
@@ -326,6 +373,8 @@ func (mc *MaterialsClient) CreatePurchased(ctx context.Context, material Materia
 		IncomingDeliveryNumber: material.IncomingDeliveryNumber,
 		OtherFields:            string(otherFieldsJSON),
 		CompanyId:              material.CompanyID,
+		BinId:                  material.BinID,
+		CategoryId:             material.CategoryID,
 	})
 	if err != nil {
 		return 0, 0, err
@@ -368,6 +417,9 @@ func (mc *MaterialsClient) UpdatePurchasedById(ctx context.Context, material Mat
 		WarehouseSection:       material.WarehouseSection,
 		IncomingDeliveryNumber: material.IncomingDeliveryNumber,
 		OtherFields:            string(otherFieldsJSON),
+		CompanyId:              material.CompanyID,
+		BinId:                  material.BinID,
+		CategoryId:             material.CategoryID,
 	})
 	if err != nil {
 		return err
@@ -376,22 +428,18 @@ func (mc *MaterialsClient) UpdatePurchasedById(ctx context.Context, material Mat
 	return nil
 }
 
-func (mc *MaterialsClient) DeletePurchasedById(ctx context.Context, id int64) error {
-	_, err := mc.materialsClient.DeletePurchased(ctx, &materials.MaterialId{Id: id})
+func (mc *MaterialsClient) DeletePurchasedById(ctx context.Context, id, companyId int64) error {
+	_, err := mc.materialsClient.DeletePurchased(ctx, &materials.MaterialId{Id: id, CompanyId: companyId})
 	return err
 }
 
-func (mc *MaterialsClient) GetPurchasedById(ctx context.Context, id int64) (Material, error) {
+func (mc *MaterialsClient) GetPurchasedById(ctx context.Context, id, companyId int64) (Material, error) {
 	if id <= 0 {
 		return Material{}, errors.New("materials, grpc client - invalid id")
 	}
 
-	resp, err := mc.materialsClient.GetPurchased(ctx, &materials.MaterialId{Id: id})
+	resp, err := mc.materialsClient.GetPurchased(ctx, &materials.MaterialId{Id: id, CompanyId: companyId})
 	if err != nil {
-		if err.Error() == "rpc error: code = Unknown desc = sql: no rows in result set" {
-			return Material{}, domain.ErrMaterialNotFound
-		}
-
 		return Material{}, err
 	}
 
@@ -430,28 +478,25 @@ func (mc *MaterialsClient) GetPurchasedById(ctx context.Context, id int64) (Mate
 		IncomingDeliveryNumber: resp.IncomingDeliveryNumber,
 		OtherFields:            otherFields,
 		CompanyID:              resp.CompanyId,
+		BinID:                  resp.BinId,
+		CategoryID:             resp.CategoryId,
 	}, nil
 }
 
-func (mc *MaterialsClient) GetListPurchased(ctx context.Context, params MaterialParams) ([]Material, error) {
-	var mtrls []Material
-
-	resp, err := mc.materialsClient.GetListPurchased(ctx, &materials.MaterialParams{
-		Limit:     params.Limit,
-		Offset:    params.Offset,
-		CompanyId: params.CompanyId,
-	})
+func (mc *MaterialsClient) GetListPurchased(ctx context.Context, params MaterialParams) (MaterialPage, error) {
+	resp, err := mc.materialsClient.GetListPurchased(ctx, materialParamsProto(params))
 	if err != nil {
-		return nil, err
+		return MaterialPage{}, err
 	}
 
+	page := MaterialPage{NextCursor: resp.NextCursor, Total: resp.Total}
 	for _, mtrl := range resp.Materials {
 		var otherFields map[string]interface{}
 		if err = json.Unmarshal([]byte(mtrl.OtherFields), &otherFields); err != nil {
-			return nil, err
+			return MaterialPage{}, err
 		}
 
-		mtrls = append(mtrls, Material{
+		page.Materials = append(page.Materials, Material{
 			ID:                     mtrl.Id,
 			WarehouseID:            mtrl.WarehouseId,
 			ItemID:                 mtrl.ItemId,
@@ -481,28 +526,26 @@ func (mc *MaterialsClient) GetListPurchased(ctx context.Context, params Material
 			IncomingDeliveryNumber: mtrl.IncomingDeliveryNumber,
 			OtherFields:            otherFields,
 			CompanyID:              mtrl.CompanyId,
+			BinID:                  mtrl.BinId,
+			CategoryID:             mtrl.CategoryId,
 		})
 	}
 
-	return mtrls, nil
+	return page, nil
 }
 
-func (mc *MaterialsClient) MovePurchasedToArchive(ctx context.Context, id int64) error {
-	_, err := mc.materialsClient.MovePurchasedToArchive(ctx, &materials.MaterialId{Id: id})
+func (mc *MaterialsClient) MovePurchasedToArchive(ctx context.Context, id, companyId int64) error {
+	_, err := mc.materialsClient.MovePurchasedToArchive(ctx, &materials.MaterialId{Id: id, CompanyId: companyId})
 	return err
 }
 
-func (mc *MaterialsClient) GetPlanningArchiveById(ctx context.Context, id int64) (Material, error) {
+func (mc *MaterialsClient) GetPlanningArchiveById(ctx context.Context, id, companyId int64) (Material, error) {
 	if id <= 0 {
 		return Material{}, errors.New("materials, grpc client - invalid id")
 	}
 
-	resp, err := mc.materialsClient.GetPlanningArchive(ctx, &materials.MaterialId{Id: id})
+	resp, err := mc.materialsClient.GetPlanningArchive(ctx, &materials.MaterialId{Id: id, CompanyId: companyId})
 	if err != nil {
-		if err.Error() == "rpc error: code = Unknown desc = sql: no rows in result set" {
-			return Material{}, domain.ErrMaterialNotFound
-		}
-
 		return Material{}, err
 	}
 
@@ -541,20 +584,18 @@ func (mc *MaterialsClient) GetPlanningArchiveById(ctx context.Context, id int64)
 		IncomingDeliveryNumber: resp.IncomingDeliveryNumber,
 		OtherFields:            otherFields,
 		CompanyID:              resp.CompanyId,
+		BinID:                  resp.BinId,
+		CategoryID:             resp.CategoryId,
 	}, nil
 }
 
-func (mc *MaterialsClient) GetPurchasedArchiveById(ctx context.Context, id int64) (Material, error) {
+func (mc *MaterialsClient) GetPurchasedArchiveById(ctx context.Context, id, companyId int64) (Material, error) {
 	if id <= 0 {
 		return Material{}, errors.New("materials, grpc client - invalid id")
 	}
 
-	resp, err := mc.materialsClient.GetPurchasedArchive(ctx, &materials.MaterialId{Id: id})
+	resp, err := mc.materialsClient.GetPurchasedArchive(ctx, &materials.MaterialId{Id: id, CompanyId: companyId})
 	if err != nil {
-		if err.Error() == "rpc error: code = Unknown desc = sql: no rows in result set" {
-			return Material{}, domain.ErrMaterialNotFound
-		}
-
 		return Material{}, err
 	}
 
@@ -593,28 +634,25 @@ func (mc *MaterialsClient) GetPurchasedArchiveById(ctx context.Context, id int64
 		IncomingDeliveryNumber: resp.IncomingDeliveryNumber,
 		OtherFields:            otherFields,
 		CompanyID:              resp.CompanyId,
+		BinID:                  resp.BinId,
+		CategoryID:             resp.CategoryId,
 	}, nil
 }
 
-func (mc *MaterialsClient) GetListPlanningArchive(ctx context.Context, params MaterialParams) ([]Material, error) {
-	var mtrls []Material
-
-	resp, err := mc.materialsClient.GetListPlanningArchive(ctx, &materials.MaterialParams{
-		Limit:     params.Limit,
-		Offset:    params.Offset,
-		CompanyId: params.CompanyId,
-	})
+func (mc *MaterialsClient) GetListPlanningArchive(ctx context.Context, params MaterialParams) (MaterialPage, error) {
+	resp, err := mc.materialsClient.GetListPlanningArchive(ctx, materialParamsProto(params))
 	if err != nil {
-		return nil, err
+		return MaterialPage{}, err
 	}
 
+	page := MaterialPage{NextCursor: resp.NextCursor, Total: resp.Total}
 	for _, mtrl := range resp.Materials {
 		var otherFields map[string]interface{}
 		if err = json.Unmarshal([]byte(mtrl.OtherFields), &otherFields); err != nil {
-			return nil, err
+			return MaterialPage{}, err
 		}
 
-		mtrls = append(mtrls, Material{
+		page.Materials = append(page.Materials, Material{
 			ID:                     mtrl.Id,
 			WarehouseID:            mtrl.WarehouseId,
 			ItemID:                 mtrl.ItemId,
@@ -644,31 +682,28 @@ func (mc *MaterialsClient) GetListPlanningArchive(ctx context.Context, params Ma
 			IncomingDeliveryNumber: mtrl.IncomingDeliveryNumber,
 			OtherFields:            otherFields,
 			CompanyID:              mtrl.CompanyId,
+			BinID:                  mtrl.BinId,
+			CategoryID:             mtrl.CategoryId,
 		})
 	}
 
-	return mtrls, nil
+	return page, nil
 }
 
-func (mc *MaterialsClient) GetListPurchasedArchive(ctx context.Context, params MaterialParams) ([]Material, error) {
-	var mtrls []Material
-
-	resp, err := mc.materialsClient.GetListPurchasedArchive(ctx, &materials.MaterialParams{
-		Limit:     params.Limit,
-		Offset:    params.Offset,
-		CompanyId: params.CompanyId,
-	})
+func (mc *MaterialsClient) GetListPurchasedArchive(ctx context.Context, params MaterialParams) (MaterialPage, error) {
+	resp, err := mc.materialsClient.GetListPurchasedArchive(ctx, materialParamsProto(params))
 	if err != nil {
-		return nil, err
+		return MaterialPage{}, err
 	}
 
+	page := MaterialPage{NextCursor: resp.NextCursor, Total: resp.Total}
 	for _, mtrl := range resp.Materials {
 		var otherFields map[string]interface{}
 		if err = json.Unmarshal([]byte(mtrl.OtherFields), &otherFields); err != nil {
-			return nil, err
+			return MaterialPage{}, err
 		}
 
-		mtrls = append(mtrls, Material{
+		page.Materials = append(page.Materials, Material{
 			ID:                     mtrl.Id,
 			WarehouseID:            mtrl.WarehouseId,
 			ItemID:                 mtrl.ItemId,
@@ -698,19 +733,21 @@ func (mc *MaterialsClient) GetListPurchasedArchive(ctx context.Context, params M
 			IncomingDeliveryNumber: mtrl.IncomingDeliveryNumber,
 			OtherFields:            otherFields,
 			CompanyID:              mtrl.CompanyId,
+			BinID:                  mtrl.BinId,
+			CategoryID:             mtrl.CategoryId,
 		})
 	}
 
-	return mtrls, nil
+	return page, nil
 }
 
-func (mc *MaterialsClient) DeletePlanningArchiveById(ctx context.Context, id int64) error {
-	_, err := mc.materialsClient.DeletePlanningArchive(ctx, &materials.MaterialId{Id: id})
+func (mc *MaterialsClient) DeletePlanningArchiveById(ctx context.Context, id, companyId int64) error {
+	_, err := mc.materialsClient.DeletePlanningArchive(ctx, &materials.MaterialId{Id: id, CompanyId: companyId})
 	return err
 }
 
-func (mc *MaterialsClient) DeletePurchasedArchiveById(ctx context.Context, id int64) error {
-	_, err := mc.materialsClient.DeletePurchasedArchive(ctx, &materials.MaterialId{Id: id})
+func (mc *MaterialsClient) DeletePurchasedArchiveById(ctx context.Context, id, companyId int64) error {
+	_, err := mc.materialsClient.DeletePurchasedArchive(ctx, &materials.MaterialId{Id: id, CompanyId: companyId})
 	return err
 }
 
@@ -763,6 +800,8 @@ func (mc *MaterialsClient) SearchMaterial(ctx context.Context, param MaterialPar
 			IncomingDeliveryNumber: mtrl.IncomingDeliveryNumber,
 			OtherFields:            otherFields,
 			CompanyID:              mtrl.CompanyId,
+			BinID:                  mtrl.BinId,
+			CategoryID:             mtrl.CategoryId,
 		})
 	}
 
@@ -779,6 +818,7 @@ func (mc *MaterialsClient) CreateMaterialCategory(ctx context.Context, category
 		UpdatedAt:   timestamppb.New(category.UpdatedAt),
 		IsActive:    category.IsActive,
 		ImgUrl:      category.ImgURL,
+		ParentId:    category.ParentID,
 	})
 	if err != nil {
 		return 0, err
@@ -807,6 +847,10 @@ func (mc *MaterialsClient) GetByIdMaterialCategory(ctx context.Context, id, comp
 		UpdatedAt:   resp.UpdatedAt.AsTime(),
 		IsActive:    resp.IsActive,
 		ImgURL:      resp.ImgUrl,
+		DeletedAt:   optionalTime(resp.DeletedAt),
+		ParentID:    resp.ParentId,
+		Path:        resp.Path,
+		Depth:       resp.Depth,
 	}, err
 }
 
@@ -835,14 +879,44 @@ func (mc *MaterialsClient) DeleteMaterialCategory(ctx context.Context, id, compa
 	return err
 }
 
+func (mc *MaterialsClient) RestoreMaterialCategory(ctx context.Context, id, companyId int64) error {
+	_, err := mc.materialsClient.RestoreMaterialCategory(ctx, &materials.MaterialCategoryId{Id: id, CompanyId: companyId})
+	return err
+}
+
+// MoveMaterialCategory переносит категорию вместе с вложенными под parentId, 0 - в корень
+func (mc *MaterialsClient) MoveMaterialCategory(ctx context.Context, id, companyId, parentId int64) error {
+	_, err := mc.materialsClient.MoveMaterialCategory(ctx, &materials.MoveCategoryRequest{
+		Id:        id,
+		ParentId:  parentId,
+		CompanyId: companyId,
+	})
+	return err
+}
+
+// ReparentMaterialCategories переносит вложенные категории fromId под parentId и возвращает их количество
+func (mc *MaterialsClient) ReparentMaterialCategories(ctx context.Context, fromId, companyId, parentId int64) (int64, error) {
+	resp, err := mc.materialsClient.ReparentMaterialCategories(ctx, &materials.ReparentCategoriesRequest{
+		FromId:    fromId,
+		ParentId:  parentId,
+		CompanyId: companyId,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Count, nil
+}
+
 func (mc *MaterialsClient) GetListMaterialCategory(ctx context.Context, param MaterialParams) ([]MaterialCategory, error) {
 	var categories []MaterialCategory
 
 	resp, err := mc.materialsClient.GetListMaterialCategory(ctx, &materials.MaterialParams{
-		Limit:     param.Limit,
-		Offset:    param.Offset,
-		CompanyId: param.CompanyId,
-		Query:     param.Query,
+		Limit:          param.Limit,
+		Offset:         param.Offset,
+		CompanyId:      param.CompanyId,
+		Query:          param.Query,
+		IncludeDeleted: param.IncludeDeleted,
 	})
 	if err != nil {
 		return nil, err
@@ -859,6 +933,10 @@ func (mc *MaterialsClient) GetListMaterialCategory(ctx context.Context, param Ma
 			UpdatedAt:   c.UpdatedAt.AsTime(),
 			IsActive:    c.IsActive,
 			ImgURL:      c.ImgUrl,
+			DeletedAt:   optionalTime(c.DeletedAt),
+			ParentID:    c.ParentId,
+			Path:        c.Path,
+			Depth:       c.Depth,
 		})
 	}
 
@@ -869,10 +947,11 @@ func (mc *MaterialsClient) SearchMaterialCategory(ctx context.Context, param Mat
 	var categories []MaterialCategory
 
 	resp, err := mc.materialsClient.SearchMaterialCategory(ctx, &materials.MaterialParams{
-		Limit:     param.Limit,
-		Offset:    param.Offset,
-		CompanyId: param.CompanyId,
-		Query:     param.Query,
+		Limit:          param.Limit,
+		Offset:         param.Offset,
+		CompanyId:      param.CompanyId,
+		Query:          param.Query,
+		IncludeDeleted: param.IncludeDeleted,
 	})
 	if err != nil {
 		return nil, err
@@ -889,8 +968,188 @@ func (mc *MaterialsClient) SearchMaterialCategory(ctx context.Context, param Mat
 			UpdatedAt:   c.UpdatedAt.AsTime(),
 			IsActive:    c.IsActive,
 			ImgURL:      c.ImgUrl,
+			DeletedAt:   optionalTime(c.DeletedAt),
+			ParentID:    c.ParentId,
+			Path:        c.Path,
+			Depth:       c.Depth,
 		})
 	}
 
 	return categories, nil
 }
+
+// GetExpiring возвращает партии, срок годности которых истекает в пределах withinDays дней, 0 - окно по умолчанию
+func (mc *MaterialsClient) GetExpiring(ctx context.Context, param MaterialParams, warehouseId, withinDays int64) ([]Material, error) {
+	var mtrls []Material
+
+	resp, err := mc.materialsClient.GetExpiring(ctx, &materials.ExpirationParams{
+		Limit:       param.Limit,
+		Offset:      param.Offset,
+		CompanyId:   param.CompanyId,
+		WarehouseId: warehouseId,
+		WithinDays:  withinDays,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mtrl := range resp.Materials {
+		var otherFields map[string]interface{}
+		if err = json.Unmarshal([]byte(mtrl.OtherFields), &otherFields); err != nil {
+			return nil, err
+		}
+
+		mtrls = append(mtrls, Material{
+			ID:                     mtrl.Id,
+			WarehouseID:            mtrl.WarehouseId,
+			ItemID:                 mtrl.ItemId,
+			Name:                   mtrl.Name,
+			ByInvoice:              mtrl.ByInvoice,
+			Article:                mtrl.Article,
+			ProductCategory:        mtrl.ProductCategory,
+			Unit:                   mtrl.Unit,
+			TotalQuantity:          mtrl.TotalQuantity,
+			Volume:                 mtrl.Volume,
+			PriceWithoutVAT:        mtrl.PriceWithoutVat,
+			TotalWithoutVAT:        mtrl.TotalWithoutVat,
+			SupplierID:             mtrl.SupplierId,
+			Location:               mtrl.Location,
+			Contract:               mtrl.Contract.AsTime(),
+			File:                   mtrl.File,
+			Status:                 mtrl.Status,
+			Comments:               mtrl.Comments,
+			Reserve:                mtrl.Reserve,
+			ReceivedDate:           mtrl.ReceivedDate.AsTime(),
+			LastUpdated:            mtrl.LastUpdated.AsTime(),
+			MinStockLevel:          mtrl.MinStockLevel,
+			ExpirationDate:         mtrl.ExpirationDate.AsTime(),
+			ResponsiblePerson:      mtrl.ResponsiblePerson,
+			StorageCost:            mtrl.StorageCost,
+			WarehouseSection:       mtrl.WarehouseSection,
+			IncomingDeliveryNumber: mtrl.IncomingDeliveryNumber,
+			OtherFields:            otherFields,
+			CompanyID:              mtrl.CompanyId,
+			BinID:                  mtrl.BinId,
+			CategoryID:             mtrl.CategoryId,
+		})
+	}
+
+	return mtrls, nil
+}
+
+func (mc *MaterialsClient) QuarantineExpired(ctx context.Context, companyId int64) (int64, error) {
+	resp, err := mc.materialsClient.QuarantineExpired(ctx, &materials.QuarantineRequest{CompanyId: companyId})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Count, nil
+}
+
+// SuggestFefo подбирает партии товара для отпуска quantity в порядке FEFO, warehouseId = 0 - по всем складам
+func (mc *MaterialsClient) SuggestFefo(ctx context.Context, companyId, itemId, warehouseId, quantity int64) (domain.FefoSuggestion, error) {
+	resp, err := mc.materialsClient.SuggestFefo(ctx, &materials.FefoRequest{
+		CompanyId:   companyId,
+		ItemId:      itemId,
+		WarehouseId: warehouseId,
+		Quantity:    quantity,
+	})
+	if err != nil {
+		return domain.FefoSuggestion{}, err
+	}
+
+	suggestion := domain.FefoSuggestion{
+		ItemID:    resp.ItemId,
+		Requested: resp.Requested,
+		Shortage:  resp.Shortage,
+	}
+
+	for _, p := range resp.Picks {
+		suggestion.Picks = append(suggestion.Picks, domain.FefoPick{
+			MaterialID:     p.MaterialId,
+			WarehouseID:    p.WarehouseId,
+			Quantity:       p.Quantity,
+			Available:      p.Available,
+			ExpirationDate: optionalTime(p.ExpirationDate),
+		})
+	}
+
+	return suggestion, nil
+}
+
+// GetQRCode возвращает PNG с QR-кодом купленной партии и закодированный в нем текст
+func (mc *MaterialsClient) GetQRCode(ctx context.Context, id, companyId int64) ([]byte, string, error) {
+	if id <= 0 {
+		return nil, "", errors.New("materials, grpc client - invalid id")
+	}
+
+	resp, err := mc.materialsClient.GetQRCode(ctx, &materials.MaterialId{Id: id, CompanyId: companyId})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return resp.Png, resp.Payload, nil
+}
+
+// GetLabels возвращает PDF с этикетками купленных партий в порядке ids
+func (mc *MaterialsClient) GetLabels(ctx context.Context, ids []int64, companyId int64) ([]byte, error) {
+	resp, err := mc.materialsClient.GetLabels(ctx, &materials.LabelsRequest{Ids: ids, CompanyId: companyId})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Pdf, nil
+}
+
+// ScanQR находит купленную партию по тексту отсканированного QR-кода
+func (mc *MaterialsClient) ScanQR(ctx context.Context, payload string, companyId int64) (Material, error) {
+	resp, err := mc.materialsClient.ScanQR(ctx, &materials.ScanRequest{Payload: payload, CompanyId: companyId})
+	if err != nil {
+		return Material{}, err
+	}
+
+	var otherFields map[string]interface{}
+	if err = json.Unmarshal([]byte(resp.OtherFields), &otherFields); err != nil {
+		return Material{}, err
+	}
+
+	return Material{
+		ID:                     resp.Id,
+		WarehouseID:            resp.WarehouseId,
+		ItemID:                 resp.ItemId,
+		Name:                   resp.Name,
+		ByInvoice:              resp.ByInvoice,
+		Article:                resp.Article,
+		ProductCategory:        resp.ProductCategory,
+		Unit:                   resp.Unit,
+		TotalQuantity:          resp.TotalQuantity,
+		Volume:                 resp.Volume,
+		PriceWithoutVAT:        resp.PriceWithoutVat,
+		TotalWithoutVAT:        resp.TotalWithoutVat,
+		SupplierID:             resp.SupplierId,
+		Location:               resp.Location,
+		Contract:               resp.Contract.AsTime(),
+		File:                   resp.File,
+		Status:                 resp.Status,
+		Comments:               resp.Comments,
+		Reserve:                resp.Reserve,
+		ReceivedDate:           resp.ReceivedDate.AsTime(),
+		LastUpdated:            resp.LastUpdated.AsTime(),
+		MinStockLevel:          resp.MinStockLevel,
+		ExpirationDate:         resp.ExpirationDate.AsTime(),
+		ResponsiblePerson:      resp.ResponsiblePerson,
+		StorageCost:            resp.StorageCost,
+		WarehouseSection:       resp.WarehouseSection,
+		IncomingDeliveryNumber: resp.IncomingDeliveryNumber,
+		OtherFields:            otherFields,
+		CompanyID:              resp.CompanyId,
+		BinID:                  resp.BinId,
+		CategoryID:             resp.CategoryId,
+	}, nil
+}
+
+// PutAway размещает купленную партию в ячейке ее склада
+func (mc *MaterialsClient) PutAway(ctx context.Context, id, companyId, binId int64) error {
+	_, err := mc.materialsClient.PutAway(ctx, &materials.PutAwayRequest{Id: id, CompanyId: companyId, BinId: binId})
+	return err
+}
@@ -0,0 +1,120 @@
+package grpc
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/movements"
+	"google.golang.org/grpc"
+)
+
+type MovementsClient struct {
+	conn            *grpc.ClientConn
+	movementsClient movements.MovementServiceClient
+}
+
+func NewMovementsClient(addr string) (*MovementsClient, error) {
+	opt := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(errorInterceptor),
+	}
+
+	conn, err := grpc.Dial(addr, opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MovementsClient{
+		conn:            conn,
+		movementsClient: movements.NewMovementServiceClient(conn),
+	}, nil
+}
+
+func (mc *MovementsClient) Close() error {
+	return mc.conn.Close()
+}
+
+// Create проводит движение по партии, для перемещения возвращает две записи: расход и приход
+func (mc *MovementsClient) Create(ctx context.Context, req domain.MovementRequest) ([]domain.Movement, error) {
+	resp, err := mc.movementsClient.Create(ctx, &movements.MovementRequest{
+		CompanyId:     req.CompanyID,
+		MaterialId:    req.MaterialID,
+		Type:          req.Type,
+		Quantity:      req.Quantity,
+		ToWarehouseId: req.ToWarehouseID,
+		Reference:     req.Reference,
+		Comment:       req.Comment,
+		CreatedBy:     req.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fromMovementList(resp), nil
+}
+
+func (mc *MovementsClient) GetById(ctx context.Context, id, companyId int64) (domain.Movement, error) {
+	resp, err := mc.movementsClient.GetById(ctx, &movements.MovementId{Id: id, CompanyId: companyId})
+	if err != nil {
+		return domain.Movement{}, err
+	}
+
+	return fromMovementProto(resp), nil
+}
+
+func (mc *MovementsClient) GetList(ctx context.Context, params domain.MovementParams) ([]domain.Movement, error) {
+	resp, err := mc.movementsClient.GetList(ctx, &movements.MovementParams{
+		Limit:       params.Limit,
+		Offset:      params.Offset,
+		CompanyId:   params.CompanyId,
+		MaterialId:  params.MaterialId,
+		ItemId:      params.ItemId,
+		WarehouseId: params.WarehouseId,
+		Type:        params.Type,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fromMovementList(resp), nil
+}
+
+func (mc *MovementsClient) GetStockOnHand(ctx context.Context, params domain.StockParams) (int64, error) {
+	resp, err := mc.movementsClient.GetStockOnHand(ctx, &movements.StockParams{
+		CompanyId:   params.CompanyId,
+		MaterialId:  params.MaterialId,
+		ItemId:      params.ItemId,
+		WarehouseId: params.WarehouseId,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Quantity, nil
+}
+
+func fromMovementList(list *movements.MovementList) []domain.Movement {
+	mvs := make([]domain.Movement, 0, len(list.Movements))
+	for _, m := range list.Movements {
+		mvs = append(mvs, fromMovementProto(m))
+	}
+
+	return mvs
+}
+
+func fromMovementProto(m *movements.Movement) domain.Movement {
+	return domain.Movement{
+		ID:                m.Id,
+		CompanyID:         m.CompanyId,
+		MaterialID:        m.MaterialId,
+		ItemID:            m.ItemId,
+		WarehouseID:       m.WarehouseId,
+		Type:              m.Type,
+		Quantity:          m.Quantity,
+		BalanceAfter:      m.BalanceAfter,
+		RelatedMovementID: m.RelatedMovementId,
+		Reference:         m.Reference,
+		Comment:           m.Comment,
+		CreatedBy:         m.CreatedBy,
+		CreatedAt:         m.CreatedAt.AsTime(),
+	}
+}
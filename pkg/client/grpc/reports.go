@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/reports"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ReportsClient struct {
+	conn          *grpc.ClientConn
+	reportsClient reports.ReportServiceClient
+}
+
+func NewReportsClient(addr string) (*ReportsClient, error) {
+	opt := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(errorInterceptor),
+	}
+
+	conn, err := grpc.Dial(addr, opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReportsClient{
+		conn:          conn,
+		reportsClient: reports.NewReportServiceClient(conn),
+	}, nil
+}
+
+func (rc *ReportsClient) Close() error {
+	return rc.conn.Close()
+}
+
+func (rc *ReportsClient) GetStockOnDate(ctx context.Context, params domain.StockOnDateParams) ([]domain.StockBalance, error) {
+	resp, err := rc.reportsClient.GetStockOnDate(ctx, &reports.StockOnDateRequest{
+		CompanyId:   params.CompanyId,
+		WarehouseId: params.WarehouseId,
+		Date:        timestamppb.New(params.Date),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]domain.StockBalance, 0, len(resp.Balances))
+	for _, b := range resp.Balances {
+		balances = append(balances, domain.StockBalance{
+			ItemID:      b.ItemId,
+			WarehouseID: b.WarehouseId,
+			Category:    b.Category,
+			Quantity:    b.Quantity,
+			Value:       b.Value,
+		})
+	}
+
+	return balances, nil
+}
+
+func (rc *ReportsClient) GetCategoryTurnover(ctx context.Context, params domain.PeriodParams) ([]domain.CategoryTurnover, error) {
+	resp, err := rc.reportsClient.GetCategoryTurnover(ctx, toPeriodRequest(params))
+	if err != nil {
+		return nil, err
+	}
+
+	turnover := make([]domain.CategoryTurnover, 0, len(resp.Categories))
+	for _, t := range resp.Categories {
+		turnover = append(turnover, domain.CategoryTurnover{
+			Category: t.Category,
+			Opening:  t.Opening,
+			Received: t.Received,
+			Issued:   t.Issued,
+			Closing:  t.Closing,
+			Turnover: t.Turnover,
+		})
+	}
+
+	return turnover, nil
+}
+
+func (rc *ReportsClient) GetSupplierSpend(ctx context.Context, params domain.PeriodParams) ([]domain.SupplierSpend, error) {
+	resp, err := rc.reportsClient.GetSupplierSpend(ctx, toPeriodRequest(params))
+	if err != nil {
+		return nil, err
+	}
+
+	spend := make([]domain.SupplierSpend, 0, len(resp.Suppliers))
+	for _, s := range resp.Suppliers {
+		spend = append(spend, domain.SupplierSpend{
+			SupplierID: s.SupplierId,
+			Month:      s.Month.AsTime(),
+			Lots:       s.Lots,
+			Quantity:   s.Quantity,
+			Amount:     s.Amount,
+		})
+	}
+
+	return spend, nil
+}
+
+func toPeriodRequest(params domain.PeriodParams) *reports.PeriodRequest {
+	return &reports.PeriodRequest{
+		CompanyId: params.CompanyId,
+		From:      timestamppb.New(params.From),
+		To:        timestamppb.New(params.To),
+	}
+}
@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/reservations"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ReservationsClient struct {
+	conn               *grpc.ClientConn
+	reservationsClient reservations.ReservationServiceClient
+}
+
+func NewReservationsClient(addr string) (*ReservationsClient, error) {
+	opt := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(errorInterceptor),
+	}
+
+	conn, err := grpc.Dial(addr, opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReservationsClient{
+		conn:               conn,
+		reservationsClient: reservations.NewReservationServiceClient(conn),
+	}, nil
+}
+
+func (rc *ReservationsClient) Close() error {
+	return rc.conn.Close()
+}
+
+func (rc *ReservationsClient) Reserve(ctx context.Context, reservation domain.Reservation) (int64, error) {
+	var expiresAt *timestamppb.Timestamp
+	if !reservation.ExpiresAt.IsZero() {
+		expiresAt = timestamppb.New(reservation.ExpiresAt)
+	}
+
+	resp, err := rc.reservationsClient.Reserve(ctx, &reservations.Reservation{
+		CompanyId:      reservation.CompanyID,
+		MaterialId:     reservation.MaterialID,
+		Quantity:       reservation.Quantity,
+		OrderReference: reservation.OrderReference,
+		OwnerId:        reservation.OwnerID,
+		Comment:        reservation.Comment,
+		ExpiresAt:      expiresAt,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Id, nil
+}
+
+func (rc *ReservationsClient) GetById(ctx context.Context, id, companyId int64) (domain.Reservation, error) {
+	resp, err := rc.reservationsClient.GetById(ctx, &reservations.ReservationId{Id: id, CompanyId: companyId})
+	if err != nil {
+		return domain.Reservation{}, err
+	}
+
+	return fromReservationProto(resp), nil
+}
+
+func (rc *ReservationsClient) GetList(ctx context.Context, params domain.ReservationParams) ([]domain.Reservation, error) {
+	resp, err := rc.reservationsClient.GetList(ctx, &reservations.ReservationParams{
+		Limit:          params.Limit,
+		Offset:         params.Offset,
+		CompanyId:      params.CompanyId,
+		MaterialId:     params.MaterialId,
+		OrderReference: params.OrderReference,
+		Status:         params.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]domain.Reservation, 0, len(resp.Reservations))
+	for _, r := range resp.Reservations {
+		list = append(list, fromReservationProto(r))
+	}
+
+	return list, nil
+}
+
+func (rc *ReservationsClient) Release(ctx context.Context, id, companyId int64) error {
+	_, err := rc.reservationsClient.Release(ctx, &reservations.ReservationId{Id: id, CompanyId: companyId})
+	return err
+}
+
+// Consume расходует резерв и возвращает id записи журнала движений и остаток партии после отпуска
+func (rc *ReservationsClient) Consume(ctx context.Context, id, companyId, quantity, userId int64) (int64, int64, error) {
+	resp, err := rc.reservationsClient.Consume(ctx, &reservations.ConsumeRequest{
+		Id:        id,
+		CompanyId: companyId,
+		UserId:    userId,
+		Quantity:  quantity,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return resp.MovementId, resp.BalanceAfter, nil
+}
+
+func (rc *ReservationsClient) GetAvailability(ctx context.Context, materialId, companyId int64) (domain.Availability, error) {
+	resp, err := rc.reservationsClient.GetAvailability(ctx, &reservations.AvailabilityRequest{
+		MaterialId: materialId,
+		CompanyId:  companyId,
+	})
+	if err != nil {
+		return domain.Availability{}, err
+	}
+
+	return domain.Availability{
+		MaterialID: resp.MaterialId,
+		OnHand:     resp.OnHand,
+		Reserved:   resp.Reserved,
+		Available:  resp.Available,
+	}, nil
+}
+
+func fromReservationProto(r *reservations.Reservation) domain.Reservation {
+	return domain.Reservation{
+		ID:               r.Id,
+		CompanyID:        r.CompanyId,
+		MaterialID:       r.MaterialId,
+		ItemID:           r.ItemId,
+		WarehouseID:      r.WarehouseId,
+		Quantity:         r.Quantity,
+		ConsumedQuantity: r.ConsumedQuantity,
+		OrderReference:   r.OrderReference,
+		OwnerID:          r.OwnerId,
+		Status:           r.Status,
+		Comment:          r.Comment,
+		ExpiresAt:        optionalTime(r.ExpiresAt),
+		CreatedAt:        r.CreatedAt.AsTime(),
+		UpdatedAt:        r.UpdatedAt.AsTime(),
+		ClosedAt:         optionalTime(r.ClosedAt),
+	}
+}
@@ -0,0 +1,196 @@
+package grpc
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/stocktakes"
+	"google.golang.org/grpc"
+)
+
+type StocktakesClient struct {
+	conn             *grpc.ClientConn
+	stocktakesClient stocktakes.StocktakeServiceClient
+}
+
+func NewStocktakesClient(addr string) (*StocktakesClient, error) {
+	opt := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(errorInterceptor),
+	}
+
+	conn, err := grpc.Dial(addr, opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StocktakesClient{
+		conn:             conn,
+		stocktakesClient: stocktakes.NewStocktakeServiceClient(conn),
+	}, nil
+}
+
+func (sc *StocktakesClient) Close() error {
+	return sc.conn.Close()
+}
+
+func (sc *StocktakesClient) Open(ctx context.Context, stocktake domain.Stocktake) (int64, error) {
+	resp, err := sc.stocktakesClient.Open(ctx, &stocktakes.Stocktake{
+		CompanyId:   stocktake.CompanyID,
+		WarehouseId: stocktake.WarehouseID,
+		ZoneId:      stocktake.ZoneID,
+		Comment:     stocktake.Comment,
+		CreatedBy:   stocktake.CreatedBy,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Id, nil
+}
+
+func (sc *StocktakesClient) GetById(ctx context.Context, id, companyId int64) (domain.Stocktake, error) {
+	resp, err := sc.stocktakesClient.GetById(ctx, &stocktakes.StocktakeId{Id: id, CompanyId: companyId})
+	if err != nil {
+		return domain.Stocktake{}, err
+	}
+
+	return fromStocktakeProto(resp), nil
+}
+
+func (sc *StocktakesClient) GetList(ctx context.Context, params domain.StocktakeParams) ([]domain.Stocktake, error) {
+	resp, err := sc.stocktakesClient.GetList(ctx, &stocktakes.StocktakeParams{
+		Limit:       params.Limit,
+		Offset:      params.Offset,
+		CompanyId:   params.CompanyId,
+		WarehouseId: params.WarehouseId,
+		Status:      params.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]domain.Stocktake, 0, len(resp.Stocktakes))
+	for _, s := range resp.Stocktakes {
+		list = append(list, fromStocktakeProto(s))
+	}
+
+	return list, nil
+}
+
+// Count записывает подсчет партии, партия задается одним из MaterialID, ItemID или QRPayload
+func (sc *StocktakesClient) Count(ctx context.Context, req domain.StocktakeCountRequest) (domain.StocktakeLine, error) {
+	resp, err := sc.stocktakesClient.Count(ctx, &stocktakes.CountRequest{
+		Id:         req.StocktakeID,
+		CompanyId:  req.CompanyID,
+		MaterialId: req.MaterialID,
+		ItemId:     req.ItemID,
+		QrPayload:  req.QRPayload,
+		Quantity:   req.Quantity,
+		UserId:     req.CountedBy,
+	})
+	if err != nil {
+		return domain.StocktakeLine{}, err
+	}
+
+	return fromStocktakeLineProto(resp), nil
+}
+
+func (sc *StocktakesClient) GetCounts(ctx context.Context, id, companyId int64) ([]domain.StocktakeCount, error) {
+	resp, err := sc.stocktakesClient.GetCounts(ctx, &stocktakes.StocktakeId{Id: id, CompanyId: companyId})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]domain.StocktakeCount, 0, len(resp.Counts))
+	for _, c := range resp.Counts {
+		counts = append(counts, domain.StocktakeCount{
+			ID:          c.Id,
+			StocktakeID: c.StocktakeId,
+			LineID:      c.LineId,
+			MaterialID:  c.MaterialId,
+			Quantity:    c.Quantity,
+			Source:      c.Source,
+			CountedBy:   c.CountedBy,
+			CountedAt:   c.CountedAt.AsTime(),
+		})
+	}
+
+	return counts, nil
+}
+
+func (sc *StocktakesClient) GetVariance(ctx context.Context, id, companyId int64) (domain.StocktakeVariance, error) {
+	resp, err := sc.stocktakesClient.GetVariance(ctx, &stocktakes.StocktakeId{Id: id, CompanyId: companyId})
+	if err != nil {
+		return domain.StocktakeVariance{}, err
+	}
+
+	lines := make([]domain.StocktakeLine, 0, len(resp.Lines))
+	for _, line := range resp.Lines {
+		lines = append(lines, fromStocktakeLineProto(line))
+	}
+
+	return domain.StocktakeVariance{
+		StocktakeID:   resp.StocktakeId,
+		Lines:         lines,
+		TotalLines:    resp.TotalLines,
+		CountedLines:  resp.CountedLines,
+		Surplus:       resp.Surplus,
+		Shortage:      resp.Shortage,
+		SurplusValue:  resp.SurplusValue,
+		ShortageValue: resp.ShortageValue,
+	}, nil
+}
+
+// Approve утверждает инвентаризацию и возвращает id проведенных корректировок
+func (sc *StocktakesClient) Approve(ctx context.Context, id, companyId, userId int64) ([]int64, error) {
+	resp, err := sc.stocktakesClient.Approve(ctx, &stocktakes.ApproveRequest{Id: id, CompanyId: companyId, UserId: userId})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.MovementIds, nil
+}
+
+func (sc *StocktakesClient) Cancel(ctx context.Context, id, companyId int64) error {
+	_, err := sc.stocktakesClient.Cancel(ctx, &stocktakes.StocktakeId{Id: id, CompanyId: companyId})
+	return err
+}
+
+func fromStocktakeProto(s *stocktakes.Stocktake) domain.Stocktake {
+	lines := make([]domain.StocktakeLine, 0, len(s.Lines))
+	for _, line := range s.Lines {
+		lines = append(lines, fromStocktakeLineProto(line))
+	}
+
+	return domain.Stocktake{
+		ID:          s.Id,
+		CompanyID:   s.CompanyId,
+		WarehouseID: s.WarehouseId,
+		ZoneID:      s.ZoneId,
+		Status:      s.Status,
+		Comment:     s.Comment,
+		CreatedBy:   s.CreatedBy,
+		ApprovedBy:  s.ApprovedBy,
+		CreatedAt:   s.CreatedAt.AsTime(),
+		UpdatedAt:   s.UpdatedAt.AsTime(),
+		ClosedAt:    optionalTime(s.ClosedAt),
+		Lines:       lines,
+	}
+}
+
+func fromStocktakeLineProto(line *stocktakes.StocktakeLine) domain.StocktakeLine {
+	return domain.StocktakeLine{
+		ID:          line.Id,
+		StocktakeID: line.StocktakeId,
+		MaterialID:  line.MaterialId,
+		ItemID:      line.ItemId,
+		Name:        line.Name,
+		Location:    line.Location,
+		Price:       line.Price,
+		Expected:    line.Expected,
+		Counted:     line.Counted,
+		IsCounted:   line.IsCounted,
+		CountedBy:   line.CountedBy,
+		CountedAt:   optionalTime(line.CountedAt),
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"github.com/rusystem/crm-warehouse/pkg/domain"
 	"github.com/rusystem/crm-warehouse/pkg/gen/proto/supplier"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -37,6 +36,7 @@ type Supplier struct {
 	IsActive          bool                   `json:"is_active"`            // Статус активности поставщика (активен/неактивен)
 	OtherFields       map[string]interface{} `json:"other_fields"`         // Дополнительные пользовательские поля
 	CompanyId         int64                  `json:"company_id"`           // ID компании
+	DeletedAt         time.Time              `json:"deleted_at"`           // Дата удаления, нулевая - поставщик не удален
 }
 
 type SuppliersClient struct {
@@ -47,6 +47,7 @@ type SuppliersClient struct {
 func NewSuppliersClient(addr string) (*SuppliersClient, error) {
 	opt := []grpc.DialOption{
 		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(errorInterceptor),
 	}
 
 	conn, err := grpc.Dial(addr, opt...)
@@ -64,17 +65,13 @@ func (s *SuppliersClient) Close() error {
 	return s.conn.Close()
 }
 
-func (s *SuppliersClient) GetById(ctx context.Context, id int64) (Supplier, error) {
+func (s *SuppliersClient) GetById(ctx context.Context, id, companyId int64) (Supplier, error) {
 	if id <= 0 {
 		return Supplier{}, errors.New("suppliers, grpc: id can`t be zero")
 	}
 
-	resp, err := s.supplierClient.GetById(ctx, &supplier.SupplierId{Id: id})
+	resp, err := s.supplierClient.GetById(ctx, &supplier.SupplierId{Id: id, CompanyId: companyId})
 	if err != nil {
-		if err.Error() == "rpc error: code = Unknown desc = sql: no rows in result set" {
-			return Supplier{}, domain.ErrSupplierNotFound
-		}
-
 		return Supplier{}, err
 	}
 
@@ -109,6 +106,7 @@ func (s *SuppliersClient) GetById(ctx context.Context, id int64) (Supplier, erro
 		IsActive:          resp.IsActive,
 		OtherFields:       otherFields,
 		CompanyId:         resp.CompanyId,
+		DeletedAt:         optionalTime(resp.DeletedAt),
 	}, nil
 }
 
@@ -183,6 +181,7 @@ func (s *SuppliersClient) Update(ctx context.Context, spl Supplier) error {
 		PaymentTerms:      spl.PaymentTerms,
 		IsActive:          spl.IsActive,
 		OtherFields:       string(otherFieldsJSON),
+		CompanyId:         spl.CompanyId,
 	})
 	if err != nil {
 		return err
@@ -191,8 +190,13 @@ func (s *SuppliersClient) Update(ctx context.Context, spl Supplier) error {
 	return nil
 }
 
-func (s *SuppliersClient) Delete(ctx context.Context, id int64) error {
-	_, err := s.supplierClient.Delete(ctx, &supplier.SupplierId{Id: id})
+func (s *SuppliersClient) Delete(ctx context.Context, id, companyId int64) error {
+	_, err := s.supplierClient.Delete(ctx, &supplier.SupplierId{Id: id, CompanyId: companyId})
+	return err
+}
+
+func (s *SuppliersClient) Restore(ctx context.Context, id, companyId int64) error {
+	_, err := s.supplierClient.Restore(ctx, &supplier.SupplierId{Id: id, CompanyId: companyId})
 	return err
 }
 
@@ -236,6 +240,7 @@ func (s *SuppliersClient) GetList(ctx context.Context, companyId int64) ([]Suppl
 			IsActive:          sps.IsActive,
 			OtherFields:       otherFields,
 			CompanyId:         sps.CompanyId,
+			DeletedAt:         optionalTime(sps.DeletedAt),
 		})
 	}
 
@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/gen/proto/transfers"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+type TransfersClient struct {
+	conn            *grpc.ClientConn
+	transfersClient transfers.TransferServiceClient
+}
+
+func NewTransfersClient(addr string) (*TransfersClient, error) {
+	opt := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(errorInterceptor),
+	}
+
+	conn, err := grpc.Dial(addr, opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransfersClient{
+		conn:            conn,
+		transfersClient: transfers.NewTransferServiceClient(conn),
+	}, nil
+}
+
+func (tc *TransfersClient) Close() error {
+	return tc.conn.Close()
+}
+
+func (tc *TransfersClient) Create(ctx context.Context, order domain.TransferOrder) (int64, error) {
+	items := make([]*transfers.TransferOrderItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, &transfers.TransferOrderItem{
+			MaterialId: item.MaterialID,
+			Quantity:   item.Quantity,
+		})
+	}
+
+	resp, err := tc.transfersClient.Create(ctx, &transfers.TransferOrder{
+		CompanyId:              order.CompanyID,
+		SourceWarehouseId:      order.SourceWarehouseID,
+		DestinationWarehouseId: order.DestinationWarehouseID,
+		Comment:                order.Comment,
+		CreatedBy:              order.CreatedBy,
+		Items:                  items,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Id, nil
+}
+
+func (tc *TransfersClient) GetById(ctx context.Context, id, companyId int64) (domain.TransferOrder, error) {
+	resp, err := tc.transfersClient.GetById(ctx, &transfers.TransferOrderId{Id: id, CompanyId: companyId})
+	if err != nil {
+		return domain.TransferOrder{}, err
+	}
+
+	return fromTransferOrderProto(resp), nil
+}
+
+func (tc *TransfersClient) GetList(ctx context.Context, params domain.TransferOrderParams) ([]domain.TransferOrder, error) {
+	resp, err := tc.transfersClient.GetList(ctx, &transfers.TransferOrderParams{
+		Limit:       params.Limit,
+		Offset:      params.Offset,
+		CompanyId:   params.CompanyId,
+		WarehouseId: params.WarehouseId,
+		Status:      params.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]domain.TransferOrder, 0, len(resp.Orders))
+	for _, order := range resp.Orders {
+		orders = append(orders, fromTransferOrderProto(order))
+	}
+
+	return orders, nil
+}
+
+func (tc *TransfersClient) Ship(ctx context.Context, id, companyId, userId int64) error {
+	_, err := tc.transfersClient.Ship(ctx, &transfers.ShipRequest{Id: id, CompanyId: companyId, UserId: userId})
+	return err
+}
+
+// Receive принимает на складе-получателе указанные количества по позициям заказа
+func (tc *TransfersClient) Receive(ctx context.Context, id, companyId, userId int64, receipts []domain.TransferReceipt) error {
+	req := &transfers.ReceiveRequest{Id: id, CompanyId: companyId, UserId: userId}
+	for _, r := range receipts {
+		req.Receipts = append(req.Receipts, &transfers.Receipt{ItemId: r.ItemID, Quantity: r.Quantity})
+	}
+
+	_, err := tc.transfersClient.Receive(ctx, req)
+	return err
+}
+
+func (tc *TransfersClient) Cancel(ctx context.Context, id, companyId int64) error {
+	_, err := tc.transfersClient.Cancel(ctx, &transfers.TransferOrderId{Id: id, CompanyId: companyId})
+	return err
+}
+
+func (tc *TransfersClient) GetInTransit(ctx context.Context, params domain.InTransitParams) (int64, error) {
+	resp, err := tc.transfersClient.GetInTransit(ctx, &transfers.InTransitParams{
+		CompanyId:   params.CompanyId,
+		WarehouseId: params.WarehouseId,
+		ItemId:      params.ItemId,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Quantity, nil
+}
+
+func fromTransferOrderProto(order *transfers.TransferOrder) domain.TransferOrder {
+	items := make([]domain.TransferOrderItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, domain.TransferOrderItem{
+			ID:                    item.Id,
+			TransferOrderID:       item.TransferOrderId,
+			MaterialID:            item.MaterialId,
+			ItemID:                item.ItemId,
+			DestinationMaterialID: item.DestinationMaterialId,
+			Quantity:              item.Quantity,
+			ShippedQuantity:       item.ShippedQuantity,
+			ReceivedQuantity:      item.ReceivedQuantity,
+		})
+	}
+
+	return domain.TransferOrder{
+		ID:                     order.Id,
+		CompanyID:              order.CompanyId,
+		SourceWarehouseID:      order.SourceWarehouseId,
+		DestinationWarehouseID: order.DestinationWarehouseId,
+		Status:                 order.Status,
+		Comment:                order.Comment,
+		CreatedBy:              order.CreatedBy,
+		CreatedAt:              order.CreatedAt.AsTime(),
+		UpdatedAt:              order.UpdatedAt.AsTime(),
+		ShippedAt:              optionalTime(order.ShippedAt),
+		ReceivedAt:             optionalTime(order.ReceivedAt),
+		Items:                  items,
+	}
+}
+
+func optionalTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
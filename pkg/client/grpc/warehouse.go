@@ -8,6 +8,7 @@ import (
 	"github.com/rusystem/crm-warehouse/pkg/domain"
 	"github.com/rusystem/crm-warehouse/pkg/gen/proto/warehouse"
 	"google.golang.org/grpc"
+	"time"
 )
 
 type Warehouse struct {
@@ -18,10 +19,12 @@ type Warehouse struct {
 	Phone             string                 `json:"phone"`                // Контактный телефон склада
 	Email             string                 `json:"email"`                // Электронная почта для связи
 	MaxCapacity       int64                  `json:"max_capacity"`         // Максимальная вместимость склада
-	CurrentOccupancy  int64                  `json:"current_occupancy"`    // Текущая заполняемость склада
+	CurrentOccupancy  int64                  `json:"current_occupancy"`    // Объем купленных партий на складе, считается сервисом
+	CapacityPolicy    string                 `json:"capacity_policy"`      // Политика при превышении вместимости: reject или warn
 	OtherFields       map[string]interface{} `json:"other_fields"`         // Дополнительные пользовательские поля
 	Country           string                 `json:"country"`              // Страна склада
 	CompanyId         int64                  `json:"company_id"`           // Уникальный идентификатор компании
+	DeletedAt         time.Time              `json:"deleted_at"`           // Дата удаления, нулевая - склад не удален
 }
 
 type WarehouseClient struct {
@@ -32,6 +35,7 @@ type WarehouseClient struct {
 func NewWarehouseClient(addr string) (*WarehouseClient, error) {
 	opt := []grpc.DialOption{
 		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(errorInterceptor),
 	}
 
 	conn, err := grpc.Dial(addr, opt...)
@@ -49,17 +53,13 @@ func (w *WarehouseClient) Close() error {
 	return w.conn.Close()
 }
 
-func (w *WarehouseClient) GetById(ctx context.Context, id int64) (Warehouse, error) {
+func (w *WarehouseClient) GetById(ctx context.Context, id, companyId int64) (Warehouse, error) {
 	if id <= 0 {
 		return Warehouse{}, errors.New("calls grpc: id can`t be zero")
 	}
 
-	resp, err := w.warehouseClient.GetById(ctx, &warehouse.WarehouseId{Id: id})
+	resp, err := w.warehouseClient.GetById(ctx, &warehouse.WarehouseId{Id: id, CompanyId: companyId})
 	if err != nil {
-		if err.Error() == "rpc error: code = Unknown desc = sql: no rows in result set" {
-			return Warehouse{}, domain.ErrWarehouseNotFound
-		}
-
 		return Warehouse{}, err
 	}
 
@@ -77,9 +77,11 @@ func (w *WarehouseClient) GetById(ctx context.Context, id int64) (Warehouse, err
 		Email:             resp.Email,
 		MaxCapacity:       resp.MaxCapacity,
 		CurrentOccupancy:  resp.CurrentOccupancy,
+		CapacityPolicy:    resp.CapacityPolicy,
 		OtherFields:       otherFields,
 		Country:           resp.Country,
 		CompanyId:         resp.CompanyId,
+		DeletedAt:         optionalTime(resp.DeletedAt),
 	}, nil
 }
 
@@ -98,6 +100,7 @@ func (w *WarehouseClient) Create(ctx context.Context, wh Warehouse) (int64, erro
 		Email:             wh.Email,
 		MaxCapacity:       wh.MaxCapacity,
 		CurrentOccupancy:  wh.CurrentOccupancy,
+		CapacityPolicy:    wh.CapacityPolicy,
 		OtherFields:       string(otherFieldsJSON),
 		Country:           wh.Country,
 		CompanyId:         wh.CompanyId,
@@ -124,8 +127,10 @@ func (w *WarehouseClient) Update(ctx context.Context, wh Warehouse) error {
 		Email:             wh.Email,
 		MaxCapacity:       wh.MaxCapacity,
 		CurrentOccupancy:  wh.CurrentOccupancy,
+		CapacityPolicy:    wh.CapacityPolicy,
 		OtherFields:       string(otherFieldsJSON),
 		Country:           wh.Country,
+		CompanyId:         wh.CompanyId,
 	})
 	if err != nil {
 		return err
@@ -134,8 +139,13 @@ func (w *WarehouseClient) Update(ctx context.Context, wh Warehouse) error {
 	return nil
 }
 
-func (w *WarehouseClient) Delete(ctx context.Context, id int64) error {
-	_, err := w.warehouseClient.Delete(ctx, &warehouse.WarehouseId{Id: id})
+func (w *WarehouseClient) Delete(ctx context.Context, id, companyId int64) error {
+	_, err := w.warehouseClient.Delete(ctx, &warehouse.WarehouseId{Id: id, CompanyId: companyId})
+	return err
+}
+
+func (w *WarehouseClient) Restore(ctx context.Context, id, companyId int64) error {
+	_, err := w.warehouseClient.Restore(ctx, &warehouse.WarehouseId{Id: id, CompanyId: companyId})
 	return err
 }
 
@@ -161,9 +171,11 @@ func (w *WarehouseClient) GetList(ctx context.Context, companyId int64) ([]Wareh
 			Email:             wh.Email,
 			MaxCapacity:       wh.MaxCapacity,
 			CurrentOccupancy:  wh.CurrentOccupancy,
+			CapacityPolicy:    wh.CapacityPolicy,
 			OtherFields:       otherFields,
 			Country:           wh.Country,
 			CompanyId:         wh.CompanyId,
+			DeletedAt:         optionalTime(wh.DeletedAt),
 		})
 	}
 
@@ -211,3 +223,89 @@ func (w *WarehouseClient) GetResponsiblePerson(ctx context.Context, companyId in
 
 	return users, nil
 }
+
+// CreateLocation заводит место хранения склада, у зоны parentId = 0
+func (w *WarehouseClient) CreateLocation(ctx context.Context, location domain.StorageLocation) (int64, error) {
+	resp, err := w.warehouseClient.CreateLocation(ctx, toLocationProto(location))
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Id, nil
+}
+
+func (w *WarehouseClient) GetLocation(ctx context.Context, id, companyId int64) (domain.StorageLocation, error) {
+	if id <= 0 {
+		return domain.StorageLocation{}, errors.New("calls grpc: id can`t be zero")
+	}
+
+	resp, err := w.warehouseClient.GetLocation(ctx, &warehouse.LocationId{Id: id, CompanyId: companyId})
+	if err != nil {
+		return domain.StorageLocation{}, err
+	}
+
+	return fromLocationProto(resp), nil
+}
+
+// UpdateLocation меняет код, название и вместимость места хранения
+func (w *WarehouseClient) UpdateLocation(ctx context.Context, location domain.StorageLocation) error {
+	_, err := w.warehouseClient.UpdateLocation(ctx, toLocationProto(location))
+	return err
+}
+
+func (w *WarehouseClient) DeleteLocation(ctx context.Context, id, companyId int64) error {
+	_, err := w.warehouseClient.DeleteLocation(ctx, &warehouse.LocationId{Id: id, CompanyId: companyId})
+	return err
+}
+
+// GetListLocations места хранения склада, отбор по родителю и уровню необязателен
+func (w *WarehouseClient) GetListLocations(ctx context.Context, params domain.StorageLocationParams) ([]domain.StorageLocation, error) {
+	resp, err := w.warehouseClient.GetListLocations(ctx, &warehouse.LocationParams{
+		Limit:       params.Limit,
+		Offset:      params.Offset,
+		CompanyId:   params.CompanyId,
+		WarehouseId: params.WarehouseId,
+		ParentId:    params.ParentId,
+		Kind:        params.Kind,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]domain.StorageLocation, 0, len(resp.Locations))
+	for _, l := range resp.Locations {
+		locations = append(locations, fromLocationProto(l))
+	}
+
+	return locations, nil
+}
+
+func toLocationProto(l domain.StorageLocation) *warehouse.StorageLocation {
+	return &warehouse.StorageLocation{
+		Id:          l.ID,
+		CompanyId:   l.CompanyID,
+		WarehouseId: l.WarehouseID,
+		ParentId:    l.ParentID,
+		Kind:        l.Kind,
+		Code:        l.Code,
+		Name:        l.Name,
+		Capacity:    l.Capacity,
+	}
+}
+
+func fromLocationProto(l *warehouse.StorageLocation) domain.StorageLocation {
+	return domain.StorageLocation{
+		ID:          l.Id,
+		CompanyID:   l.CompanyId,
+		WarehouseID: l.WarehouseId,
+		ParentID:    l.ParentId,
+		Kind:        l.Kind,
+		Code:        l.Code,
+		Name:        l.Name,
+		Capacity:    l.Capacity,
+		Occupancy:   l.Occupancy,
+		Address:     l.Address,
+		CreatedAt:   l.CreatedAt.AsTime(),
+		UpdatedAt:   l.UpdatedAt.AsTime(),
+	}
+}
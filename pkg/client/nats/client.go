@@ -0,0 +1,91 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	natsio "github.com/nats-io/nats.go"
+	clientGrpc "github.com/rusystem/crm-warehouse/pkg/client/grpc"
+	"github.com/rusystem/crm-warehouse/pkg/mq"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"time"
+)
+
+// Conn реализует grpc.ClientConnInterface поверх NATS request-reply, поэтому подходит
+// для сгенерированных клиентов, например materials.NewMaterialServiceClient(conn).
+// Ошибки возвращаются так же, как клиентами pkg/client/grpc.
+type Conn struct {
+	nc      *natsio.Conn
+	prefix  string
+	timeout time.Duration
+}
+
+// NewConn prefix - subject_prefix сервиса, timeout применяется к вызовам без дедлайна в контексте
+func NewConn(nc *natsio.Conn, prefix string, timeout time.Duration) *Conn {
+	return &Conn{
+		nc:      nc,
+		prefix:  prefix,
+		timeout: timeout,
+	}
+}
+
+func (c *Conn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, _ ...grpc.CallOption) error {
+	data, err := proto.Marshal(args.(proto.Message))
+	if err != nil {
+		return err
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	msg := natsio.NewMsg(mq.RPCSubject(c.prefix, method))
+	msg.Data = data
+
+	// метаданные вызова, например токен из grpc.WithToken, уходят заголовками запроса
+	md, _ := metadata.FromOutgoingContext(ctx)
+	for k, v := range md {
+		msg.Header[k] = v
+	}
+
+	resp, err := c.nc.RequestMsgWithContext(ctx, msg)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, natsio.ErrTimeout) {
+			return grpcStatus.Error(codes.DeadlineExceeded, err.Error())
+		}
+
+		if errors.Is(err, natsio.ErrNoResponders) {
+			return grpcStatus.Error(codes.Unavailable, err.Error())
+		}
+
+		return err
+	}
+
+	code, err := strconv.Atoi(resp.Header.Get(mq.StatusHeader))
+	if err != nil {
+		return grpcStatus.Error(codes.Internal, "nats rpc: response without status")
+	}
+
+	if codes.Code(code) != codes.OK {
+		st := &status.Status{}
+		if err = proto.Unmarshal(resp.Data, st); err != nil {
+			return clientGrpc.FromStatus(grpcStatus.Error(codes.Code(code), resp.Header.Get(mq.MessageHeader)))
+		}
+
+		return clientGrpc.FromStatus(grpcStatus.ErrorProto(st))
+	}
+
+	return proto.Unmarshal(resp.Data, reply.(proto.Message))
+}
+
+// NewStream потоковые методы через NATS не поддерживаются
+func (c *Conn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, grpcStatus.Error(codes.Unimplemented, "nats rpc: streaming is not supported")
+}
@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"time"
+)
+
+type ClickHouseConfig struct {
+	Addr     []string
+	Database string
+	Username string
+	Password string
+}
+
+// clickHouseSchema - таблицы аналитики. ReplacingMergeTree схлопывает строки, выгруженные повторно
+// после сбоя, поэтому отчеты читают таблицы с FINAL.
+var clickHouseSchema = []string{
+	`CREATE TABLE IF NOT EXISTS material_events
+	(
+		event_id          Int64,
+		event_type        LowCardinality(String),
+		company_id        Int64,
+		material_id       Int64,
+		item_id           Int64,
+		warehouse_id      Int64,
+		supplier_id       Int64,
+		category          LowCardinality(String),
+		name              String,
+		unit              LowCardinality(String),
+		status            LowCardinality(String),
+		total_quantity    Int64,
+		price_without_vat Float64,
+		total_without_vat Float64,
+		payload           String,
+		occurred_at       DateTime64(3)
+	)
+	ENGINE = ReplacingMergeTree
+	PARTITION BY toYYYYMM(occurred_at)
+	ORDER BY (company_id, occurred_at, event_id)`,
+
+	`CREATE TABLE IF NOT EXISTS stock_movements
+	(
+		movement_id       Int64,
+		company_id        Int64,
+		material_id       Int64,
+		item_id           Int64,
+		warehouse_id      Int64,
+		movement_type     LowCardinality(String),
+		quantity          Int64,
+		balance_after     Int64,
+		category          LowCardinality(String),
+		supplier_id       Int64,
+		price_without_vat Float64,
+		created_at        DateTime64(3)
+	)
+	ENGINE = ReplacingMergeTree
+	PARTITION BY toYYYYMM(created_at)
+	ORDER BY (company_id, created_at, movement_id)`,
+}
+
+// NewClickHouseConnection подключается к ClickHouse и создает недостающие таблицы аналитики
+func NewClickHouseConnection(cfg ClickHouseConfig) (driver.Conn, error) {
+	conn, err := clickhouse.Open(&clickhouse.Options{
+		Addr: cfg.Addr,
+		Auth: clickhouse.Auth{
+			Database: cfg.Database,
+			Username: cfg.Username,
+			Password: cfg.Password,
+		},
+		DialTimeout: time.Second * 5,
+		Compression: &clickhouse.Compression{Method: clickhouse.CompressionLZ4},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	if err = conn.Ping(ctx); err != nil {
+		return nil, fmt.Errorf("clickhouse ping: %w", err)
+	}
+
+	for _, ddl := range clickHouseSchema {
+		if err = conn.Exec(ctx, ddl); err != nil {
+			return nil, fmt.Errorf("clickhouse schema: %w", err)
+		}
+	}
+
+	return conn, nil
+}
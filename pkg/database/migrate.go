@@ -0,0 +1,349 @@
+package database
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	migrationsTable = "schema_migrations"
+	// migrationsLockKey произвольный ключ advisory lock, чтобы две реплики не накатывали миграции одновременно
+	migrationsLockKey = 7_241_001
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+var migrationFileRe = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+var (
+	ErrChecksumMismatch = errors.New("migration checksum mismatch")
+	ErrUnknownMigration = errors.New("database has migration unknown to this binary")
+)
+
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string // sha256 от up скрипта
+}
+
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+type appliedMigration struct {
+	version   int64
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+// Migrator накатывает и откатывает встроенные в бинарник sql миграции
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *sql.DB) (*Migrator, error) {
+	migrations, err := loadMigrations(migrationsFS)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+func loadMigrations(fsys fs.FS) ([]Migration, error) {
+	files, err := fs.Glob(fsys, "migrations/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, file := range files {
+		base := file[len("migrations/"):]
+
+		match := migrationFileRe.FindStringSubmatch(base)
+		if match == nil {
+			return nil, fmt.Errorf("migrations: invalid file name %q", base)
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migrations: invalid version in %q: %v", base, err)
+		}
+
+		body, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migrations: version %d has different names %q and %q", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			sum := sha256.Sum256(body)
+			m.Up = string(body)
+			m.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migrations: version %d has no up script", m.Version)
+		}
+
+		migrations = append(migrations, *m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Up применяет все не примененные миграции по порядку, каждую в своей транзакции
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	var applied int
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		done, err := m.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+
+			if err = m.apply(ctx, conn, migration); err != nil {
+				return err
+			}
+
+			applied++
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down откатывает последние steps примененных миграций
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	var reverted int
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		done, err := m.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && reverted < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := done[migration.Version]; !ok {
+				continue
+			}
+
+			if migration.Down == "" {
+				return fmt.Errorf("migrations: version %d has no down script", migration.Version)
+			}
+
+			if err = m.revert(ctx, conn, migration); err != nil {
+				return err
+			}
+
+			reverted++
+		}
+
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Status возвращает список всех известных миграций с отметкой о применении
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var statuses []MigrationStatus
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		done, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			st := MigrationStatus{Version: migration.Version, Name: migration.Name}
+			if a, ok := done[migration.Version]; ok {
+				st.Applied = true
+				st.AppliedAt = a.appliedAt
+			}
+
+			statuses = append(statuses, st)
+		}
+
+		return nil
+	})
+
+	return statuses, err
+}
+
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func(conn *sql.Conn) {
+		if err = conn.Close(); err != nil {
+			return
+		}
+	}(conn)
+
+	if _, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationsLockKey); err != nil {
+		return fmt.Errorf("migrations: failed to acquire lock: %v", err)
+	}
+	defer func(conn *sql.Conn) {
+		if _, err = conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationsLockKey); err != nil {
+			return
+		}
+	}(conn)
+
+	query := fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			version    BIGINT PRIMARY KEY,
+			name       VARCHAR(255) NOT NULL,
+			checksum   VARCHAR(64)  NOT NULL,
+			applied_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`, migrationsTable)
+
+	if _, err = conn.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("migrations: failed to create version table: %v", err)
+	}
+
+	return fn(conn)
+}
+
+func (m *Migrator) applied(ctx context.Context, conn *sql.Conn) (map[int64]appliedMigration, error) {
+	rows, err := conn.QueryContext(ctx, fmt.Sprintf("SELECT version, name, checksum, applied_at FROM %s", migrationsTable))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			return
+		}
+	}(rows)
+
+	done := make(map[int64]appliedMigration)
+	for rows.Next() {
+		var a appliedMigration
+		if err = rows.Scan(&a.version, &a.name, &a.checksum, &a.appliedAt); err != nil {
+			return nil, err
+		}
+
+		done[a.version] = a
+	}
+
+	return done, rows.Err()
+}
+
+// verify сверяет контрольные суммы уже примененных миграций со встроенными скриптами
+func (m *Migrator) verify(ctx context.Context, conn *sql.Conn) (map[int64]appliedMigration, error) {
+	done, err := m.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[int64]Migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = migration
+	}
+
+	for version, a := range done {
+		migration, ok := known[version]
+		if !ok {
+			return nil, fmt.Errorf("%w: version %d (%s)", ErrUnknownMigration, version, a.name)
+		}
+
+		if migration.Checksum != a.checksum {
+			return nil, fmt.Errorf("%w: version %d (%s)", ErrChecksumMismatch, version, a.name)
+		}
+	}
+
+	return done, nil
+}
+
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func(tx *sql.Tx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	if _, err = tx.ExecContext(ctx, migration.Up); err != nil {
+		return fmt.Errorf("migrations: failed to apply version %d (%s): %v", migration.Version, migration.Name, err)
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (version, name, checksum) VALUES ($1, $2, $3)", migrationsTable)
+	if _, err = tx.ExecContext(ctx, query, migration.Version, migration.Name, migration.Checksum); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *Migrator) revert(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func(tx *sql.Tx) {
+		if err = tx.Rollback(); err != nil {
+			return
+		}
+	}(tx)
+
+	if _, err = tx.ExecContext(ctx, migration.Down); err != nil {
+		return fmt.Errorf("migrations: failed to revert version %d (%s): %v", migration.Version, migration.Name, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE version = $1", migrationsTable), migration.Version); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
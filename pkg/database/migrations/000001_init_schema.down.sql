@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS purchased_materials_archive;
+DROP TABLE IF EXISTS planning_materials_archive;
+DROP TABLE IF EXISTS purchased_materials;
+DROP TABLE IF EXISTS planning_materials;
+DROP TABLE IF EXISTS material_categories;
+DROP TABLE IF EXISTS suppliers;
+DROP TABLE IF EXISTS "warehouses";
+
+-- таблица users принадлежит основному сервису CRM и создается здесь только если ее нет, поэтому не удаляем
@@ -0,0 +1,228 @@
+-- таблица users принадлежит основному сервису CRM, создаем ее только для новых окружений и тестов
+CREATE TABLE IF NOT EXISTS users
+(
+    id                          BIGSERIAL PRIMARY KEY,
+    company_id                  BIGINT       NOT NULL,
+    username                    VARCHAR(255) NOT NULL UNIQUE,
+    name                        VARCHAR(255) NOT NULL DEFAULT '',
+    email                       VARCHAR(255) NOT NULL UNIQUE,
+    phone                       VARCHAR(50)  NOT NULL DEFAULT '',
+    password_hash               VARCHAR(255) NOT NULL,
+    created_at                  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at                  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_login                  TIMESTAMP,
+    is_active                   BOOLEAN      NOT NULL DEFAULT TRUE,
+    role                        VARCHAR(50)  NOT NULL DEFAULT 'user',
+    language                    VARCHAR(10)  NOT NULL DEFAULT '',
+    country                     VARCHAR(100) NOT NULL DEFAULT '',
+    is_approved                 BOOLEAN      NOT NULL DEFAULT FALSE,
+    is_send_system_notification BOOLEAN      NOT NULL DEFAULT FALSE,
+    sections                    JSONB        NOT NULL DEFAULT '[]',
+    position                    VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_users_company_id ON users (company_id);
+
+CREATE TABLE "warehouses"
+(
+    id                 BIGSERIAL PRIMARY KEY,
+    name               VARCHAR(255) NOT NULL,
+    address            VARCHAR(255) NOT NULL DEFAULT '',
+    responsible_person VARCHAR(255) NOT NULL DEFAULT '',
+    phone              VARCHAR(50)  NOT NULL DEFAULT '',
+    email              VARCHAR(255) NOT NULL DEFAULT '',
+    max_capacity       BIGINT       NOT NULL DEFAULT 0,
+    current_occupancy  BIGINT       NOT NULL DEFAULT 0,
+    other_fields       JSONB        NOT NULL DEFAULT '{}',
+    country            VARCHAR(100) NOT NULL DEFAULT '',
+    company_id         BIGINT       NOT NULL
+);
+
+CREATE INDEX idx_warehouses_company_id ON "warehouses" (company_id);
+
+CREATE TABLE suppliers
+(
+    id                 BIGSERIAL PRIMARY KEY,
+    name               VARCHAR(255)   NOT NULL,
+    legal_address      VARCHAR(255)   NOT NULL DEFAULT '',
+    actual_address     VARCHAR(255)   NOT NULL DEFAULT '',
+    warehouse_address  VARCHAR(255)   NOT NULL DEFAULT '',
+    contact_person     VARCHAR(255)   NOT NULL DEFAULT '',
+    phone              VARCHAR(50)    NOT NULL DEFAULT '',
+    email              VARCHAR(255)   NOT NULL DEFAULT '',
+    website            VARCHAR(255)   NOT NULL DEFAULT '',
+    contract_number    VARCHAR(255)   NOT NULL DEFAULT '',
+    product_categories TEXT           NOT NULL DEFAULT '',
+    purchase_amount    NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    balance            NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    product_types      BIGINT         NOT NULL DEFAULT 0,
+    comments           TEXT           NOT NULL DEFAULT '',
+    files              TEXT           NOT NULL DEFAULT '',
+    country            VARCHAR(100)   NOT NULL DEFAULT '',
+    region             VARCHAR(100)   NOT NULL DEFAULT '',
+    tax_id             VARCHAR(50)    NOT NULL DEFAULT '',
+    bank_details       TEXT           NOT NULL DEFAULT '',
+    registration_date  TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    payment_terms      TEXT           NOT NULL DEFAULT '',
+    is_active          BOOLEAN        NOT NULL DEFAULT TRUE,
+    other_fields       JSONB          NOT NULL DEFAULT '{}',
+    company_id         BIGINT         NOT NULL
+);
+
+CREATE INDEX idx_suppliers_company_id ON suppliers (company_id);
+
+CREATE TABLE material_categories
+(
+    id          BIGSERIAL PRIMARY KEY,
+    name        VARCHAR(255) NOT NULL,
+    company_id  BIGINT       NOT NULL,
+    description TEXT         NOT NULL DEFAULT '',
+    slug        VARCHAR(255) NOT NULL DEFAULT '',
+    created_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    is_active   BOOLEAN      NOT NULL DEFAULT TRUE,
+    img_url     VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_material_categories_company_id ON material_categories (company_id);
+
+-- item_id закупленного материала генерируется базой при создании или переносе из планирования
+CREATE TABLE planning_materials
+(
+    id                       BIGSERIAL PRIMARY KEY,
+    warehouse_id             BIGINT         NOT NULL DEFAULT 0,
+    item_id                  BIGINT         NOT NULL DEFAULT 0,
+    name                     VARCHAR(255)   NOT NULL,
+    by_invoice               VARCHAR(255)   NOT NULL DEFAULT '',
+    article                  VARCHAR(255)   NOT NULL DEFAULT '',
+    product_category         VARCHAR(255)   NOT NULL DEFAULT '',
+    unit                     VARCHAR(50)    NOT NULL DEFAULT '',
+    total_quantity           BIGINT         NOT NULL DEFAULT 0,
+    volume                   BIGINT         NOT NULL DEFAULT 0,
+    price_without_vat        NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    total_without_vat        NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    supplier_id              BIGINT         NOT NULL DEFAULT 0,
+    location                 VARCHAR(255)   NOT NULL DEFAULT '',
+    contract                 TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    file                     VARCHAR(255)   NOT NULL DEFAULT '',
+    status                   VARCHAR(50)    NOT NULL DEFAULT '',
+    comments                 TEXT           NOT NULL DEFAULT '',
+    reserve                  VARCHAR(255)   NOT NULL DEFAULT '',
+    received_date            TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated             TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    min_stock_level          BIGINT         NOT NULL DEFAULT 0,
+    expiration_date          TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    responsible_person       VARCHAR(255)   NOT NULL DEFAULT '',
+    storage_cost             NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    warehouse_section        VARCHAR(255)   NOT NULL DEFAULT '',
+    incoming_delivery_number VARCHAR(255)   NOT NULL DEFAULT '',
+    other_fields             JSONB          NOT NULL DEFAULT '{}',
+    company_id               BIGINT         NOT NULL
+);
+
+CREATE INDEX idx_planning_materials_company_id ON planning_materials (company_id);
+
+CREATE TABLE purchased_materials
+(
+    id                       BIGSERIAL PRIMARY KEY,
+    warehouse_id             BIGINT         NOT NULL DEFAULT 0,
+    item_id                  BIGSERIAL      NOT NULL,
+    name                     VARCHAR(255)   NOT NULL,
+    by_invoice               VARCHAR(255)   NOT NULL DEFAULT '',
+    article                  VARCHAR(255)   NOT NULL DEFAULT '',
+    product_category         VARCHAR(255)   NOT NULL DEFAULT '',
+    unit                     VARCHAR(50)    NOT NULL DEFAULT '',
+    total_quantity           BIGINT         NOT NULL DEFAULT 0,
+    volume                   BIGINT         NOT NULL DEFAULT 0,
+    price_without_vat        NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    total_without_vat        NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    supplier_id              BIGINT         NOT NULL DEFAULT 0,
+    location                 VARCHAR(255)   NOT NULL DEFAULT '',
+    contract                 TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    file                     VARCHAR(255)   NOT NULL DEFAULT '',
+    status                   VARCHAR(50)    NOT NULL DEFAULT '',
+    comments                 TEXT           NOT NULL DEFAULT '',
+    reserve                  VARCHAR(255)   NOT NULL DEFAULT '',
+    received_date            TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated             TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    min_stock_level          BIGINT         NOT NULL DEFAULT 0,
+    expiration_date          TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    responsible_person       VARCHAR(255)   NOT NULL DEFAULT '',
+    storage_cost             NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    warehouse_section        VARCHAR(255)   NOT NULL DEFAULT '',
+    incoming_delivery_number VARCHAR(255)   NOT NULL DEFAULT '',
+    other_fields             JSONB          NOT NULL DEFAULT '{}',
+    company_id               BIGINT         NOT NULL
+);
+
+CREATE INDEX idx_purchased_materials_company_id ON purchased_materials (company_id);
+
+CREATE TABLE planning_materials_archive
+(
+    id                       BIGSERIAL PRIMARY KEY,
+    warehouse_id             BIGINT         NOT NULL DEFAULT 0,
+    item_id                  BIGINT         NOT NULL DEFAULT 0,
+    name                     VARCHAR(255)   NOT NULL,
+    by_invoice               VARCHAR(255)   NOT NULL DEFAULT '',
+    article                  VARCHAR(255)   NOT NULL DEFAULT '',
+    product_category         VARCHAR(255)   NOT NULL DEFAULT '',
+    unit                     VARCHAR(50)    NOT NULL DEFAULT '',
+    total_quantity           BIGINT         NOT NULL DEFAULT 0,
+    volume                   BIGINT         NOT NULL DEFAULT 0,
+    price_without_vat        NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    total_without_vat        NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    supplier_id              BIGINT         NOT NULL DEFAULT 0,
+    location                 VARCHAR(255)   NOT NULL DEFAULT '',
+    contract                 TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    file                     VARCHAR(255)   NOT NULL DEFAULT '',
+    status                   VARCHAR(50)    NOT NULL DEFAULT '',
+    comments                 TEXT           NOT NULL DEFAULT '',
+    reserve                  VARCHAR(255)   NOT NULL DEFAULT '',
+    received_date            TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated             TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    min_stock_level          BIGINT         NOT NULL DEFAULT 0,
+    expiration_date          TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    responsible_person       VARCHAR(255)   NOT NULL DEFAULT '',
+    storage_cost             NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    warehouse_section        VARCHAR(255)   NOT NULL DEFAULT '',
+    incoming_delivery_number VARCHAR(255)   NOT NULL DEFAULT '',
+    other_fields             JSONB          NOT NULL DEFAULT '{}',
+    company_id               BIGINT         NOT NULL
+);
+
+CREATE INDEX idx_planning_materials_archive_company_id ON planning_materials_archive (company_id);
+
+CREATE TABLE purchased_materials_archive
+(
+    id                       BIGSERIAL PRIMARY KEY,
+    warehouse_id             BIGINT         NOT NULL DEFAULT 0,
+    item_id                  BIGINT         NOT NULL DEFAULT 0,
+    name                     VARCHAR(255)   NOT NULL,
+    by_invoice               VARCHAR(255)   NOT NULL DEFAULT '',
+    article                  VARCHAR(255)   NOT NULL DEFAULT '',
+    product_category         VARCHAR(255)   NOT NULL DEFAULT '',
+    unit                     VARCHAR(50)    NOT NULL DEFAULT '',
+    total_quantity           BIGINT         NOT NULL DEFAULT 0,
+    volume                   BIGINT         NOT NULL DEFAULT 0,
+    price_without_vat        NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    total_without_vat        NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    supplier_id              BIGINT         NOT NULL DEFAULT 0,
+    location                 VARCHAR(255)   NOT NULL DEFAULT '',
+    contract                 TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    file                     VARCHAR(255)   NOT NULL DEFAULT '',
+    status                   VARCHAR(50)    NOT NULL DEFAULT '',
+    comments                 TEXT           NOT NULL DEFAULT '',
+    reserve                  VARCHAR(255)   NOT NULL DEFAULT '',
+    received_date            TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated             TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    min_stock_level          BIGINT         NOT NULL DEFAULT 0,
+    expiration_date          TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    responsible_person       VARCHAR(255)   NOT NULL DEFAULT '',
+    storage_cost             NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    warehouse_section        VARCHAR(255)   NOT NULL DEFAULT '',
+    incoming_delivery_number VARCHAR(255)   NOT NULL DEFAULT '',
+    other_fields             JSONB          NOT NULL DEFAULT '{}',
+    company_id               BIGINT         NOT NULL
+);
+
+CREATE INDEX idx_purchased_materials_archive_company_id ON purchased_materials_archive (company_id);
@@ -0,0 +1,42 @@
+-- Журнал движения товара. Записи только добавляются, остаток партии равен сумме quantity по material_id.
+CREATE TABLE stock_movements
+(
+    id                  BIGSERIAL PRIMARY KEY,
+    company_id          BIGINT       NOT NULL,
+    material_id         BIGINT       NOT NULL, -- id партии в purchased_materials
+    item_id             BIGINT       NOT NULL DEFAULT 0,
+    warehouse_id        BIGINT       NOT NULL DEFAULT 0,
+    movement_type       VARCHAR(32)  NOT NULL,
+    quantity            BIGINT       NOT NULL, -- со знаком: приход > 0, расход < 0
+    balance_after       BIGINT       NOT NULL,
+    related_movement_id BIGINT REFERENCES stock_movements (id),
+    reference           VARCHAR(255) NOT NULL DEFAULT '',
+    comment             TEXT         NOT NULL DEFAULT '',
+    created_by          BIGINT       NOT NULL DEFAULT 0,
+    created_at          TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT stock_movements_type_check CHECK (movement_type IN ('receipt', 'issue', 'transfer', 'adjustment', 'write_off')),
+    CONSTRAINT stock_movements_quantity_check CHECK (quantity <> 0)
+);
+
+CREATE INDEX idx_stock_movements_company_id ON stock_movements (company_id, created_at);
+CREATE INDEX idx_stock_movements_material_id ON stock_movements (material_id);
+CREATE INDEX idx_stock_movements_item_warehouse ON stock_movements (company_id, item_id, warehouse_id);
+
+CREATE FUNCTION stock_movements_immutable() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'stock_movements is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER stock_movements_immutable
+    BEFORE UPDATE OR DELETE
+    ON stock_movements
+    FOR EACH ROW
+EXECUTE FUNCTION stock_movements_immutable();
+
+-- начальные остатки для уже существующих партий
+INSERT INTO stock_movements (company_id, material_id, item_id, warehouse_id, movement_type, quantity, balance_after, reference)
+SELECT company_id, id, item_id, warehouse_id, 'receipt', total_quantity, total_quantity, 'opening balance'
+FROM purchased_materials
+WHERE total_quantity <> 0;
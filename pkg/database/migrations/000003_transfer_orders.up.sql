@@ -0,0 +1,35 @@
+CREATE TABLE transfer_orders
+(
+    id                       BIGSERIAL PRIMARY KEY,
+    company_id               BIGINT      NOT NULL,
+    source_warehouse_id      BIGINT      NOT NULL,
+    destination_warehouse_id BIGINT      NOT NULL,
+    status                   VARCHAR(32) NOT NULL DEFAULT 'draft',
+    comment                  TEXT        NOT NULL DEFAULT '',
+    created_by               BIGINT      NOT NULL DEFAULT 0,
+    created_at               TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at               TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    shipped_at               TIMESTAMP,
+    received_at              TIMESTAMP,
+    CONSTRAINT transfer_orders_status_check CHECK (status IN ('draft', 'shipped', 'partially_received', 'received', 'cancelled')),
+    CONSTRAINT transfer_orders_warehouses_check CHECK (source_warehouse_id <> destination_warehouse_id)
+);
+
+CREATE INDEX idx_transfer_orders_company_id ON transfer_orders (company_id, status);
+
+CREATE TABLE transfer_order_items
+(
+    id                      BIGSERIAL PRIMARY KEY,
+    transfer_order_id       BIGINT NOT NULL REFERENCES transfer_orders (id) ON DELETE CASCADE,
+    material_id             BIGINT NOT NULL, -- партия на складе-отправителе
+    item_id                 BIGINT NOT NULL DEFAULT 0,
+    destination_material_id BIGINT,          -- партия, созданная на складе-получателе при первой приемке
+    quantity                BIGINT NOT NULL,
+    shipped_quantity        BIGINT NOT NULL DEFAULT 0,
+    shipped_volume          BIGINT NOT NULL DEFAULT 0,
+    received_quantity       BIGINT NOT NULL DEFAULT 0,
+    received_volume         BIGINT NOT NULL DEFAULT 0,
+    CONSTRAINT transfer_order_items_quantity_check CHECK (quantity > 0 AND received_quantity <= shipped_quantity)
+);
+
+CREATE INDEX idx_transfer_order_items_order_id ON transfer_order_items (transfer_order_id);
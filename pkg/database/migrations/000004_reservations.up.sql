@@ -0,0 +1,24 @@
+CREATE TABLE reservations
+(
+    id                BIGSERIAL PRIMARY KEY,
+    company_id        BIGINT       NOT NULL,
+    material_id       BIGINT       NOT NULL, -- партия из purchased_materials
+    item_id           BIGINT       NOT NULL DEFAULT 0,
+    warehouse_id      BIGINT       NOT NULL DEFAULT 0,
+    quantity          BIGINT       NOT NULL,
+    consumed_quantity BIGINT       NOT NULL DEFAULT 0,
+    order_reference   VARCHAR(255) NOT NULL DEFAULT '',
+    owner_id          BIGINT       NOT NULL DEFAULT 0,
+    status            VARCHAR(32)  NOT NULL DEFAULT 'active',
+    comment           TEXT         NOT NULL DEFAULT '',
+    expires_at        TIMESTAMP,
+    created_at        TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at        TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    closed_at         TIMESTAMP,
+    CONSTRAINT reservations_status_check CHECK (status IN ('active', 'released', 'consumed')),
+    CONSTRAINT reservations_quantity_check CHECK (quantity > 0 AND consumed_quantity >= 0 AND consumed_quantity <= quantity)
+);
+
+CREATE INDEX idx_reservations_material_id ON reservations (material_id) WHERE status = 'active';
+CREATE INDEX idx_reservations_company_id ON reservations (company_id, status);
+CREATE INDEX idx_reservations_order_reference ON reservations (company_id, order_reference);
@@ -0,0 +1,15 @@
+CREATE TABLE low_stock_alerts
+(
+    id              BIGSERIAL PRIMARY KEY,
+    company_id      BIGINT       NOT NULL,
+    warehouse_id    BIGINT       NOT NULL,
+    item_id         BIGINT       NOT NULL,
+    name            VARCHAR(255) NOT NULL DEFAULT '',
+    quantity        BIGINT       NOT NULL, -- остаток на момент срабатывания
+    min_stock_level BIGINT       NOT NULL,
+    raised_at       TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at     TIMESTAMP              -- остаток снова не ниже минимального
+);
+
+-- не более одного открытого уведомления на товар и склад: повторное срабатывание только после восстановления остатка
+CREATE UNIQUE INDEX idx_low_stock_alerts_open ON low_stock_alerts (company_id, warehouse_id, item_id) WHERE resolved_at IS NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_purchased_materials_item_id;
+DROP INDEX IF EXISTS idx_purchased_materials_expiration;
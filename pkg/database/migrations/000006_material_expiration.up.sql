@@ -0,0 +1,3 @@
+-- поиск истекающих партий и подбор партий по FEFO
+CREATE INDEX idx_purchased_materials_expiration ON purchased_materials (company_id, expiration_date);
+CREATE INDEX idx_purchased_materials_item_id ON purchased_materials (company_id, item_id);
@@ -0,0 +1,16 @@
+CREATE TABLE event_outbox
+(
+    id           BIGSERIAL PRIMARY KEY,
+    event_type   VARCHAR(128) NOT NULL,
+    version      INT          NOT NULL,
+    company_id   BIGINT       NOT NULL,
+    entity_id    BIGINT       NOT NULL DEFAULT 0,
+    payload      JSONB        NOT NULL, -- снимок сущности
+    occurred_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMP,             -- NULL - событие еще не доставлено в NATS
+    attempts     INT          NOT NULL DEFAULT 0,
+    last_error   TEXT         NOT NULL DEFAULT ''
+);
+
+-- очередь неопубликованных событий в порядке возникновения
+CREATE INDEX idx_event_outbox_pending ON event_outbox (id) WHERE published_at IS NULL;
@@ -0,0 +1,8 @@
+-- обработанные входящие сообщения, повторная доставка того же сообщения пропускается
+CREATE TABLE inbox_messages
+(
+    consumer     VARCHAR(128) NOT NULL, -- durable потребителя
+    message_id   VARCHAR(255) NOT NULL, -- Nats-Msg-Id или поток и номер сообщения в нем
+    processed_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (consumer, message_id)
+);
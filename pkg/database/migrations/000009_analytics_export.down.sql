@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS analytics_cursors;
+
+DROP INDEX IF EXISTS idx_event_outbox_export;
+
+ALTER TABLE event_outbox DROP COLUMN IF EXISTS exported_at;
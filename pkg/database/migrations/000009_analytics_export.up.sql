@@ -0,0 +1,12 @@
+-- выгрузка в ClickHouse: события материалов отмечаются в outbox, журнал движений не изменяется
+-- и выгружается по курсору
+ALTER TABLE event_outbox ADD COLUMN exported_at TIMESTAMP; -- NULL - событие еще не выгружено в аналитику
+
+CREATE INDEX idx_event_outbox_export ON event_outbox (id) WHERE exported_at IS NULL AND event_type LIKE 'material.%';
+
+CREATE TABLE analytics_cursors
+(
+    feed       VARCHAR(64) PRIMARY KEY, -- источник выгрузки
+    last_id    BIGINT      NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_purchased_materials_bin_id;
+
+ALTER TABLE purchased_materials_archive DROP COLUMN IF EXISTS bin_id;
+ALTER TABLE purchased_materials DROP COLUMN IF EXISTS bin_id;
+
+DROP TABLE IF EXISTS storage_locations;
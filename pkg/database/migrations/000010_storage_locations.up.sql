@@ -0,0 +1,29 @@
+-- места хранения склада: зона -> стеллаж -> полка -> ячейка. path - id мест от зоны до самого места
+-- через '/', по нему находятся вложенные места и предки без рекурсивных запросов
+CREATE TABLE storage_locations
+(
+    id           BIGSERIAL PRIMARY KEY,
+    company_id   BIGINT       NOT NULL,
+    warehouse_id BIGINT       NOT NULL,
+    parent_id    BIGINT REFERENCES storage_locations (id),
+    kind         VARCHAR(16)  NOT NULL,
+    code         VARCHAR(64)  NOT NULL,
+    name         VARCHAR(255) NOT NULL DEFAULT '',
+    capacity     BIGINT       NOT NULL DEFAULT 0, -- вместимость в единицах объема материалов, 0 - без ограничения
+    path         VARCHAR(255) NOT NULL DEFAULT '',
+    created_at   TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT storage_locations_kind_check CHECK (kind IN ('zone', 'rack', 'shelf', 'bin')),
+    CONSTRAINT storage_locations_parent_check CHECK ((kind = 'zone') = (parent_id IS NULL)),
+    CONSTRAINT storage_locations_capacity_check CHECK (capacity >= 0)
+);
+
+CREATE UNIQUE INDEX idx_storage_locations_code ON storage_locations (warehouse_id, COALESCE(parent_id, 0), code);
+CREATE INDEX idx_storage_locations_parent_id ON storage_locations (parent_id);
+CREATE INDEX idx_storage_locations_path ON storage_locations (path varchar_pattern_ops);
+
+-- ячейка партии, 0 - партия не размещена. Архив хранит ячейку, в которой партия была перед архивацией
+ALTER TABLE purchased_materials ADD COLUMN bin_id BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE purchased_materials_archive ADD COLUMN bin_id BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_purchased_materials_bin_id ON purchased_materials (bin_id) WHERE bin_id <> 0;
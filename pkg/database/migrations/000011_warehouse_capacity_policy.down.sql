@@ -0,0 +1,2 @@
+ALTER TABLE warehouses DROP CONSTRAINT IF EXISTS warehouses_capacity_policy_check;
+ALTER TABLE warehouses DROP COLUMN IF EXISTS capacity_policy;
@@ -0,0 +1,8 @@
+-- reject - приемка сверх max_capacity отклоняется, warn - проходит с предупреждением.
+-- Существующие склады получают warn, чтобы пересчет заполненности не остановил приемку на них
+ALTER TABLE warehouses ADD COLUMN capacity_policy VARCHAR(16) NOT NULL DEFAULT 'warn';
+ALTER TABLE warehouses ADD CONSTRAINT warehouses_capacity_policy_check CHECK (capacity_policy IN ('reject', 'warn'));
+
+-- заполненность больше не задается клиентом, а считается по объему купленных партий склада
+UPDATE warehouses w
+SET current_occupancy = COALESCE((SELECT SUM(m.volume) FROM purchased_materials m WHERE m.warehouse_id = w.id), 0);
@@ -0,0 +1,56 @@
+-- инвентаризация склада или его зоны: при открытии фиксируются ожидаемые остатки партий,
+-- после утверждения расхождения проводятся корректировками в stock_movements
+CREATE TABLE stocktakes
+(
+    id           BIGSERIAL PRIMARY KEY,
+    company_id   BIGINT      NOT NULL,
+    warehouse_id BIGINT      NOT NULL,
+    zone_id      BIGINT      NOT NULL DEFAULT 0, -- зона склада, 0 - весь склад
+    status       VARCHAR(32) NOT NULL DEFAULT 'open',
+    comment      TEXT        NOT NULL DEFAULT '',
+    created_by   BIGINT      NOT NULL DEFAULT 0,
+    approved_by  BIGINT      NOT NULL DEFAULT 0,
+    created_at   TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    closed_at    TIMESTAMP,
+    CONSTRAINT stocktakes_status_check CHECK (status IN ('open', 'closed', 'cancelled'))
+);
+
+CREATE INDEX idx_stocktakes_company_id ON stocktakes (company_id, status);
+-- на складе одна открытая инвентаризация, иначе партию можно было бы скорректировать дважды
+CREATE UNIQUE INDEX idx_stocktakes_open_warehouse ON stocktakes (warehouse_id) WHERE status = 'open';
+
+CREATE TABLE stocktake_lines
+(
+    id           BIGSERIAL PRIMARY KEY,
+    stocktake_id BIGINT         NOT NULL REFERENCES stocktakes (id) ON DELETE CASCADE,
+    material_id  BIGINT         NOT NULL,
+    item_id      BIGINT         NOT NULL DEFAULT 0,
+    name         VARCHAR(255)   NOT NULL DEFAULT '',
+    location     VARCHAR(255)   NOT NULL DEFAULT '',
+    price        NUMERIC(15, 2) NOT NULL DEFAULT 0,
+    expected     BIGINT         NOT NULL, -- остаток партии по журналу на момент открытия
+    counted      BIGINT,                  -- NULL - партия еще не посчитана
+    counted_by   BIGINT         NOT NULL DEFAULT 0,
+    counted_at   TIMESTAMP,
+    CONSTRAINT stocktake_lines_counted_check CHECK (counted >= 0)
+);
+
+CREATE UNIQUE INDEX idx_stocktake_lines_material ON stocktake_lines (stocktake_id, material_id);
+CREATE INDEX idx_stocktake_lines_item ON stocktake_lines (stocktake_id, item_id);
+
+-- все подсчеты по строкам: кто, когда, сколько и каким способом посчитал
+CREATE TABLE stocktake_counts
+(
+    id           BIGSERIAL PRIMARY KEY,
+    stocktake_id BIGINT      NOT NULL REFERENCES stocktakes (id) ON DELETE CASCADE,
+    line_id      BIGINT      NOT NULL REFERENCES stocktake_lines (id) ON DELETE CASCADE,
+    quantity     BIGINT      NOT NULL,
+    source       VARCHAR(16) NOT NULL,
+    counted_by   BIGINT      NOT NULL DEFAULT 0,
+    counted_at   TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT stocktake_counts_quantity_check CHECK (quantity >= 0),
+    CONSTRAINT stocktake_counts_source_check CHECK (source IN ('material', 'item', 'qr'))
+);
+
+CREATE INDEX idx_stocktake_counts_stocktake_id ON stocktake_counts (stocktake_id, id);
@@ -0,0 +1,19 @@
+-- журнал аудита изменений материалов, поставщиков, складов и категорий,
+-- запись добавляется в транзакции изменения
+CREATE TABLE audit_log
+(
+    id          BIGSERIAL PRIMARY KEY,
+    company_id  BIGINT       NOT NULL,
+    actor_id    BIGINT       NOT NULL DEFAULT 0, -- 0 - системное изменение
+    method      VARCHAR(255) NOT NULL DEFAULT '',
+    entity_type VARCHAR(64)  NOT NULL,
+    entity_id   BIGINT       NOT NULL,
+    action      VARCHAR(16)  NOT NULL,
+    changes     JSONB        NOT NULL DEFAULT '{}',
+    created_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT audit_log_action_check CHECK (action IN ('create', 'update', 'delete', 'move'))
+);
+
+CREATE INDEX idx_audit_log_company_id ON audit_log (company_id, created_at DESC, id DESC);
+CREATE INDEX idx_audit_log_entity ON audit_log (company_id, entity_type, entity_id);
+CREATE INDEX idx_audit_log_actor ON audit_log (company_id, actor_id);
@@ -0,0 +1,12 @@
+DELETE FROM audit_log WHERE action = 'restore';
+ALTER TABLE audit_log DROP CONSTRAINT audit_log_action_check;
+ALTER TABLE audit_log ADD CONSTRAINT audit_log_action_check CHECK (action IN ('create', 'update', 'delete', 'move'));
+
+-- мягко удаленные записи при откате удаляются окончательно, иначе они снова стали бы видимыми
+DELETE FROM suppliers WHERE deleted_at IS NOT NULL;
+DELETE FROM "warehouses" WHERE deleted_at IS NOT NULL;
+DELETE FROM material_categories WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE suppliers DROP COLUMN deleted_at;
+ALTER TABLE "warehouses" DROP COLUMN deleted_at;
+ALTER TABLE material_categories DROP COLUMN deleted_at;
@@ -0,0 +1,12 @@
+-- мягкое удаление поставщиков, складов и категорий: удаленные записи скрываются из чтения,
+-- могут быть восстановлены и окончательно удаляются по истечении срока хранения, если на них нет ссылок
+ALTER TABLE suppliers ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE "warehouses" ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE material_categories ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX idx_suppliers_deleted_at ON suppliers (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_warehouses_deleted_at ON "warehouses" (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_material_categories_deleted_at ON material_categories (deleted_at) WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE audit_log DROP CONSTRAINT audit_log_action_check;
+ALTER TABLE audit_log ADD CONSTRAINT audit_log_action_check CHECK (action IN ('create', 'update', 'delete', 'move', 'restore'));
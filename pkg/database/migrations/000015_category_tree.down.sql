@@ -0,0 +1,10 @@
+ALTER TABLE planning_materials DROP COLUMN category_id;
+ALTER TABLE purchased_materials DROP COLUMN category_id;
+ALTER TABLE planning_materials_archive DROP COLUMN category_id;
+ALTER TABLE purchased_materials_archive DROP COLUMN category_id;
+
+DROP INDEX idx_material_categories_slug;
+
+ALTER TABLE material_categories DROP COLUMN depth;
+ALTER TABLE material_categories DROP COLUMN path;
+ALTER TABLE material_categories DROP COLUMN parent_id;
@@ -0,0 +1,77 @@
+-- дерево категорий материалов: path - id категорий от корня до самой категории через '/',
+-- depth - глубина, у корневых 0. По path находятся вложенные категории без рекурсивных запросов
+ALTER TABLE material_categories ADD COLUMN parent_id BIGINT REFERENCES material_categories (id);
+ALTER TABLE material_categories ADD COLUMN path VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE material_categories ADD COLUMN depth INT NOT NULL DEFAULT 0;
+
+UPDATE material_categories SET path = '/' || id || '/';
+
+CREATE INDEX idx_material_categories_parent_id ON material_categories (parent_id);
+CREATE INDEX idx_material_categories_path ON material_categories (path varchar_pattern_ops);
+
+-- slug уникален в компании: пустые заполняются как category-<id>, у повторов к slug добавляется id.
+-- Полученный slug может совпасть с уже существующим, тогда добавляется номер, пока совпадения не исчезнут
+DO $$
+DECLARE
+    r         RECORD;
+    base      TEXT;
+    candidate TEXT;
+    n         INT;
+BEGIN
+    FOR r IN
+        SELECT c.id, c.company_id, c.slug FROM material_categories c
+        WHERE c.slug = ''
+           OR EXISTS (SELECT 1 FROM material_categories d WHERE d.company_id = c.company_id AND d.slug = c.slug AND d.id < c.id)
+        ORDER BY c.id
+    LOOP
+        base := left(CASE WHEN r.slug = '' THEN 'category' ELSE r.slug END, 200) || '-' || r.id;
+        candidate := base;
+        n := 1;
+
+        WHILE EXISTS (SELECT 1 FROM material_categories WHERE company_id = r.company_id AND slug = candidate) LOOP
+            n := n + 1;
+            candidate := base || '-' || n;
+        END LOOP;
+
+        UPDATE material_categories SET slug = candidate WHERE id = r.id;
+    END LOOP;
+END;
+$$;
+
+CREATE UNIQUE INDEX idx_material_categories_slug ON material_categories (company_id, slug);
+
+-- категория материала по id, 0 - без категории. product_category хранит название категории для чтения
+ALTER TABLE planning_materials ADD COLUMN category_id BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE purchased_materials ADD COLUMN category_id BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE planning_materials_archive ADD COLUMN category_id BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE purchased_materials_archive ADD COLUMN category_id BIGINT NOT NULL DEFAULT 0;
+
+UPDATE planning_materials m SET category_id = (
+    SELECT MIN(c.id) FROM material_categories c
+    WHERE c.company_id = m.company_id AND c.name = m.product_category AND c.deleted_at IS NULL)
+WHERE m.product_category <> '' AND EXISTS (
+    SELECT 1 FROM material_categories c
+    WHERE c.company_id = m.company_id AND c.name = m.product_category AND c.deleted_at IS NULL);
+UPDATE purchased_materials m SET category_id = (
+    SELECT MIN(c.id) FROM material_categories c
+    WHERE c.company_id = m.company_id AND c.name = m.product_category AND c.deleted_at IS NULL)
+WHERE m.product_category <> '' AND EXISTS (
+    SELECT 1 FROM material_categories c
+    WHERE c.company_id = m.company_id AND c.name = m.product_category AND c.deleted_at IS NULL);
+UPDATE planning_materials_archive m SET category_id = (
+    SELECT MIN(c.id) FROM material_categories c
+    WHERE c.company_id = m.company_id AND c.name = m.product_category AND c.deleted_at IS NULL)
+WHERE m.product_category <> '' AND EXISTS (
+    SELECT 1 FROM material_categories c
+    WHERE c.company_id = m.company_id AND c.name = m.product_category AND c.deleted_at IS NULL);
+UPDATE purchased_materials_archive m SET category_id = (
+    SELECT MIN(c.id) FROM material_categories c
+    WHERE c.company_id = m.company_id AND c.name = m.product_category AND c.deleted_at IS NULL)
+WHERE m.product_category <> '' AND EXISTS (
+    SELECT 1 FROM material_categories c
+    WHERE c.company_id = m.company_id AND c.name = m.product_category AND c.deleted_at IS NULL);
+
+CREATE INDEX idx_planning_materials_category_id ON planning_materials (category_id) WHERE category_id <> 0;
+CREATE INDEX idx_purchased_materials_category_id ON purchased_materials (category_id) WHERE category_id <> 0;
+CREATE INDEX idx_planning_materials_archive_category_id ON planning_materials_archive (category_id) WHERE category_id <> 0;
+CREATE INDEX idx_purchased_materials_archive_category_id ON purchased_materials_archive (category_id) WHERE category_id <> 0;
@@ -0,0 +1 @@
+ALTER TABLE transfer_order_items DROP COLUMN lot_snapshot;
@@ -0,0 +1,8 @@
+-- снимок партии-отправителя на момент отгрузки: по нему создается партия на складе-получателе,
+-- даже если исходная партия к приемке удалена или перенесена в архив
+ALTER TABLE transfer_order_items ADD COLUMN lot_snapshot JSONB;
+
+UPDATE transfer_order_items i
+SET lot_snapshot = to_jsonb(m)
+FROM purchased_materials m
+WHERE m.id = i.material_id AND i.shipped_quantity > 0;
@@ -0,0 +1,3 @@
+DROP INDEX idx_low_stock_alerts_undelivered;
+
+ALTER TABLE low_stock_alerts DROP COLUMN notified_at;
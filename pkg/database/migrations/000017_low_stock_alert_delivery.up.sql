@@ -0,0 +1,6 @@
+-- время доставки уведомления: открытые уведомления без notified_at рассылаются повторно при следующей проверке
+ALTER TABLE low_stock_alerts ADD COLUMN notified_at TIMESTAMP;
+
+UPDATE low_stock_alerts SET notified_at = raised_at;
+
+CREATE INDEX idx_low_stock_alerts_undelivered ON low_stock_alerts (id) WHERE resolved_at IS NULL AND notified_at IS NULL;
@@ -0,0 +1 @@
+DROP INDEX idx_event_outbox_published_at;
@@ -0,0 +1,2 @@
+-- очистка outbox по сроку хранения опубликованных событий
+CREATE INDEX idx_event_outbox_published_at ON event_outbox (published_at) WHERE published_at IS NOT NULL;
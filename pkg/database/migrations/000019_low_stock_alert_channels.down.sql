@@ -0,0 +1,3 @@
+ALTER TABLE low_stock_alerts DROP COLUMN nats_notified_at;
+
+ALTER TABLE low_stock_alerts DROP COLUMN telegram_notified_at;
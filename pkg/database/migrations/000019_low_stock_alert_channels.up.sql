@@ -0,0 +1,5 @@
+-- доставка уведомления по каналам: при сбое одного канала повторная рассылка не дублирует сообщение в другом
+ALTER TABLE low_stock_alerts ADD COLUMN telegram_notified_at TIMESTAMP;
+ALTER TABLE low_stock_alerts ADD COLUMN nats_notified_at TIMESTAMP;
+
+UPDATE low_stock_alerts SET telegram_notified_at = notified_at, nats_notified_at = notified_at WHERE notified_at IS NOT NULL;
@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+// Каналы рассылки уведомлений, доставка по каждому отмечается отдельно
+const (
+	AlertChannelTelegram = "telegram"
+	AlertChannelNats     = "nats"
+)
+
+// LowStockAlert уведомление о том, что остаток товара на складе опустился ниже MinStockLevel
+type LowStockAlert struct {
+	ID            int64     `json:"id"`              // Уникальный идентификатор уведомления
+	CompanyID     int64     `json:"company_id"`      // Кабинет компании
+	WarehouseID   int64     `json:"warehouse_id"`    // Склад
+	ItemID        int64     `json:"item_id"`         // Идентификатор товара
+	Name          string    `json:"name"`            // Наименование товара
+	Quantity      int64     `json:"quantity"`        // Остаток на момент срабатывания
+	MinStockLevel int64     `json:"min_stock_level"` // Минимальный уровень запаса
+	RaisedAt      time.Time `json:"raised_at"`       // Дата срабатывания
+	Recipients    []int64   `json:"recipients"`      // Ответственные пользователи, получившие уведомление
+
+	TelegramNotified bool `json:"-"` // Уведомление уже доставлено в Telegram
+	NatsNotified     bool `json:"-"` // Уведомление уже опубликовано в NATS
+}
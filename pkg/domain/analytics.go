@@ -0,0 +1,60 @@
+package domain
+
+import "time"
+
+// MaterialFact - событие материала из outbox в виде строки аналитики
+type MaterialFact struct {
+	EventID    int64     // id события в outbox
+	EventType  string    // тип события, например material.purchased.created
+	Material   Material  // снимок материала на момент события
+	Payload    []byte    // данные события без изменений
+	OccurredAt time.Time // время события
+}
+
+// MovementFact - запись журнала движения с атрибутами партии для аналитики
+type MovementFact struct {
+	Movement
+	Category        string  // категория товара партии
+	SupplierID      int64   // поставщик партии
+	PriceWithoutVAT float64 // цена единицы партии без НДС
+}
+
+type StockOnDateParams struct {
+	CompanyId   int64
+	WarehouseId int64
+	Date        time.Time // остаток на конец дня
+}
+
+// StockBalance остаток товара на складе на дату
+type StockBalance struct {
+	ItemID      int64   `json:"item_id"`
+	WarehouseID int64   `json:"warehouse_id"`
+	Category    string  `json:"category"`
+	Quantity    int64   `json:"quantity"`
+	Value       float64 `json:"value"` // стоимость остатка без НДС по ценам партий
+}
+
+type PeriodParams struct {
+	CompanyId int64
+	From      time.Time // начало периода, включительно
+	To        time.Time // конец периода, не включительно
+}
+
+// CategoryTurnover оборачиваемость категории за период
+type CategoryTurnover struct {
+	Category string  `json:"category"`
+	Opening  int64   `json:"opening"`  // остаток на начало периода
+	Received int64   `json:"received"` // поступило за период
+	Issued   int64   `json:"issued"`   // отпущено и списано за период
+	Closing  int64   `json:"closing"`  // остаток на конец периода
+	Turnover float64 `json:"turnover"` // отпущено к среднему остатку, 0 - если остатка не было
+}
+
+// SupplierSpend закупки у поставщика за месяц
+type SupplierSpend struct {
+	SupplierID int64     `json:"supplier_id"`
+	Month      time.Time `json:"month"`    // первое число месяца
+	Lots       int64     `json:"lots"`     // закупленных партий
+	Quantity   int64     `json:"quantity"` // закупленное количество
+	Amount     float64   `json:"amount"`   // сумма закупок без НДС
+}
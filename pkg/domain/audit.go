@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Действия, записываемые в журнал аудита
+const (
+	AuditActionCreate  = "create"
+	AuditActionUpdate  = "update"
+	AuditActionDelete  = "delete"
+	AuditActionMove    = "move"    // Перенос между разделами, после - снимок в новом разделе
+	AuditActionRestore = "restore" // Восстановление удаленной записи
+)
+
+// Типы сущностей журнала аудита
+const (
+	AuditEntityPlanningMaterial  = "planning_material"
+	AuditEntityPurchasedMaterial = "purchased_material"
+	AuditEntityPlanningArchive   = "planning_archive"
+	AuditEntityPurchasedArchive  = "purchased_archive"
+	AuditEntitySupplier          = "supplier"
+	AuditEntityWarehouse         = "warehouse"
+	AuditEntityCategory          = "material_category"
+)
+
+// AuditChange значения поля до и после изменения в JSON, отсутствующее значение - nil
+type AuditChange struct {
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// AuditEntry запись журнала аудита, записи не изменяются и не удаляются
+type AuditEntry struct {
+	ID         int64                  `json:"id"`
+	CompanyID  int64                  `json:"company_id"`  // Кабинет компании измененной сущности
+	ActorID    int64                  `json:"actor_id"`    // Пользователь, 0 - системное изменение
+	Method     string                 `json:"method"`      // Вызванный RPC метод или источник изменения
+	EntityType string                 `json:"entity_type"` // Тип сущности
+	EntityID   int64                  `json:"entity_id"`   // Идентификатор сущности, при переносе - в исходном разделе
+	Action     string                 `json:"action"`      // Действие: create, update, delete, move или restore
+	Changes    map[string]AuditChange `json:"changes"`     // Только изменившиеся поля
+	CreatedAt  time.Time              `json:"created_at"`  // Дата изменения
+}
+
+type AuditParams struct {
+	Limit      int64
+	Offset     int64
+	CompanyId  int64
+	ActorId    int64     // 0 - любой пользователь
+	EntityType string    // Пусто - любой тип
+	EntityId   int64     // 0 - любая сущность
+	Method     string    // Пусто - любой метод
+	Action     string    // Пусто - любое действие
+	From       time.Time // Нулевое время - без ограничения
+	To         time.Time // Нулевое время - без ограничения
+}
@@ -1,10 +1,133 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 var (
-	ErrEmptyId           = errors.New("id can`t be zero")
-	ErrWarehouseNotFound = errors.New("warehouse not found")
-	ErrSupplierNotFound  = errors.New("supplier not found")
-	ErrMaterialNotFound  = errors.New("material not found")
+	ErrEmptyId             = errors.New("id can`t be zero")
+	ErrWarehouseNotFound   = errors.New("warehouse not found")
+	ErrSupplierNotFound    = errors.New("supplier not found")
+	ErrMaterialNotFound    = errors.New("material not found")
+	ErrMaterialQuarantined = errors.New("material is quarantined")
+	ErrCategoryNotFound    = errors.New("material category not found")
+	ErrInvalidCategory     = errors.New("invalid material category")
+	ErrMovementNotFound    = errors.New("movement not found")
+	ErrInsufficientStock   = errors.New("insufficient stock")
+	ErrInvalidMovement     = errors.New("invalid movement")
+
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrAlreadyExists   = errors.New("already exists")
+	ErrReferenced      = errors.New("entity is still referenced")
+
+	ErrUserNotFound     = errors.New("user not found")
+	ErrUnauthenticated  = errors.New("unauthenticated")
+	ErrPermissionDenied = errors.New("permission denied")
+
+	ErrTransferOrderNotFound = errors.New("transfer order not found")
+	ErrInvalidTransferOrder  = errors.New("invalid transfer order")
+	ErrTransferOrderStatus   = errors.New("transfer order status does not allow this operation")
+
+	ErrReservationNotFound = errors.New("reservation not found")
+	ErrInvalidReservation  = errors.New("invalid reservation")
+	ErrReservationStatus   = errors.New("reservation status does not allow this operation")
+
+	ErrAnalyticsDisabled = errors.New("analytics is disabled")
+
+	ErrLocationNotFound = errors.New("storage location not found")
+	ErrInvalidLocation  = errors.New("invalid storage location")
+	ErrLocationFull     = errors.New("storage location is full")
+
+	ErrWarehouseFull = errors.New("warehouse capacity exceeded")
+
+	ErrStocktakeNotFound = errors.New("stocktake not found")
+	ErrInvalidStocktake  = errors.New("invalid stocktake")
+	ErrStocktakeStatus   = errors.New("stocktake status does not allow this operation")
 )
+
+// ErrorDomain - домен ошибок сервиса в errdetails.ErrorInfo
+const ErrorDomain = "crm-warehouse"
+
+// errorReasons - стабильные коды ошибок для клиентов, порядок важен: первое совпадение по errors.Is
+var errorReasons = []struct {
+	err    error
+	reason string
+}{
+	{ErrEmptyId, "EMPTY_ID"},
+	{ErrWarehouseNotFound, "WAREHOUSE_NOT_FOUND"},
+	{ErrSupplierNotFound, "SUPPLIER_NOT_FOUND"},
+	{ErrMaterialNotFound, "MATERIAL_NOT_FOUND"},
+	{ErrMaterialQuarantined, "MATERIAL_QUARANTINED"},
+	{ErrCategoryNotFound, "CATEGORY_NOT_FOUND"},
+	{ErrInvalidCategory, "INVALID_CATEGORY"},
+	{ErrMovementNotFound, "MOVEMENT_NOT_FOUND"},
+	{ErrInsufficientStock, "INSUFFICIENT_STOCK"},
+	{ErrInvalidMovement, "INVALID_MOVEMENT"},
+	{ErrInvalidArgument, "INVALID_ARGUMENT"},
+	{ErrAlreadyExists, "ALREADY_EXISTS"},
+	{ErrReferenced, "REFERENCED"},
+	{ErrUserNotFound, "USER_NOT_FOUND"},
+	{ErrUnauthenticated, "UNAUTHENTICATED"},
+	{ErrPermissionDenied, "PERMISSION_DENIED"},
+	{ErrTransferOrderNotFound, "TRANSFER_ORDER_NOT_FOUND"},
+	{ErrInvalidTransferOrder, "INVALID_TRANSFER_ORDER"},
+	{ErrTransferOrderStatus, "TRANSFER_ORDER_STATUS"},
+	{ErrReservationNotFound, "RESERVATION_NOT_FOUND"},
+	{ErrInvalidReservation, "INVALID_RESERVATION"},
+	{ErrReservationStatus, "RESERVATION_STATUS"},
+	{ErrAnalyticsDisabled, "ANALYTICS_DISABLED"},
+	{ErrLocationNotFound, "LOCATION_NOT_FOUND"},
+	{ErrInvalidLocation, "INVALID_LOCATION"},
+	{ErrLocationFull, "LOCATION_FULL"},
+	{ErrWarehouseFull, "WAREHOUSE_FULL"},
+	{ErrStocktakeNotFound, "STOCKTAKE_NOT_FOUND"},
+	{ErrInvalidStocktake, "INVALID_STOCKTAKE"},
+	{ErrStocktakeStatus, "STOCKTAKE_STATUS"},
+}
+
+// ErrorReason возвращает код доменной ошибки, false - если ошибка не доменная
+func ErrorReason(err error) (string, bool) {
+	for _, r := range errorReasons {
+		if errors.Is(err, r.err) {
+			return r.reason, true
+		}
+	}
+
+	return "", false
+}
+
+// ErrorByReason возвращает доменную ошибку по ее коду
+func ErrorByReason(reason string) (error, bool) {
+	for _, r := range errorReasons {
+		if r.reason == reason {
+			return r.err, true
+		}
+	}
+
+	return nil, false
+}
+
+// FieldViolation - нарушение правила валидации для одного поля запроса
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError - ошибки валидации запроса по полям, errors.Is сопоставляет ее с ErrInvalidArgument
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		parts = append(parts, v.Field+": "+v.Description)
+	}
+
+	return ErrInvalidArgument.Error() + ": " + strings.Join(parts, "; ")
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
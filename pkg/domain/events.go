@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// EventVersion версия схемы событий. Поля событий и снимков только добавляются,
+// при несовместимом изменении версия увеличивается.
+const EventVersion = 1
+
+// Типы доменных событий, публикуются в NATS с subject <prefix>.<type>
+const (
+	EventMaterialPlanningCreated         = "material.planning.created"
+	EventMaterialPlanningUpdated         = "material.planning.updated"
+	EventMaterialPlanningDeleted         = "material.planning.deleted"
+	EventMaterialMovedToPurchased        = "material.moved_to_purchased"
+	EventMaterialPurchasedCreated        = "material.purchased.created"
+	EventMaterialPurchasedUpdated        = "material.purchased.updated"
+	EventMaterialPurchasedDeleted        = "material.purchased.deleted"
+	EventMaterialMovedToArchive          = "material.moved_to_archive"
+	EventMaterialPlanningArchiveDeleted  = "material.planning_archive.deleted"
+	EventMaterialPurchasedArchiveDeleted = "material.purchased_archive.deleted"
+
+	EventSupplierCreated  = "supplier.created"
+	EventSupplierUpdated  = "supplier.updated"
+	EventSupplierDeleted  = "supplier.deleted"
+	EventSupplierRestored = "supplier.restored"
+
+	EventWarehouseCreated  = "warehouse.created"
+	EventWarehouseUpdated  = "warehouse.updated"
+	EventWarehouseDeleted  = "warehouse.deleted"
+	EventWarehouseRestored = "warehouse.restored"
+
+	EventWarehouseCapacityExceeded = "warehouse.capacity_exceeded"
+)
+
+// Event доменное событие. Сохраняется в outbox в транзакции изменения и публикуется в NATS отдельно.
+type Event struct {
+	ID         int64           `json:"id"`          // Идентификатор записи outbox, используется для дедупликации
+	Type       string          `json:"type"`        // Тип события
+	Version    int             `json:"version"`     // Версия схемы события
+	CompanyID  int64           `json:"company_id"`  // Кабинет компании
+	EntityID   int64           `json:"entity_id"`   // Идентификатор измененной сущности
+	OccurredAt time.Time       `json:"occurred_at"` // Дата изменения
+	Data       json.RawMessage `json:"data"`        // Снимок сущности после изменения, для удаления - до него
+}
+
+// MaterialMovedData снимок переноса материала между разделами
+type MaterialMovedData struct {
+	FromID   int64    `json:"from_id"`  // Идентификатор записи в исходном разделе
+	Material Material `json:"material"` // Материал в новом разделе
+}
@@ -0,0 +1,60 @@
+package domain
+
+import "time"
+
+// Уровни мест хранения склада сверху вниз, партии размещаются только в ячейках
+const (
+	LocationKindZone  = "zone"  // Зона склада
+	LocationKindRack  = "rack"  // Стеллаж в зоне
+	LocationKindShelf = "shelf" // Полка стеллажа
+	LocationKindBin   = "bin"   // Ячейка на полке
+)
+
+// LocationParentKind уровень, в который вкладывается место хранения, пустая строка - место верхнего уровня
+var LocationParentKind = map[string]string{
+	LocationKindZone:  "",
+	LocationKindRack:  LocationKindZone,
+	LocationKindShelf: LocationKindRack,
+	LocationKindBin:   LocationKindShelf,
+}
+
+// StorageLocation место хранения на складе
+type StorageLocation struct {
+	ID          int64     `json:"id"`           // Уникальный идентификатор места
+	CompanyID   int64     `json:"company_id"`   // Кабинет компании
+	WarehouseID int64     `json:"warehouse_id"` // Склад
+	ParentID    int64     `json:"parent_id"`    // Родительское место, 0 - у зоны
+	Kind        string    `json:"kind"`         // Уровень: зона, стеллаж, полка или ячейка
+	Code        string    `json:"code"`         // Код места, уникален среди мест с общим родителем
+	Name        string    `json:"name"`         // Название
+	Capacity    int64     `json:"capacity"`     // Вместимость в единицах объема материалов, 0 - без ограничения
+	Occupancy   int64     `json:"occupancy"`    // Объем купленных партий во всех вложенных ячейках
+	Address     string    `json:"address"`      // Коды мест от зоны до этого места через '-'
+	CreatedAt   time.Time `json:"created_at"`   // Дата создания
+	UpdatedAt   time.Time `json:"updated_at"`   // Дата последнего изменения
+}
+
+type StorageLocationParams struct {
+	Limit       int64
+	Offset      int64
+	CompanyId   int64
+	WarehouseId int64
+	ParentId    int64  // Вложенные в это место, 0 - без отбора по родителю
+	Kind        string // Уровень мест, пусто - любой
+}
+
+// LocationUsage заполненность места хранения с ограниченной вместимостью
+type LocationUsage struct {
+	ID        int64
+	Kind      string
+	Code      string
+	Capacity  int64
+	Occupancy int64
+}
+
+// PutAwayTarget ячейка для размещения партии и заполненность ее самой и мест, в которые она вложена
+type PutAwayTarget struct {
+	Bin   StorageLocation
+	Zone  string          // Код зоны ячейки
+	Usage []LocationUsage // Только места с ограниченной вместимостью
+}
@@ -14,4 +14,8 @@ type MaterialCategory struct {
 	UpdatedAt   time.Time `json:"updated_at"`
 	IsActive    bool      `json:"is_active"`
 	ImgURL      string    `json:"img_url"`
+	DeletedAt   time.Time `json:"deleted_at"` // Дата удаления, нулевая - категория не удалена
+	ParentID    int64     `json:"parent_id"`  // Родительская категория, 0 - корневая
+	Path        string    `json:"path"`       // id категорий от корня до самой категории через '/'
+	Depth       int64     `json:"depth"`      // Глубина в дереве, у корневых 0
 }
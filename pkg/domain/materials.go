@@ -22,7 +22,7 @@ type Material struct {
 	File                   string                 `json:"file"`                     // Файл, связанный с товаром
 	Status                 string                 `json:"status"`                   // Статус товара
 	Comments               string                 `json:"comments"`                 // Комментарии
-	Reserve                string                 `json:"reserve"`                  // Резерв товара
+	Reserve                string                 `json:"reserve"`                  // Устарело: резервы партии ведутся в reservations
 	ReceivedDate           time.Time              `json:"received_date"`            // Дата поступления товара
 	LastUpdated            time.Time              `json:"last_updated"`             // Дата последнего обновления информации о товаре
 	MinStockLevel          int64                  `json:"min_stock_level"`          // Минимальный уровень запаса
@@ -33,10 +33,108 @@ type Material struct {
 	IncomingDeliveryNumber string                 `json:"incoming_delivery_number"` // Входящий номер поставки
 	OtherFields            map[string]interface{} `json:"other_fields"`             // Дополнительные пользовательские поля
 	CompanyID              int64                  `json:"company_id"`               // Кабинет компании к кому привязан товар
+	BinID                  int64                  `json:"bin_id"`                   // Ячейка склада с купленной партией, 0 - не размещена
+	CategoryID             int64                  `json:"category_id"`              // Категория материала, 0 - без категории
 }
 
 type MaterialParams struct {
 	Limit     int64
-	Offset    int64
+	Offset    int64 // С курсором не используется
 	CompanyId int64
+	Filter    MaterialFilter
+	Sort      []MaterialSort // Пусто - по id, id всегда добавляется последним для однозначного порядка
+	Cursor    string         // Курсор следующей страницы из MaterialPage, пусто - первая страница
+}
+
+// MaterialFilter условия списков материалов, нулевое значение поля - без условия
+type MaterialFilter struct {
+	WarehouseId    int64
+	SupplierId     int64
+	CategoryId     int64 // Категория вместе с вложенными
+	Status         string
+	ReceivedFrom   time.Time         // Дата поступления, включительно
+	ReceivedTo     time.Time         // Дата поступления, не включая
+	ExpirationFrom time.Time         // Срок годности, включительно
+	ExpirationTo   time.Time         // Срок годности, не включая
+	QuantityMin    *int64            // Общее количество, включительно, nil - без ограничения
+	QuantityMax    *int64            // Общее количество, включительно, nil - без ограничения
+	OtherFields    map[string]string // Значения дополнительных полей по ключу
+}
+
+// MaterialSort поле сортировки списка материалов, поле из MaterialSortFields
+type MaterialSort struct {
+	Field string
+	Desc  bool
+}
+
+// MaterialSortFields поля, по которым сортируются списки материалов
+var MaterialSortFields = map[string]bool{
+	"id":                true,
+	"name":              true,
+	"article":           true,
+	"status":            true,
+	"warehouse_id":      true,
+	"supplier_id":       true,
+	"total_quantity":    true,
+	"price_without_vat": true,
+	"received_date":     true,
+	"expiration_date":   true,
+	"last_updated":      true,
+}
+
+// MaterialPage страница списка материалов
+type MaterialPage struct {
+	Materials  []Material `json:"materials"`
+	NextCursor string     `json:"next_cursor"` // Пусто - страница последняя
+	Total      int64      `json:"total"`       // Количество материалов по фильтру во всем списке
+}
+
+// MaterialReference поставщик или склад, на который ссылаются материалы, нулевой id - без условия
+type MaterialReference struct {
+	CompanyID   int64
+	SupplierID  int64
+	WarehouseID int64
+}
+
+// ReferencingMaterials id материалов в планировании и купленных, ссылающихся на поставщика или склад
+type ReferencingMaterials struct {
+	Planning  []int64
+	Purchased []int64
+}
+
+func (r ReferencingMaterials) Empty() bool {
+	return len(r.Planning) == 0 && len(r.Purchased) == 0
+}
+
+// MaterialStatusQuarantine - партия с истекшим сроком годности, не отпускается и не резервируется до списания
+const MaterialStatusQuarantine = "quarantine"
+
+// HasExpiration срок годности задан: незаполненная дата приходит как нулевое время или начало эпохи
+func (m Material) HasExpiration() bool {
+	return m.ExpirationDate.After(time.Unix(0, 0))
+}
+
+type ExpirationParams struct {
+	Limit       int64
+	Offset      int64
+	CompanyId   int64
+	WarehouseId int64
+	Within      time.Duration // окно от текущего момента, просроченные партии входят всегда
+}
+
+// FefoPick партия, из которой предлагается отпустить товар
+type FefoPick struct {
+	MaterialID     int64     `json:"material_id"`
+	WarehouseID    int64     `json:"warehouse_id"`
+	Quantity       int64     `json:"quantity"`        // Предлагаемое к отпуску количество
+	Available      int64     `json:"available"`       // Доступный остаток партии с учетом резервов
+	ExpirationDate time.Time `json:"expiration_date"` // Срок годности, нулевое значение - не задан
+}
+
+// FefoSuggestion подбор партий по принципу first-expired-first-out
+type FefoSuggestion struct {
+	ItemID    int64      `json:"item_id"`
+	Requested int64      `json:"requested"`
+	Picks     []FefoPick `json:"picks"`
+	Shortage  int64      `json:"shortage"` // Количество, которого не хватает на складе
 }
@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+const (
+	MovementTypeReceipt    = "receipt"    // Поступление на склад
+	MovementTypeIssue      = "issue"      // Отпуск со склада (в производство и т.п.)
+	MovementTypeTransfer   = "transfer"   // Перемещение между складами
+	MovementTypeAdjustment = "adjustment" // Корректировка остатка
+	MovementTypeWriteOff   = "write_off"  // Списание
+)
+
+// Movement представляет запись в журнале движения товара.
+// Записи журнала не изменяются и не удаляются, остаток партии равен сумме Quantity по MaterialID.
+type Movement struct {
+	ID                int64     `json:"id"`                  // Уникальный идентификатор записи
+	CompanyID         int64     `json:"company_id"`          // Кабинет компании
+	MaterialID        int64     `json:"material_id"`         // Партия товара из purchased_materials
+	ItemID            int64     `json:"item_id"`             // Идентификатор товара
+	WarehouseID       int64     `json:"warehouse_id"`        // Склад, на котором изменился остаток
+	Type              string    `json:"type"`                // Тип движения
+	Quantity          int64     `json:"quantity"`            // Изменение остатка со знаком
+	BalanceAfter      int64     `json:"balance_after"`       // Остаток партии после движения
+	RelatedMovementID int64     `json:"related_movement_id"` // Парная запись для перемещений
+	Reference         string    `json:"reference"`           // Документ-основание
+	Comment           string    `json:"comment"`             // Комментарий
+	CreatedBy         int64     `json:"created_by"`          // Пользователь, создавший движение
+	CreatedAt         time.Time `json:"created_at"`          // Дата и время движения
+}
+
+// MovementRequest описывает операцию над партией товара.
+// Quantity всегда положительное, кроме корректировки, где знак задает направление.
+type MovementRequest struct {
+	CompanyID     int64
+	MaterialID    int64
+	Type          string
+	Quantity      int64
+	ToWarehouseID int64 // только для перемещения
+	Reference     string
+	Comment       string
+	CreatedBy     int64
+}
+
+type MovementParams struct {
+	Limit       int64
+	Offset      int64
+	CompanyId   int64
+	MaterialId  int64
+	ItemId      int64
+	WarehouseId int64
+	Type        string
+}
+
+type StockParams struct {
+	CompanyId   int64
+	MaterialId  int64
+	ItemId      int64
+	WarehouseId int64
+}
@@ -5,4 +5,6 @@ type Param struct {
 	Offset    int64  `json:"offset"`
 	CompanyId int64  `json:"company_id"`
 	Query     string `json:"query"`
+
+	IncludeDeleted bool `json:"include_deleted"` // Вместе с удаленными записями, только для категорий
 }
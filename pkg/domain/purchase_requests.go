@@ -0,0 +1,51 @@
+package domain
+
+import "time"
+
+// PurchaseRequest заявка на закупку из модуля закупок CRM, приходит в JetStream в формате JSON
+type PurchaseRequest struct {
+	RequestID         string                 `json:"request_id"`         // Номер заявки в модуле закупок
+	CompanyID         int64                  `json:"company_id"`         // Кабинет компании
+	WarehouseID       int64                  `json:"warehouse_id"`       // Склад поставки
+	SupplierID        int64                  `json:"supplier_id"`        // Поставщик
+	Name              string                 `json:"name"`               // Наименование товара
+	Article           string                 `json:"article"`            // Артикул товара
+	ProductCategory   string                 `json:"product_category"`   // Категория товара
+	Unit              string                 `json:"unit"`               // Единица измерения
+	Quantity          int64                  `json:"quantity"`           // Количество к закупке
+	PriceWithoutVAT   float64                `json:"price_without_vat"`  // Цена без НДС
+	Contract          time.Time              `json:"contract"`           // Дата договора
+	ResponsiblePerson string                 `json:"responsible_person"` // Ответственный за закупку
+	Comments          string                 `json:"comments"`           // Комментарии
+	OtherFields       map[string]interface{} `json:"other_fields"`       // Дополнительные пользовательские поля
+}
+
+// PurchaseRequestField - ключ OtherFields планируемого материала с номером заявки на закупку
+const PurchaseRequestField = "purchase_request_id"
+
+// Material планируемый материал по заявке
+func (r PurchaseRequest) Material() Material {
+	otherFields := make(map[string]interface{}, len(r.OtherFields)+1)
+	for k, v := range r.OtherFields {
+		otherFields[k] = v
+	}
+	otherFields[PurchaseRequestField] = r.RequestID
+
+	return Material{
+		WarehouseID:       r.WarehouseID,
+		Name:              r.Name,
+		Article:           r.Article,
+		ProductCategory:   r.ProductCategory,
+		Unit:              r.Unit,
+		TotalQuantity:     r.Quantity,
+		PriceWithoutVAT:   r.PriceWithoutVAT,
+		TotalWithoutVAT:   r.PriceWithoutVAT * float64(r.Quantity),
+		SupplierID:        r.SupplierID,
+		Contract:          r.Contract,
+		Comments:          r.Comments,
+		LastUpdated:       time.Now(),
+		ResponsiblePerson: r.ResponsiblePerson,
+		OtherFields:       otherFields,
+		CompanyID:         r.CompanyID,
+	}
+}
@@ -1,6 +1,36 @@
 package domain
 
+import (
+	"encoding/json"
+	"errors"
+)
+
+// QRInfo содержимое QR-кода купленной партии: партия и товар, по которым код разрешается обратно при сканировании
 type QRInfo struct {
 	ID     int64 `json:"id"`
 	ItemID int64 `json:"item_id"`
 }
+
+// Payload текст, кодируемый в QR-код
+func (q QRInfo) Payload() (string, error) {
+	data, err := json.Marshal(q)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// ParseQRInfo разбирает текст отсканированного QR-кода
+func ParseQRInfo(payload string) (QRInfo, error) {
+	var q QRInfo
+	if err := json.Unmarshal([]byte(payload), &q); err != nil {
+		return QRInfo{}, err
+	}
+
+	if q.ID <= 0 || q.ItemID <= 0 {
+		return QRInfo{}, errors.New("qr payload has no material")
+	}
+
+	return q, nil
+}
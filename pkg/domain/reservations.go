@@ -0,0 +1,56 @@
+package domain
+
+import "time"
+
+const (
+	ReservationStatusActive   = "active"   // Действует и уменьшает доступный остаток
+	ReservationStatusReleased = "released" // Снят без расхода
+	ReservationStatusConsumed = "consumed" // Израсходован полностью
+	ReservationStatusExpired  = "expired"  // Истек срок, в БД хранится как active
+)
+
+// Reservation представляет резерв количества партии под заказ на производство
+type Reservation struct {
+	ID               int64     `json:"id"`                // Уникальный идентификатор резерва
+	CompanyID        int64     `json:"company_id"`        // Кабинет компании
+	MaterialID       int64     `json:"material_id"`       // Партия из purchased_materials
+	ItemID           int64     `json:"item_id"`           // Идентификатор товара
+	WarehouseID      int64     `json:"warehouse_id"`      // Склад партии
+	Quantity         int64     `json:"quantity"`          // Зарезервированное количество
+	ConsumedQuantity int64     `json:"consumed_quantity"` // Израсходованное по резерву количество
+	OrderReference   string    `json:"order_reference"`   // Заказ, под который сделан резерв
+	OwnerID          int64     `json:"owner_id"`          // Пользователь, сделавший резерв
+	Status           string    `json:"status"`            // Статус резерва
+	Comment          string    `json:"comment"`           // Комментарий
+	ExpiresAt        time.Time `json:"expires_at"`        // Срок действия, нулевое значение - бессрочно
+	CreatedAt        time.Time `json:"created_at"`        // Дата создания
+	UpdatedAt        time.Time `json:"updated_at"`        // Дата последнего изменения
+	ClosedAt         time.Time `json:"closed_at"`         // Дата снятия или полного расхода
+}
+
+// Remaining количество, которое еще удерживается резервом
+func (r Reservation) Remaining() int64 {
+	return r.Quantity - r.ConsumedQuantity
+}
+
+// IsExpired резерв активен в БД, но срок его действия прошел
+func (r Reservation) IsExpired(now time.Time) bool {
+	return r.Status == ReservationStatusActive && !r.ExpiresAt.IsZero() && !r.ExpiresAt.After(now)
+}
+
+// Availability остаток партии с учетом резервов
+type Availability struct {
+	MaterialID int64 `json:"material_id"`
+	OnHand     int64 `json:"on_hand"`   // Остаток по журналу движений
+	Reserved   int64 `json:"reserved"`  // Сумма действующих резервов
+	Available  int64 `json:"available"` // Доступно к резервированию и отпуску (available-to-promise)
+}
+
+type ReservationParams struct {
+	Limit          int64
+	Offset         int64
+	CompanyId      int64
+	MaterialId     int64
+	OrderReference string
+	Status         string
+}
@@ -0,0 +1,100 @@
+package domain
+
+import "time"
+
+const (
+	StocktakeStatusOpen      = "open"      // Идет подсчет, ожидаемые остатки зафиксированы
+	StocktakeStatusClosed    = "closed"    // Утверждена, расхождения проведены корректировками
+	StocktakeStatusCancelled = "cancelled" // Отменена без проводок
+)
+
+// Способ, которым партия найдена при подсчете
+const (
+	CountSourceMaterial = "material" // По id партии
+	CountSourceItem     = "item"     // По id товара, если товар в инвентаризации одной партией
+	CountSourceQR       = "qr"       // По отсканированному QR-коду партии
+)
+
+// Stocktake инвентаризация склада или зоны склада
+type Stocktake struct {
+	ID          int64           `json:"id"`           // Уникальный идентификатор инвентаризации
+	CompanyID   int64           `json:"company_id"`   // Кабинет компании
+	WarehouseID int64           `json:"warehouse_id"` // Склад
+	ZoneID      int64           `json:"zone_id"`      // Зона склада, 0 - весь склад
+	Status      string          `json:"status"`       // Статус инвентаризации
+	Comment     string          `json:"comment"`      // Комментарий
+	CreatedBy   int64           `json:"created_by"`   // Пользователь, открывший инвентаризацию
+	ApprovedBy  int64           `json:"approved_by"`  // Пользователь, утвердивший результаты
+	CreatedAt   time.Time       `json:"created_at"`   // Дата открытия
+	UpdatedAt   time.Time       `json:"updated_at"`   // Дата последнего изменения
+	ClosedAt    time.Time       `json:"closed_at"`    // Дата утверждения или отмены
+	Lines       []StocktakeLine `json:"lines"`        // Партии к подсчету
+}
+
+// StocktakeLine партия в инвентаризации с ожидаемым и посчитанным остатком
+type StocktakeLine struct {
+	ID          int64     `json:"id"`           // Уникальный идентификатор строки
+	StocktakeID int64     `json:"stocktake_id"` // Инвентаризация
+	MaterialID  int64     `json:"material_id"`  // Партия из purchased_materials
+	ItemID      int64     `json:"item_id"`      // Идентификатор товара
+	Name        string    `json:"name"`         // Наименование на момент открытия
+	Location    string    `json:"location"`     // Место хранения на момент открытия
+	Price       float64   `json:"price"`        // Цена без НДС за единицу
+	Expected    int64     `json:"expected"`     // Остаток по журналу на момент открытия
+	Counted     int64     `json:"counted"`      // Посчитанный остаток, последний подсчет
+	IsCounted   bool      `json:"is_counted"`   // Партия посчитана
+	CountedBy   int64     `json:"counted_by"`   // Пользователь, посчитавший партию последним
+	CountedAt   time.Time `json:"counted_at"`   // Дата последнего подсчета
+}
+
+// Variance расхождение посчитанного остатка с ожидаемым, у непосчитанной партии 0
+func (l StocktakeLine) Variance() int64 {
+	if !l.IsCounted {
+		return 0
+	}
+
+	return l.Counted - l.Expected
+}
+
+// StocktakeCount запись подсчета, подсчеты не изменяются и не удаляются
+type StocktakeCount struct {
+	ID          int64     `json:"id"`
+	StocktakeID int64     `json:"stocktake_id"`
+	LineID      int64     `json:"line_id"`
+	MaterialID  int64     `json:"material_id"`
+	Quantity    int64     `json:"quantity"`   // Посчитанный остаток партии
+	Source      string    `json:"source"`     // Способ поиска партии: material, item или qr
+	CountedBy   int64     `json:"counted_by"` // Пользователь, выполнивший подсчет
+	CountedAt   time.Time `json:"counted_at"` // Дата подсчета
+}
+
+// StocktakeCountRequest подсчет партии: партия задается id, id товара или текстом QR-кода
+type StocktakeCountRequest struct {
+	StocktakeID int64
+	CompanyID   int64
+	MaterialID  int64
+	ItemID      int64
+	QRPayload   string
+	Quantity    int64
+	CountedBy   int64
+}
+
+// StocktakeVariance отчет о расхождениях инвентаризации
+type StocktakeVariance struct {
+	StocktakeID   int64           `json:"stocktake_id"`
+	Lines         []StocktakeLine `json:"lines"`          // Только посчитанные партии с расхождением
+	TotalLines    int64           `json:"total_lines"`    // Партий в инвентаризации
+	CountedLines  int64           `json:"counted_lines"`  // Посчитано партий
+	Surplus       int64           `json:"surplus"`        // Излишки, единиц
+	Shortage      int64           `json:"shortage"`       // Недостача, единиц
+	SurplusValue  float64         `json:"surplus_value"`  // Излишки по цене без НДС
+	ShortageValue float64         `json:"shortage_value"` // Недостача по цене без НДС
+}
+
+type StocktakeParams struct {
+	Limit       int64
+	Offset      int64
+	CompanyId   int64
+	WarehouseId int64
+	Status      string
+}
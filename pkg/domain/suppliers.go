@@ -28,4 +28,5 @@ type Supplier struct {
 	IsActive          bool                   `json:"is_active"`          // Статус активности поставщика (активен/неактивен)
 	OtherFields       map[string]interface{} `json:"other_fields"`       // Дополнительные пользовательские поля
 	CompanyID         int64                  `json:"company_id"`         // ID компании
+	DeletedAt         time.Time              `json:"deleted_at"`         // Дата удаления, нулевая - поставщик не удален
 }
@@ -9,4 +9,17 @@ var (
 	TableSupplier                  = "suppliers"
 	TableMaterialCategories        = "material_categories"
 	UsersTable                     = "users"
+	TableStockMovements            = "stock_movements"
+	TableTransferOrders            = "transfer_orders"
+	TableTransferOrderItems        = "transfer_order_items"
+	TableReservations              = "reservations"
+	TableLowStockAlerts            = "low_stock_alerts"
+	TableEventOutbox               = "event_outbox"
+	TableInboxMessages             = "inbox_messages"
+	TableAnalyticsCursors          = "analytics_cursors"
+	TableStorageLocations          = "storage_locations"
+	TableStocktakes                = "stocktakes"
+	TableStocktakeLines            = "stocktake_lines"
+	TableStocktakeCounts           = "stocktake_counts"
+	TableAuditLog                  = "audit_log"
 )
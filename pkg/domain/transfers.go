@@ -0,0 +1,66 @@
+package domain
+
+import "time"
+
+const (
+	TransferStatusDraft             = "draft"              // Черновик, товар еще на складе-отправителе
+	TransferStatusShipped           = "shipped"            // Отгружен, товар в пути
+	TransferStatusPartiallyReceived = "partially_received" // Принят частично
+	TransferStatusReceived          = "received"           // Принят полностью
+	TransferStatusCancelled         = "cancelled"          // Отменен до отгрузки
+)
+
+// TransferOrder представляет заказ на перемещение товара между складами
+type TransferOrder struct {
+	ID                     int64               `json:"id"`                       // Уникальный идентификатор заказа
+	CompanyID              int64               `json:"company_id"`               // Кабинет компании
+	SourceWarehouseID      int64               `json:"source_warehouse_id"`      // Склад-отправитель
+	DestinationWarehouseID int64               `json:"destination_warehouse_id"` // Склад-получатель
+	Status                 string              `json:"status"`                   // Статус заказа
+	Comment                string              `json:"comment"`                  // Комментарий
+	CreatedBy              int64               `json:"created_by"`               // Пользователь, создавший заказ
+	CreatedAt              time.Time           `json:"created_at"`               // Дата создания
+	UpdatedAt              time.Time           `json:"updated_at"`               // Дата последнего изменения
+	ShippedAt              time.Time           `json:"shipped_at"`               // Дата отгрузки
+	ReceivedAt             time.Time           `json:"received_at"`              // Дата полной приемки
+	Items                  []TransferOrderItem `json:"items"`                    // Позиции заказа
+}
+
+// TransferOrderItem позиция заказа на перемещение
+type TransferOrderItem struct {
+	ID                    int64 `json:"id"`                      // Уникальный идентификатор позиции
+	TransferOrderID       int64 `json:"transfer_order_id"`       // Заказ на перемещение
+	MaterialID            int64 `json:"material_id"`             // Партия на складе-отправителе
+	ItemID                int64 `json:"item_id"`                 // Идентификатор товара
+	DestinationMaterialID int64 `json:"destination_material_id"` // Партия на складе-получателе
+	Quantity              int64 `json:"quantity"`                // Заказанное количество
+	ShippedQuantity       int64 `json:"shipped_quantity"`        // Отгруженное количество
+	ShippedVolume         int64 `json:"shipped_volume"`          // Отгруженный объем
+	ReceivedQuantity      int64 `json:"received_quantity"`       // Принятое количество
+	ReceivedVolume        int64 `json:"received_volume"`         // Принятый объем
+}
+
+// InTransit количество, которое отгружено, но еще не принято
+func (i TransferOrderItem) InTransit() int64 {
+	return i.ShippedQuantity - i.ReceivedQuantity
+}
+
+// TransferReceipt приемка количества по позиции заказа
+type TransferReceipt struct {
+	ItemID   int64 // id позиции заказа
+	Quantity int64
+}
+
+type TransferOrderParams struct {
+	Limit       int64
+	Offset      int64
+	CompanyId   int64
+	WarehouseId int64 // склад-отправитель или получатель
+	Status      string
+}
+
+type InTransitParams struct {
+	CompanyId   int64
+	WarehouseId int64 // склад-получатель
+	ItemId      int64
+}
@@ -1,5 +1,13 @@
 package domain
 
+import "time"
+
+// Политика склада при приемке объема сверх MaxCapacity
+const (
+	CapacityPolicyReject = "reject" // Приемка отклоняется
+	CapacityPolicyWarn   = "warn"   // Приемка проходит, публикуется предупреждение
+)
+
 // Warehouse представляет данные о складе
 type Warehouse struct {
 	ID                int64                  `json:"id"`                 // Уникальный идентификатор склада
@@ -8,9 +16,25 @@ type Warehouse struct {
 	ResponsiblePerson string                 `json:"responsible_person"` // Ответственное лицо за склад
 	Phone             string                 `json:"phone"`              // Контактный телефон склада
 	Email             string                 `json:"email"`              // Электронная почта для связи
-	MaxCapacity       int64                  `json:"max_capacity"`       // Максимальная вместимость склада
-	CurrentOccupancy  int64                  `json:"current_occupancy"`  // Текущая заполняемость склада
+	MaxCapacity       int64                  `json:"max_capacity"`       // Максимальная вместимость склада, 0 - без ограничения
+	CurrentOccupancy  int64                  `json:"current_occupancy"`  // Объем купленных партий на складе, считается сервисом
+	CapacityPolicy    string                 `json:"capacity_policy"`    // Политика при превышении вместимости: reject или warn
 	OtherFields       map[string]interface{} `json:"other_fields"`       // Дополнительные пользовательские поля
 	Country           string                 `json:"country"`            // Страна склада
 	CompanyID         int64                  `json:"company_id"`         // ID компании
+	DeletedAt         time.Time              `json:"deleted_at"`         // Дата удаления, нулевая - склад не удален
+}
+
+// WarehouseOccupancy заполненность склада после изменения его партий
+type WarehouseOccupancy struct {
+	WarehouseID    int64  `json:"warehouse_id"`
+	CompanyID      int64  `json:"company_id"`
+	MaxCapacity    int64  `json:"max_capacity"`
+	Occupancy      int64  `json:"occupancy"`
+	CapacityPolicy string `json:"capacity_policy"`
+}
+
+// Exceeded объем партий больше ограниченной вместимости склада
+func (o WarehouseOccupancy) Exceeded() bool {
+	return o.MaxCapacity > 0 && o.Occupancy > o.MaxCapacity
 }
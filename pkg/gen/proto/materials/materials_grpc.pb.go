@@ -20,31 +20,41 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	MaterialService_CreatePlanning_FullMethodName          = "/materials.MaterialService/CreatePlanning"
-	MaterialService_UpdatePlanning_FullMethodName          = "/materials.MaterialService/UpdatePlanning"
-	MaterialService_DeletePlanning_FullMethodName          = "/materials.MaterialService/DeletePlanning"
-	MaterialService_GetPlanning_FullMethodName             = "/materials.MaterialService/GetPlanning"
-	MaterialService_GetListPlanning_FullMethodName         = "/materials.MaterialService/GetListPlanning"
-	MaterialService_MovePlanningToPurchased_FullMethodName = "/materials.MaterialService/MovePlanningToPurchased"
-	MaterialService_CreatePurchased_FullMethodName         = "/materials.MaterialService/CreatePurchased"
-	MaterialService_UpdatePurchased_FullMethodName         = "/materials.MaterialService/UpdatePurchased"
-	MaterialService_DeletePurchased_FullMethodName         = "/materials.MaterialService/DeletePurchased"
-	MaterialService_GetPurchased_FullMethodName            = "/materials.MaterialService/GetPurchased"
-	MaterialService_GetListPurchased_FullMethodName        = "/materials.MaterialService/GetListPurchased"
-	MaterialService_MovePurchasedToArchive_FullMethodName  = "/materials.MaterialService/MovePurchasedToArchive"
-	MaterialService_GetPlanningArchive_FullMethodName      = "/materials.MaterialService/GetPlanningArchive"
-	MaterialService_GetPurchasedArchive_FullMethodName     = "/materials.MaterialService/GetPurchasedArchive"
-	MaterialService_GetListPlanningArchive_FullMethodName  = "/materials.MaterialService/GetListPlanningArchive"
-	MaterialService_GetListPurchasedArchive_FullMethodName = "/materials.MaterialService/GetListPurchasedArchive"
-	MaterialService_DeletePlanningArchive_FullMethodName   = "/materials.MaterialService/DeletePlanningArchive"
-	MaterialService_DeletePurchasedArchive_FullMethodName  = "/materials.MaterialService/DeletePurchasedArchive"
-	MaterialService_SearchMaterial_FullMethodName          = "/materials.MaterialService/SearchMaterial"
-	MaterialService_CreateMaterialCategory_FullMethodName  = "/materials.MaterialService/CreateMaterialCategory"
-	MaterialService_GetByIdMaterialCategory_FullMethodName = "/materials.MaterialService/GetByIdMaterialCategory"
-	MaterialService_UpdateMaterialCategory_FullMethodName  = "/materials.MaterialService/UpdateMaterialCategory"
-	MaterialService_DeleteMaterialCategory_FullMethodName  = "/materials.MaterialService/DeleteMaterialCategory"
-	MaterialService_GetListMaterialCategory_FullMethodName = "/materials.MaterialService/GetListMaterialCategory"
-	MaterialService_SearchMaterialCategory_FullMethodName  = "/materials.MaterialService/SearchMaterialCategory"
+	MaterialService_CreatePlanning_FullMethodName             = "/materials.MaterialService/CreatePlanning"
+	MaterialService_UpdatePlanning_FullMethodName             = "/materials.MaterialService/UpdatePlanning"
+	MaterialService_DeletePlanning_FullMethodName             = "/materials.MaterialService/DeletePlanning"
+	MaterialService_GetPlanning_FullMethodName                = "/materials.MaterialService/GetPlanning"
+	MaterialService_GetListPlanning_FullMethodName            = "/materials.MaterialService/GetListPlanning"
+	MaterialService_MovePlanningToPurchased_FullMethodName    = "/materials.MaterialService/MovePlanningToPurchased"
+	MaterialService_CreatePurchased_FullMethodName            = "/materials.MaterialService/CreatePurchased"
+	MaterialService_UpdatePurchased_FullMethodName            = "/materials.MaterialService/UpdatePurchased"
+	MaterialService_DeletePurchased_FullMethodName            = "/materials.MaterialService/DeletePurchased"
+	MaterialService_GetPurchased_FullMethodName               = "/materials.MaterialService/GetPurchased"
+	MaterialService_GetListPurchased_FullMethodName           = "/materials.MaterialService/GetListPurchased"
+	MaterialService_MovePurchasedToArchive_FullMethodName     = "/materials.MaterialService/MovePurchasedToArchive"
+	MaterialService_GetPlanningArchive_FullMethodName         = "/materials.MaterialService/GetPlanningArchive"
+	MaterialService_GetPurchasedArchive_FullMethodName        = "/materials.MaterialService/GetPurchasedArchive"
+	MaterialService_GetListPlanningArchive_FullMethodName     = "/materials.MaterialService/GetListPlanningArchive"
+	MaterialService_GetListPurchasedArchive_FullMethodName    = "/materials.MaterialService/GetListPurchasedArchive"
+	MaterialService_DeletePlanningArchive_FullMethodName      = "/materials.MaterialService/DeletePlanningArchive"
+	MaterialService_DeletePurchasedArchive_FullMethodName     = "/materials.MaterialService/DeletePurchasedArchive"
+	MaterialService_SearchMaterial_FullMethodName             = "/materials.MaterialService/SearchMaterial"
+	MaterialService_CreateMaterialCategory_FullMethodName     = "/materials.MaterialService/CreateMaterialCategory"
+	MaterialService_GetByIdMaterialCategory_FullMethodName    = "/materials.MaterialService/GetByIdMaterialCategory"
+	MaterialService_UpdateMaterialCategory_FullMethodName     = "/materials.MaterialService/UpdateMaterialCategory"
+	MaterialService_DeleteMaterialCategory_FullMethodName     = "/materials.MaterialService/DeleteMaterialCategory"
+	MaterialService_GetListMaterialCategory_FullMethodName    = "/materials.MaterialService/GetListMaterialCategory"
+	MaterialService_SearchMaterialCategory_FullMethodName     = "/materials.MaterialService/SearchMaterialCategory"
+	MaterialService_RestoreMaterialCategory_FullMethodName    = "/materials.MaterialService/RestoreMaterialCategory"
+	MaterialService_MoveMaterialCategory_FullMethodName       = "/materials.MaterialService/MoveMaterialCategory"
+	MaterialService_ReparentMaterialCategories_FullMethodName = "/materials.MaterialService/ReparentMaterialCategories"
+	MaterialService_GetExpiring_FullMethodName                = "/materials.MaterialService/GetExpiring"
+	MaterialService_QuarantineExpired_FullMethodName          = "/materials.MaterialService/QuarantineExpired"
+	MaterialService_SuggestFefo_FullMethodName                = "/materials.MaterialService/SuggestFefo"
+	MaterialService_GetQRCode_FullMethodName                  = "/materials.MaterialService/GetQRCode"
+	MaterialService_GetLabels_FullMethodName                  = "/materials.MaterialService/GetLabels"
+	MaterialService_ScanQR_FullMethodName                     = "/materials.MaterialService/ScanQR"
+	MaterialService_PutAway_FullMethodName                    = "/materials.MaterialService/PutAway"
 )
 
 // MaterialServiceClient is the client API for MaterialService service.
@@ -76,6 +86,16 @@ type MaterialServiceClient interface {
 	DeleteMaterialCategory(ctx context.Context, in *MaterialCategoryId, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetListMaterialCategory(ctx context.Context, in *MaterialParams, opts ...grpc.CallOption) (*MaterialCategoryList, error)
 	SearchMaterialCategory(ctx context.Context, in *MaterialParams, opts ...grpc.CallOption) (*MaterialCategoryList, error)
+	RestoreMaterialCategory(ctx context.Context, in *MaterialCategoryId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MoveMaterialCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReparentMaterialCategories(ctx context.Context, in *ReparentCategoriesRequest, opts ...grpc.CallOption) (*ReparentCategoriesResult, error)
+	GetExpiring(ctx context.Context, in *ExpirationParams, opts ...grpc.CallOption) (*MaterialList, error)
+	QuarantineExpired(ctx context.Context, in *QuarantineRequest, opts ...grpc.CallOption) (*QuarantineResult, error)
+	SuggestFefo(ctx context.Context, in *FefoRequest, opts ...grpc.CallOption) (*FefoSuggestion, error)
+	GetQRCode(ctx context.Context, in *MaterialId, opts ...grpc.CallOption) (*QRCode, error)
+	GetLabels(ctx context.Context, in *LabelsRequest, opts ...grpc.CallOption) (*LabelsDocument, error)
+	ScanQR(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (*Material, error)
+	PutAway(ctx context.Context, in *PutAwayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type materialServiceClient struct {
@@ -336,6 +356,106 @@ func (c *materialServiceClient) SearchMaterialCategory(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *materialServiceClient) RestoreMaterialCategory(ctx context.Context, in *MaterialCategoryId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MaterialService_RestoreMaterialCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialServiceClient) MoveMaterialCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MaterialService_MoveMaterialCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialServiceClient) ReparentMaterialCategories(ctx context.Context, in *ReparentCategoriesRequest, opts ...grpc.CallOption) (*ReparentCategoriesResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReparentCategoriesResult)
+	err := c.cc.Invoke(ctx, MaterialService_ReparentMaterialCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialServiceClient) GetExpiring(ctx context.Context, in *ExpirationParams, opts ...grpc.CallOption) (*MaterialList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaterialList)
+	err := c.cc.Invoke(ctx, MaterialService_GetExpiring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialServiceClient) QuarantineExpired(ctx context.Context, in *QuarantineRequest, opts ...grpc.CallOption) (*QuarantineResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuarantineResult)
+	err := c.cc.Invoke(ctx, MaterialService_QuarantineExpired_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialServiceClient) SuggestFefo(ctx context.Context, in *FefoRequest, opts ...grpc.CallOption) (*FefoSuggestion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FefoSuggestion)
+	err := c.cc.Invoke(ctx, MaterialService_SuggestFefo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialServiceClient) GetQRCode(ctx context.Context, in *MaterialId, opts ...grpc.CallOption) (*QRCode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QRCode)
+	err := c.cc.Invoke(ctx, MaterialService_GetQRCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialServiceClient) GetLabels(ctx context.Context, in *LabelsRequest, opts ...grpc.CallOption) (*LabelsDocument, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LabelsDocument)
+	err := c.cc.Invoke(ctx, MaterialService_GetLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialServiceClient) ScanQR(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (*Material, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Material)
+	err := c.cc.Invoke(ctx, MaterialService_ScanQR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialServiceClient) PutAway(ctx context.Context, in *PutAwayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MaterialService_PutAway_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MaterialServiceServer is the server API for MaterialService service.
 // All implementations should embed UnimplementedMaterialServiceServer
 // for forward compatibility
@@ -365,6 +485,16 @@ type MaterialServiceServer interface {
 	DeleteMaterialCategory(context.Context, *MaterialCategoryId) (*emptypb.Empty, error)
 	GetListMaterialCategory(context.Context, *MaterialParams) (*MaterialCategoryList, error)
 	SearchMaterialCategory(context.Context, *MaterialParams) (*MaterialCategoryList, error)
+	RestoreMaterialCategory(context.Context, *MaterialCategoryId) (*emptypb.Empty, error)
+	MoveMaterialCategory(context.Context, *MoveCategoryRequest) (*emptypb.Empty, error)
+	ReparentMaterialCategories(context.Context, *ReparentCategoriesRequest) (*ReparentCategoriesResult, error)
+	GetExpiring(context.Context, *ExpirationParams) (*MaterialList, error)
+	QuarantineExpired(context.Context, *QuarantineRequest) (*QuarantineResult, error)
+	SuggestFefo(context.Context, *FefoRequest) (*FefoSuggestion, error)
+	GetQRCode(context.Context, *MaterialId) (*QRCode, error)
+	GetLabels(context.Context, *LabelsRequest) (*LabelsDocument, error)
+	ScanQR(context.Context, *ScanRequest) (*Material, error)
+	PutAway(context.Context, *PutAwayRequest) (*emptypb.Empty, error)
 }
 
 // UnimplementedMaterialServiceServer should be embedded to have forward compatible implementations.
@@ -446,6 +576,36 @@ func (UnimplementedMaterialServiceServer) GetListMaterialCategory(context.Contex
 func (UnimplementedMaterialServiceServer) SearchMaterialCategory(context.Context, *MaterialParams) (*MaterialCategoryList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchMaterialCategory not implemented")
 }
+func (UnimplementedMaterialServiceServer) RestoreMaterialCategory(context.Context, *MaterialCategoryId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMaterialCategory not implemented")
+}
+func (UnimplementedMaterialServiceServer) MoveMaterialCategory(context.Context, *MoveCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveMaterialCategory not implemented")
+}
+func (UnimplementedMaterialServiceServer) ReparentMaterialCategories(context.Context, *ReparentCategoriesRequest) (*ReparentCategoriesResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReparentMaterialCategories not implemented")
+}
+func (UnimplementedMaterialServiceServer) GetExpiring(context.Context, *ExpirationParams) (*MaterialList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpiring not implemented")
+}
+func (UnimplementedMaterialServiceServer) QuarantineExpired(context.Context, *QuarantineRequest) (*QuarantineResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuarantineExpired not implemented")
+}
+func (UnimplementedMaterialServiceServer) SuggestFefo(context.Context, *FefoRequest) (*FefoSuggestion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestFefo not implemented")
+}
+func (UnimplementedMaterialServiceServer) GetQRCode(context.Context, *MaterialId) (*QRCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQRCode not implemented")
+}
+func (UnimplementedMaterialServiceServer) GetLabels(context.Context, *LabelsRequest) (*LabelsDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLabels not implemented")
+}
+func (UnimplementedMaterialServiceServer) ScanQR(context.Context, *ScanRequest) (*Material, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanQR not implemented")
+}
+func (UnimplementedMaterialServiceServer) PutAway(context.Context, *PutAwayRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutAway not implemented")
+}
 
 // UnsafeMaterialServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to MaterialServiceServer will
@@ -908,6 +1068,186 @@ func _MaterialService_SearchMaterialCategory_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MaterialService_RestoreMaterialCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaterialCategoryId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).RestoreMaterialCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_RestoreMaterialCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).RestoreMaterialCategory(ctx, req.(*MaterialCategoryId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialService_MoveMaterialCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).MoveMaterialCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_MoveMaterialCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).MoveMaterialCategory(ctx, req.(*MoveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialService_ReparentMaterialCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReparentCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).ReparentMaterialCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_ReparentMaterialCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).ReparentMaterialCategories(ctx, req.(*ReparentCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialService_GetExpiring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpirationParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).GetExpiring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_GetExpiring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).GetExpiring(ctx, req.(*ExpirationParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialService_QuarantineExpired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).QuarantineExpired(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_QuarantineExpired_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).QuarantineExpired(ctx, req.(*QuarantineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialService_SuggestFefo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FefoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).SuggestFefo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_SuggestFefo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).SuggestFefo(ctx, req.(*FefoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialService_GetQRCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaterialId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).GetQRCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_GetQRCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).GetQRCode(ctx, req.(*MaterialId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialService_GetLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).GetLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_GetLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).GetLabels(ctx, req.(*LabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialService_ScanQR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).ScanQR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_ScanQR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).ScanQR(ctx, req.(*ScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialService_PutAway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutAwayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).PutAway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_PutAway_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).PutAway(ctx, req.(*PutAwayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MaterialService_ServiceDesc is the grpc.ServiceDesc for MaterialService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1015,6 +1355,46 @@ var MaterialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchMaterialCategory",
 			Handler:    _MaterialService_SearchMaterialCategory_Handler,
 		},
+		{
+			MethodName: "RestoreMaterialCategory",
+			Handler:    _MaterialService_RestoreMaterialCategory_Handler,
+		},
+		{
+			MethodName: "MoveMaterialCategory",
+			Handler:    _MaterialService_MoveMaterialCategory_Handler,
+		},
+		{
+			MethodName: "ReparentMaterialCategories",
+			Handler:    _MaterialService_ReparentMaterialCategories_Handler,
+		},
+		{
+			MethodName: "GetExpiring",
+			Handler:    _MaterialService_GetExpiring_Handler,
+		},
+		{
+			MethodName: "QuarantineExpired",
+			Handler:    _MaterialService_QuarantineExpired_Handler,
+		},
+		{
+			MethodName: "SuggestFefo",
+			Handler:    _MaterialService_SuggestFefo_Handler,
+		},
+		{
+			MethodName: "GetQRCode",
+			Handler:    _MaterialService_GetQRCode_Handler,
+		},
+		{
+			MethodName: "GetLabels",
+			Handler:    _MaterialService_GetLabels_Handler,
+		},
+		{
+			MethodName: "ScanQR",
+			Handler:    _MaterialService_ScanQR_Handler,
+		},
+		{
+			MethodName: "PutAway",
+			Handler:    _MaterialService_PutAway_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/materials/materials.proto",
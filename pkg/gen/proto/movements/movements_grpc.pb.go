@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             v3.20.3
+// source: proto/movements/movements.proto
+
+package movements
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	MovementService_Create_FullMethodName         = "/movements.MovementService/Create"
+	MovementService_GetById_FullMethodName        = "/movements.MovementService/GetById"
+	MovementService_GetList_FullMethodName        = "/movements.MovementService/GetList"
+	MovementService_GetStockOnHand_FullMethodName = "/movements.MovementService/GetStockOnHand"
+)
+
+// MovementServiceClient is the client API for MovementService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MovementServiceClient interface {
+	Create(ctx context.Context, in *MovementRequest, opts ...grpc.CallOption) (*MovementList, error)
+	GetById(ctx context.Context, in *MovementId, opts ...grpc.CallOption) (*Movement, error)
+	GetList(ctx context.Context, in *MovementParams, opts ...grpc.CallOption) (*MovementList, error)
+	GetStockOnHand(ctx context.Context, in *StockParams, opts ...grpc.CallOption) (*StockOnHand, error)
+}
+
+type movementServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMovementServiceClient(cc grpc.ClientConnInterface) MovementServiceClient {
+	return &movementServiceClient{cc}
+}
+
+func (c *movementServiceClient) Create(ctx context.Context, in *MovementRequest, opts ...grpc.CallOption) (*MovementList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovementList)
+	err := c.cc.Invoke(ctx, MovementService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movementServiceClient) GetById(ctx context.Context, in *MovementId, opts ...grpc.CallOption) (*Movement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Movement)
+	err := c.cc.Invoke(ctx, MovementService_GetById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movementServiceClient) GetList(ctx context.Context, in *MovementParams, opts ...grpc.CallOption) (*MovementList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovementList)
+	err := c.cc.Invoke(ctx, MovementService_GetList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movementServiceClient) GetStockOnHand(ctx context.Context, in *StockParams, opts ...grpc.CallOption) (*StockOnHand, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockOnHand)
+	err := c.cc.Invoke(ctx, MovementService_GetStockOnHand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MovementServiceServer is the server API for MovementService service.
+// All implementations should embed UnimplementedMovementServiceServer
+// for forward compatibility
+type MovementServiceServer interface {
+	Create(context.Context, *MovementRequest) (*MovementList, error)
+	GetById(context.Context, *MovementId) (*Movement, error)
+	GetList(context.Context, *MovementParams) (*MovementList, error)
+	GetStockOnHand(context.Context, *StockParams) (*StockOnHand, error)
+}
+
+// UnimplementedMovementServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedMovementServiceServer struct {
+}
+
+func (UnimplementedMovementServiceServer) Create(context.Context, *MovementRequest) (*MovementList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedMovementServiceServer) GetById(context.Context, *MovementId) (*Movement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetById not implemented")
+}
+func (UnimplementedMovementServiceServer) GetList(context.Context, *MovementParams) (*MovementList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedMovementServiceServer) GetStockOnHand(context.Context, *StockParams) (*StockOnHand, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockOnHand not implemented")
+}
+
+// UnsafeMovementServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MovementServiceServer will
+// result in compilation errors.
+type UnsafeMovementServiceServer interface {
+	mustEmbedUnimplementedMovementServiceServer()
+}
+
+func RegisterMovementServiceServer(s grpc.ServiceRegistrar, srv MovementServiceServer) {
+	s.RegisterService(&MovementService_ServiceDesc, srv)
+}
+
+func _MovementService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovementServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovementService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovementServiceServer).Create(ctx, req.(*MovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovementService_GetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovementServiceServer).GetById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovementService_GetById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovementServiceServer).GetById(ctx, req.(*MovementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovementService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovementParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovementServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovementService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovementServiceServer).GetList(ctx, req.(*MovementParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovementService_GetStockOnHand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovementServiceServer).GetStockOnHand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovementService_GetStockOnHand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovementServiceServer).GetStockOnHand(ctx, req.(*StockParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MovementService_ServiceDesc is the grpc.ServiceDesc for MovementService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MovementService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "movements.MovementService",
+	HandlerType: (*MovementServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _MovementService_Create_Handler,
+		},
+		{
+			MethodName: "GetById",
+			Handler:    _MovementService_GetById_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _MovementService_GetList_Handler,
+		},
+		{
+			MethodName: "GetStockOnHand",
+			Handler:    _MovementService_GetStockOnHand_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/movements/movements.proto",
+}
@@ -0,0 +1,188 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             v3.20.3
+// source: proto/reports/reports.proto
+
+package reports
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	ReportService_GetStockOnDate_FullMethodName      = "/reports.ReportService/GetStockOnDate"
+	ReportService_GetCategoryTurnover_FullMethodName = "/reports.ReportService/GetCategoryTurnover"
+	ReportService_GetSupplierSpend_FullMethodName    = "/reports.ReportService/GetSupplierSpend"
+)
+
+// ReportServiceClient is the client API for ReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReportService - отчеты по данным аналитики в ClickHouse
+type ReportServiceClient interface {
+	GetStockOnDate(ctx context.Context, in *StockOnDateRequest, opts ...grpc.CallOption) (*StockBalanceList, error)
+	GetCategoryTurnover(ctx context.Context, in *PeriodRequest, opts ...grpc.CallOption) (*CategoryTurnoverList, error)
+	GetSupplierSpend(ctx context.Context, in *PeriodRequest, opts ...grpc.CallOption) (*SupplierSpendList, error)
+}
+
+type reportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReportServiceClient(cc grpc.ClientConnInterface) ReportServiceClient {
+	return &reportServiceClient{cc}
+}
+
+func (c *reportServiceClient) GetStockOnDate(ctx context.Context, in *StockOnDateRequest, opts ...grpc.CallOption) (*StockBalanceList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockBalanceList)
+	err := c.cc.Invoke(ctx, ReportService_GetStockOnDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) GetCategoryTurnover(ctx context.Context, in *PeriodRequest, opts ...grpc.CallOption) (*CategoryTurnoverList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryTurnoverList)
+	err := c.cc.Invoke(ctx, ReportService_GetCategoryTurnover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) GetSupplierSpend(ctx context.Context, in *PeriodRequest, opts ...grpc.CallOption) (*SupplierSpendList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierSpendList)
+	err := c.cc.Invoke(ctx, ReportService_GetSupplierSpend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReportServiceServer is the server API for ReportService service.
+// All implementations should embed UnimplementedReportServiceServer
+// for forward compatibility
+//
+// ReportService - отчеты по данным аналитики в ClickHouse
+type ReportServiceServer interface {
+	GetStockOnDate(context.Context, *StockOnDateRequest) (*StockBalanceList, error)
+	GetCategoryTurnover(context.Context, *PeriodRequest) (*CategoryTurnoverList, error)
+	GetSupplierSpend(context.Context, *PeriodRequest) (*SupplierSpendList, error)
+}
+
+// UnimplementedReportServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedReportServiceServer struct {
+}
+
+func (UnimplementedReportServiceServer) GetStockOnDate(context.Context, *StockOnDateRequest) (*StockBalanceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockOnDate not implemented")
+}
+func (UnimplementedReportServiceServer) GetCategoryTurnover(context.Context, *PeriodRequest) (*CategoryTurnoverList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryTurnover not implemented")
+}
+func (UnimplementedReportServiceServer) GetSupplierSpend(context.Context, *PeriodRequest) (*SupplierSpendList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSupplierSpend not implemented")
+}
+
+// UnsafeReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReportServiceServer will
+// result in compilation errors.
+type UnsafeReportServiceServer interface {
+	mustEmbedUnimplementedReportServiceServer()
+}
+
+func RegisterReportServiceServer(s grpc.ServiceRegistrar, srv ReportServiceServer) {
+	s.RegisterService(&ReportService_ServiceDesc, srv)
+}
+
+func _ReportService_GetStockOnDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockOnDateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetStockOnDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetStockOnDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetStockOnDate(ctx, req.(*StockOnDateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_GetCategoryTurnover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetCategoryTurnover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetCategoryTurnover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetCategoryTurnover(ctx, req.(*PeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_GetSupplierSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetSupplierSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetSupplierSpend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetSupplierSpend(ctx, req.(*PeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReportService_ServiceDesc is the grpc.ServiceDesc for ReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reports.ReportService",
+	HandlerType: (*ReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStockOnDate",
+			Handler:    _ReportService_GetStockOnDate_Handler,
+		},
+		{
+			MethodName: "GetCategoryTurnover",
+			Handler:    _ReportService_GetCategoryTurnover_Handler,
+		},
+		{
+			MethodName: "GetSupplierSpend",
+			Handler:    _ReportService_GetSupplierSpend_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/reports/reports.proto",
+}
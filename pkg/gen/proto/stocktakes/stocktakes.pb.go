@@ -0,0 +1,1340 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.3
+// source: proto/stocktakes/stocktakes.proto
+
+package stocktakes
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Stocktake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                      // Уникальный идентификатор инвентаризации
+	CompanyId   int64                  `protobuf:"varint,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`       // Кабинет компании
+	WarehouseId int64                  `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"` // Склад
+	ZoneId      int64                  `protobuf:"varint,4,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`                // Зона склада, 0 - весь склад
+	Status      string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                               // Статус: open, closed, cancelled
+	Comment     string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`                             // Комментарий
+	CreatedBy   int64                  `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`       // Пользователь, открывший инвентаризацию
+	ApprovedBy  int64                  `protobuf:"varint,8,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`    // Пользователь, утвердивший результаты
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`        // Дата открытия
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`       // Дата последнего изменения
+	ClosedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`          // Дата утверждения или отмены
+	Lines       []*StocktakeLine       `protobuf:"bytes,12,rep,name=lines,proto3" json:"lines,omitempty"`                                // Партии к подсчету, в списке не заполняются
+}
+
+func (x *Stocktake) Reset() {
+	*x = Stocktake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stocktake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stocktake) ProtoMessage() {}
+
+func (x *Stocktake) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stocktake.ProtoReflect.Descriptor instead.
+func (*Stocktake) Descriptor() ([]byte, []int) {
+	return file_proto_stocktakes_stocktakes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Stocktake) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Stocktake) GetCompanyId() int64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *Stocktake) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *Stocktake) GetZoneId() int64 {
+	if x != nil {
+		return x.ZoneId
+	}
+	return 0
+}
+
+func (x *Stocktake) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Stocktake) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Stocktake) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Stocktake) GetApprovedBy() int64 {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return 0
+}
+
+func (x *Stocktake) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Stocktake) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Stocktake) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *Stocktake) GetLines() []*StocktakeLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type StocktakeLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                      // Уникальный идентификатор строки
+	StocktakeId int64                  `protobuf:"varint,2,opt,name=stocktake_id,json=stocktakeId,proto3" json:"stocktake_id,omitempty"` // Инвентаризация
+	MaterialId  int64                  `protobuf:"varint,3,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`    // Партия из закупленных материалов
+	ItemId      int64                  `protobuf:"varint,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                // Идентификатор товара
+	Name        string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                                   // Наименование на момент открытия
+	Location    string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`                           // Место хранения на момент открытия
+	Price       float64                `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`                               // Цена без НДС за единицу
+	Expected    int64                  `protobuf:"varint,8,opt,name=expected,proto3" json:"expected,omitempty"`                          // Остаток по журналу на момент открытия
+	Counted     int64                  `protobuf:"varint,9,opt,name=counted,proto3" json:"counted,omitempty"`                            // Посчитанный остаток
+	IsCounted   bool                   `protobuf:"varint,10,opt,name=is_counted,json=isCounted,proto3" json:"is_counted,omitempty"`      // Партия посчитана
+	Variance    int64                  `protobuf:"varint,11,opt,name=variance,proto3" json:"variance,omitempty"`                         // Расхождение, посчитано минус ожидаемо
+	CountedBy   int64                  `protobuf:"varint,12,opt,name=counted_by,json=countedBy,proto3" json:"counted_by,omitempty"`      // Пользователь, посчитавший партию последним
+	CountedAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=counted_at,json=countedAt,proto3" json:"counted_at,omitempty"`       // Дата последнего подсчета
+}
+
+func (x *StocktakeLine) Reset() {
+	*x = StocktakeLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StocktakeLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StocktakeLine) ProtoMessage() {}
+
+func (x *StocktakeLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StocktakeLine.ProtoReflect.Descriptor instead.
+func (*StocktakeLine) Descriptor() ([]byte, []int) {
+	return file_proto_stocktakes_stocktakes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StocktakeLine) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StocktakeLine) GetStocktakeId() int64 {
+	if x != nil {
+		return x.StocktakeId
+	}
+	return 0
+}
+
+func (x *StocktakeLine) GetMaterialId() int64 {
+	if x != nil {
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *StocktakeLine) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *StocktakeLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StocktakeLine) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *StocktakeLine) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *StocktakeLine) GetExpected() int64 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *StocktakeLine) GetCounted() int64 {
+	if x != nil {
+		return x.Counted
+	}
+	return 0
+}
+
+func (x *StocktakeLine) GetIsCounted() bool {
+	if x != nil {
+		return x.IsCounted
+	}
+	return false
+}
+
+func (x *StocktakeLine) GetVariance() int64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *StocktakeLine) GetCountedBy() int64 {
+	if x != nil {
+		return x.CountedBy
+	}
+	return 0
+}
+
+func (x *StocktakeLine) GetCountedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CountedAt
+	}
+	return nil
+}
+
+type StocktakeId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64 `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	CompanyId int64 `protobuf:"varint,2,opt,name=CompanyId,proto3" json:"CompanyId,omitempty"`
+}
+
+func (x *StocktakeId) Reset() {
+	*x = StocktakeId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StocktakeId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StocktakeId) ProtoMessage() {}
+
+func (x *StocktakeId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StocktakeId.ProtoReflect.Descriptor instead.
+func (*StocktakeId) Descriptor() ([]byte, []int) {
+	return file_proto_stocktakes_stocktakes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StocktakeId) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StocktakeId) GetCompanyId() int64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+type StocktakeList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stocktakes []*Stocktake `protobuf:"bytes,1,rep,name=stocktakes,proto3" json:"stocktakes,omitempty"`
+}
+
+func (x *StocktakeList) Reset() {
+	*x = StocktakeList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StocktakeList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StocktakeList) ProtoMessage() {}
+
+func (x *StocktakeList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StocktakeList.ProtoReflect.Descriptor instead.
+func (*StocktakeList) Descriptor() ([]byte, []int) {
+	return file_proto_stocktakes_stocktakes_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StocktakeList) GetStocktakes() []*Stocktake {
+	if x != nil {
+		return x.Stocktakes
+	}
+	return nil
+}
+
+type StocktakeParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit       int64  `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset      int64  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	CompanyId   int64  `protobuf:"varint,3,opt,name=CompanyId,proto3" json:"CompanyId,omitempty"`
+	WarehouseId int64  `protobuf:"varint,4,opt,name=WarehouseId,proto3" json:"WarehouseId,omitempty"`
+	Status      string `protobuf:"bytes,5,opt,name=Status,proto3" json:"Status,omitempty"`
+}
+
+func (x *StocktakeParams) Reset() {
+	*x = StocktakeParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StocktakeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StocktakeParams) ProtoMessage() {}
+
+func (x *StocktakeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StocktakeParams.ProtoReflect.Descriptor instead.
+func (*StocktakeParams) Descriptor() ([]byte, []int) {
+	return file_proto_stocktakes_stocktakes_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StocktakeParams) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *StocktakeParams) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *StocktakeParams) GetCompanyId() int64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *StocktakeParams) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *StocktakeParams) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// CountRequest партия задается ровно одним из MaterialId, ItemId или QrPayload
+type CountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	CompanyId  int64  `protobuf:"varint,2,opt,name=CompanyId,proto3" json:"CompanyId,omitempty"`
+	UserId     int64  `protobuf:"varint,3,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	MaterialId int64  `protobuf:"varint,4,opt,name=MaterialId,proto3" json:"MaterialId,omitempty"`
+	ItemId     int64  `protobuf:"varint,5,opt,name=ItemId,proto3" json:"ItemId,omitempty"`
+	QrPayload  string `protobuf:"bytes,6,opt,name=QrPayload,proto3" json:"QrPayload,omitempty"`
+	Quantity   int64  `protobuf:"varint,7,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+}
+
+func (x *CountRequest) Reset() {
+	*x = CountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountRequest) ProtoMessage() {}
+
+func (x *CountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountRequest.ProtoReflect.Descriptor instead.
+func (*CountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_stocktakes_stocktakes_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CountRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CountRequest) GetCompanyId() int64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *CountRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CountRequest) GetMaterialId() int64 {
+	if x != nil {
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *CountRequest) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *CountRequest) GetQrPayload() string {
+	if x != nil {
+		return x.QrPayload
+	}
+	return ""
+}
+
+func (x *CountRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Count struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StocktakeId int64                  `protobuf:"varint,2,opt,name=stocktake_id,json=stocktakeId,proto3" json:"stocktake_id,omitempty"`
+	LineId      int64                  `protobuf:"varint,3,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	MaterialId  int64                  `protobuf:"varint,4,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	Quantity    int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`                    // Посчитанный остаток партии
+	Source      string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`                         // Способ поиска партии: material, item, qr
+	CountedBy   int64                  `protobuf:"varint,7,opt,name=counted_by,json=countedBy,proto3" json:"counted_by,omitempty"` // Пользователь, выполнивший подсчет
+	CountedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=counted_at,json=countedAt,proto3" json:"counted_at,omitempty"`  // Дата подсчета
+}
+
+func (x *Count) Reset() {
+	*x = Count{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Count) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Count) ProtoMessage() {}
+
+func (x *Count) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Count.ProtoReflect.Descriptor instead.
+func (*Count) Descriptor() ([]byte, []int) {
+	return file_proto_stocktakes_stocktakes_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Count) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Count) GetStocktakeId() int64 {
+	if x != nil {
+		return x.StocktakeId
+	}
+	return 0
+}
+
+func (x *Count) GetLineId() int64 {
+	if x != nil {
+		return x.LineId
+	}
+	return 0
+}
+
+func (x *Count) GetMaterialId() int64 {
+	if x != nil {
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *Count) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Count) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Count) GetCountedBy() int64 {
+	if x != nil {
+		return x.CountedBy
+	}
+	return 0
+}
+
+func (x *Count) GetCountedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CountedAt
+	}
+	return nil
+}
+
+type CountList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counts []*Count `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (x *CountList) Reset() {
+	*x = CountList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountList) ProtoMessage() {}
+
+func (x *CountList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountList.ProtoReflect.Descriptor instead.
+func (*CountList) Descriptor() ([]byte, []int) {
+	return file_proto_stocktakes_stocktakes_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CountList) GetCounts() []*Count {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type VarianceReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StocktakeId   int64            `protobuf:"varint,1,opt,name=stocktake_id,json=stocktakeId,proto3" json:"stocktake_id,omitempty"`
+	Lines         []*StocktakeLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`                                        // Посчитанные партии с расхождением
+	TotalLines    int64            `protobuf:"varint,3,opt,name=total_lines,json=totalLines,proto3" json:"total_lines,omitempty"`           // Партий в инвентаризации
+	CountedLines  int64            `protobuf:"varint,4,opt,name=counted_lines,json=countedLines,proto3" json:"counted_lines,omitempty"`     // Посчитано партий
+	Surplus       int64            `protobuf:"varint,5,opt,name=surplus,proto3" json:"surplus,omitempty"`                                   // Излишки, единиц
+	Shortage      int64            `protobuf:"varint,6,opt,name=shortage,proto3" json:"shortage,omitempty"`                                 // Недостача, единиц
+	SurplusValue  float64          `protobuf:"fixed64,7,opt,name=surplus_value,json=surplusValue,proto3" json:"surplus_value,omitempty"`    // Излишки по цене без НДС
+	ShortageValue float64          `protobuf:"fixed64,8,opt,name=shortage_value,json=shortageValue,proto3" json:"shortage_value,omitempty"` // Недостача по цене без НДС
+}
+
+func (x *VarianceReport) Reset() {
+	*x = VarianceReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VarianceReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VarianceReport) ProtoMessage() {}
+
+func (x *VarianceReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VarianceReport.ProtoReflect.Descriptor instead.
+func (*VarianceReport) Descriptor() ([]byte, []int) {
+	return file_proto_stocktakes_stocktakes_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VarianceReport) GetStocktakeId() int64 {
+	if x != nil {
+		return x.StocktakeId
+	}
+	return 0
+}
+
+func (x *VarianceReport) GetLines() []*StocktakeLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *VarianceReport) GetTotalLines() int64 {
+	if x != nil {
+		return x.TotalLines
+	}
+	return 0
+}
+
+func (x *VarianceReport) GetCountedLines() int64 {
+	if x != nil {
+		return x.CountedLines
+	}
+	return 0
+}
+
+func (x *VarianceReport) GetSurplus() int64 {
+	if x != nil {
+		return x.Surplus
+	}
+	return 0
+}
+
+func (x *VarianceReport) GetShortage() int64 {
+	if x != nil {
+		return x.Shortage
+	}
+	return 0
+}
+
+func (x *VarianceReport) GetSurplusValue() float64 {
+	if x != nil {
+		return x.SurplusValue
+	}
+	return 0
+}
+
+func (x *VarianceReport) GetShortageValue() float64 {
+	if x != nil {
+		return x.ShortageValue
+	}
+	return 0
+}
+
+type ApproveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64 `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	CompanyId int64 `protobuf:"varint,2,opt,name=CompanyId,proto3" json:"CompanyId,omitempty"`
+	UserId    int64 `protobuf:"varint,3,opt,name=UserId,proto3" json:"UserId,omitempty"`
+}
+
+func (x *ApproveRequest) Reset() {
+	*x = ApproveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRequest) ProtoMessage() {}
+
+func (x *ApproveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_stocktakes_stocktakes_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ApproveRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApproveRequest) GetCompanyId() int64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *ApproveRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ApproveResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MovementIds []int64 `protobuf:"varint,1,rep,packed,name=movement_ids,json=movementIds,proto3" json:"movement_ids,omitempty"` // Корректировки в журнале движения
+}
+
+func (x *ApproveResult) Reset() {
+	*x = ApproveResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveResult) ProtoMessage() {}
+
+func (x *ApproveResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_stocktakes_stocktakes_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveResult.ProtoReflect.Descriptor instead.
+func (*ApproveResult) Descriptor() ([]byte, []int) {
+	return file_proto_stocktakes_stocktakes_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ApproveResult) GetMovementIds() []int64 {
+	if x != nil {
+		return x.MovementIds
+	}
+	return nil
+}
+
+var File_proto_stocktakes_stocktakes_proto protoreflect.FileDescriptor
+
+var file_proto_stocktakes_stocktakes_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b,
+	0x65, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x03,
+	0x0a, 0x09, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61,
+	0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x7a, 0x6f, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37,
+	0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61,
+	0x6b, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x69, 0x6e,
+	0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x8d, 0x03, 0x0a, 0x0d, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3b, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x63,
+	0x6b, 0x74, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61,
+	0x6b, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74,
+	0x61, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x6f,
+	0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b,
+	0x65, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x22, 0x97, 0x01,
+	0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x51, 0x72, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x51, 0x72, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0x82, 0x02, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x36, 0x0a, 0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xac, 0x02,
+	0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b,
+	0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x69,
+	0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64,
+	0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x72, 0x70, 0x6c, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x75, 0x72,
+	0x70, 0x6c, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x61, 0x67,
+	0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x61, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x56, 0x0a, 0x0e,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x32, 0x84, 0x04, 0x0a, 0x10, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x36, 0x0a,
+	0x04, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b,
+	0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x1a, 0x17, 0x2e, 0x73,
+	0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x74,
+	0x61, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64,
+	0x12, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x63,
+	0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65,
+	0x12, 0x41, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x74,
+	0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61,
+	0x6b, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b,
+	0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x73,
+	0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61,
+	0x6b, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x69, 0x6e,
+	0x65, 0x12, 0x3b, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x17,
+	0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x63,
+	0x6b, 0x74, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74,
+	0x61, 0x6b, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x42,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x2e,
+	0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b,
+	0x74, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61,
+	0x6b, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x40, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x1a, 0x2e,
+	0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63,
+	0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x17,
+	0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x63,
+	0x6b, 0x74, 0x61, 0x6b, 0x65, 0x49, 0x64, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
+	0x19, 0x5a, 0x17, 0x2e, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x73, 0x74, 0x6f, 0x63, 0x6b, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_proto_stocktakes_stocktakes_proto_rawDescOnce sync.Once
+	file_proto_stocktakes_stocktakes_proto_rawDescData = file_proto_stocktakes_stocktakes_proto_rawDesc
+)
+
+func file_proto_stocktakes_stocktakes_proto_rawDescGZIP() []byte {
+	file_proto_stocktakes_stocktakes_proto_rawDescOnce.Do(func() {
+		file_proto_stocktakes_stocktakes_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_stocktakes_stocktakes_proto_rawDescData)
+	})
+	return file_proto_stocktakes_stocktakes_proto_rawDescData
+}
+
+var file_proto_stocktakes_stocktakes_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_stocktakes_stocktakes_proto_goTypes = []any{
+	(*Stocktake)(nil),             // 0: stocktakes.Stocktake
+	(*StocktakeLine)(nil),         // 1: stocktakes.StocktakeLine
+	(*StocktakeId)(nil),           // 2: stocktakes.StocktakeId
+	(*StocktakeList)(nil),         // 3: stocktakes.StocktakeList
+	(*StocktakeParams)(nil),       // 4: stocktakes.StocktakeParams
+	(*CountRequest)(nil),          // 5: stocktakes.CountRequest
+	(*Count)(nil),                 // 6: stocktakes.Count
+	(*CountList)(nil),             // 7: stocktakes.CountList
+	(*VarianceReport)(nil),        // 8: stocktakes.VarianceReport
+	(*ApproveRequest)(nil),        // 9: stocktakes.ApproveRequest
+	(*ApproveResult)(nil),         // 10: stocktakes.ApproveResult
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 12: google.protobuf.Empty
+}
+var file_proto_stocktakes_stocktakes_proto_depIdxs = []int32{
+	11, // 0: stocktakes.Stocktake.created_at:type_name -> google.protobuf.Timestamp
+	11, // 1: stocktakes.Stocktake.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 2: stocktakes.Stocktake.closed_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: stocktakes.Stocktake.lines:type_name -> stocktakes.StocktakeLine
+	11, // 4: stocktakes.StocktakeLine.counted_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: stocktakes.StocktakeList.stocktakes:type_name -> stocktakes.Stocktake
+	11, // 6: stocktakes.Count.counted_at:type_name -> google.protobuf.Timestamp
+	6,  // 7: stocktakes.CountList.counts:type_name -> stocktakes.Count
+	1,  // 8: stocktakes.VarianceReport.lines:type_name -> stocktakes.StocktakeLine
+	0,  // 9: stocktakes.StocktakeService.Open:input_type -> stocktakes.Stocktake
+	2,  // 10: stocktakes.StocktakeService.GetById:input_type -> stocktakes.StocktakeId
+	4,  // 11: stocktakes.StocktakeService.GetList:input_type -> stocktakes.StocktakeParams
+	5,  // 12: stocktakes.StocktakeService.Count:input_type -> stocktakes.CountRequest
+	2,  // 13: stocktakes.StocktakeService.GetCounts:input_type -> stocktakes.StocktakeId
+	2,  // 14: stocktakes.StocktakeService.GetVariance:input_type -> stocktakes.StocktakeId
+	9,  // 15: stocktakes.StocktakeService.Approve:input_type -> stocktakes.ApproveRequest
+	2,  // 16: stocktakes.StocktakeService.Cancel:input_type -> stocktakes.StocktakeId
+	2,  // 17: stocktakes.StocktakeService.Open:output_type -> stocktakes.StocktakeId
+	0,  // 18: stocktakes.StocktakeService.GetById:output_type -> stocktakes.Stocktake
+	3,  // 19: stocktakes.StocktakeService.GetList:output_type -> stocktakes.StocktakeList
+	1,  // 20: stocktakes.StocktakeService.Count:output_type -> stocktakes.StocktakeLine
+	7,  // 21: stocktakes.StocktakeService.GetCounts:output_type -> stocktakes.CountList
+	8,  // 22: stocktakes.StocktakeService.GetVariance:output_type -> stocktakes.VarianceReport
+	10, // 23: stocktakes.StocktakeService.Approve:output_type -> stocktakes.ApproveResult
+	12, // 24: stocktakes.StocktakeService.Cancel:output_type -> google.protobuf.Empty
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_proto_stocktakes_stocktakes_proto_init() }
+func file_proto_stocktakes_stocktakes_proto_init() {
+	if File_proto_stocktakes_stocktakes_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_stocktakes_stocktakes_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Stocktake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stocktakes_stocktakes_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*StocktakeLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stocktakes_stocktakes_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*StocktakeId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stocktakes_stocktakes_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*StocktakeList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stocktakes_stocktakes_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*StocktakeParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stocktakes_stocktakes_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*CountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stocktakes_stocktakes_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Count); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stocktakes_stocktakes_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CountList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stocktakes_stocktakes_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*VarianceReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stocktakes_stocktakes_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ApproveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_stocktakes_stocktakes_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ApproveResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_stocktakes_stocktakes_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_stocktakes_stocktakes_proto_goTypes,
+		DependencyIndexes: file_proto_stocktakes_stocktakes_proto_depIdxs,
+		MessageInfos:      file_proto_stocktakes_stocktakes_proto_msgTypes,
+	}.Build()
+	File_proto_stocktakes_stocktakes_proto = out.File
+	file_proto_stocktakes_stocktakes_proto_rawDesc = nil
+	file_proto_stocktakes_stocktakes_proto_goTypes = nil
+	file_proto_stocktakes_stocktakes_proto_depIdxs = nil
+}
@@ -0,0 +1,375 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             v3.20.3
+// source: proto/stocktakes/stocktakes.proto
+
+package stocktakes
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	StocktakeService_Open_FullMethodName        = "/stocktakes.StocktakeService/Open"
+	StocktakeService_GetById_FullMethodName     = "/stocktakes.StocktakeService/GetById"
+	StocktakeService_GetList_FullMethodName     = "/stocktakes.StocktakeService/GetList"
+	StocktakeService_Count_FullMethodName       = "/stocktakes.StocktakeService/Count"
+	StocktakeService_GetCounts_FullMethodName   = "/stocktakes.StocktakeService/GetCounts"
+	StocktakeService_GetVariance_FullMethodName = "/stocktakes.StocktakeService/GetVariance"
+	StocktakeService_Approve_FullMethodName     = "/stocktakes.StocktakeService/Approve"
+	StocktakeService_Cancel_FullMethodName      = "/stocktakes.StocktakeService/Cancel"
+)
+
+// StocktakeServiceClient is the client API for StocktakeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StocktakeServiceClient interface {
+	Open(ctx context.Context, in *Stocktake, opts ...grpc.CallOption) (*StocktakeId, error)
+	GetById(ctx context.Context, in *StocktakeId, opts ...grpc.CallOption) (*Stocktake, error)
+	GetList(ctx context.Context, in *StocktakeParams, opts ...grpc.CallOption) (*StocktakeList, error)
+	Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*StocktakeLine, error)
+	GetCounts(ctx context.Context, in *StocktakeId, opts ...grpc.CallOption) (*CountList, error)
+	GetVariance(ctx context.Context, in *StocktakeId, opts ...grpc.CallOption) (*VarianceReport, error)
+	Approve(ctx context.Context, in *ApproveRequest, opts ...grpc.CallOption) (*ApproveResult, error)
+	Cancel(ctx context.Context, in *StocktakeId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type stocktakeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStocktakeServiceClient(cc grpc.ClientConnInterface) StocktakeServiceClient {
+	return &stocktakeServiceClient{cc}
+}
+
+func (c *stocktakeServiceClient) Open(ctx context.Context, in *Stocktake, opts ...grpc.CallOption) (*StocktakeId, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StocktakeId)
+	err := c.cc.Invoke(ctx, StocktakeService_Open_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocktakeServiceClient) GetById(ctx context.Context, in *StocktakeId, opts ...grpc.CallOption) (*Stocktake, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Stocktake)
+	err := c.cc.Invoke(ctx, StocktakeService_GetById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocktakeServiceClient) GetList(ctx context.Context, in *StocktakeParams, opts ...grpc.CallOption) (*StocktakeList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StocktakeList)
+	err := c.cc.Invoke(ctx, StocktakeService_GetList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocktakeServiceClient) Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*StocktakeLine, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StocktakeLine)
+	err := c.cc.Invoke(ctx, StocktakeService_Count_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocktakeServiceClient) GetCounts(ctx context.Context, in *StocktakeId, opts ...grpc.CallOption) (*CountList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountList)
+	err := c.cc.Invoke(ctx, StocktakeService_GetCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocktakeServiceClient) GetVariance(ctx context.Context, in *StocktakeId, opts ...grpc.CallOption) (*VarianceReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VarianceReport)
+	err := c.cc.Invoke(ctx, StocktakeService_GetVariance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocktakeServiceClient) Approve(ctx context.Context, in *ApproveRequest, opts ...grpc.CallOption) (*ApproveResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveResult)
+	err := c.cc.Invoke(ctx, StocktakeService_Approve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocktakeServiceClient) Cancel(ctx context.Context, in *StocktakeId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StocktakeService_Cancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StocktakeServiceServer is the server API for StocktakeService service.
+// All implementations should embed UnimplementedStocktakeServiceServer
+// for forward compatibility
+type StocktakeServiceServer interface {
+	Open(context.Context, *Stocktake) (*StocktakeId, error)
+	GetById(context.Context, *StocktakeId) (*Stocktake, error)
+	GetList(context.Context, *StocktakeParams) (*StocktakeList, error)
+	Count(context.Context, *CountRequest) (*StocktakeLine, error)
+	GetCounts(context.Context, *StocktakeId) (*CountList, error)
+	GetVariance(context.Context, *StocktakeId) (*VarianceReport, error)
+	Approve(context.Context, *ApproveRequest) (*ApproveResult, error)
+	Cancel(context.Context, *StocktakeId) (*emptypb.Empty, error)
+}
+
+// UnimplementedStocktakeServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedStocktakeServiceServer struct {
+}
+
+func (UnimplementedStocktakeServiceServer) Open(context.Context, *Stocktake) (*StocktakeId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Open not implemented")
+}
+func (UnimplementedStocktakeServiceServer) GetById(context.Context, *StocktakeId) (*Stocktake, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetById not implemented")
+}
+func (UnimplementedStocktakeServiceServer) GetList(context.Context, *StocktakeParams) (*StocktakeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedStocktakeServiceServer) Count(context.Context, *CountRequest) (*StocktakeLine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (UnimplementedStocktakeServiceServer) GetCounts(context.Context, *StocktakeId) (*CountList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCounts not implemented")
+}
+func (UnimplementedStocktakeServiceServer) GetVariance(context.Context, *StocktakeId) (*VarianceReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVariance not implemented")
+}
+func (UnimplementedStocktakeServiceServer) Approve(context.Context, *ApproveRequest) (*ApproveResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedStocktakeServiceServer) Cancel(context.Context, *StocktakeId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+
+// UnsafeStocktakeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StocktakeServiceServer will
+// result in compilation errors.
+type UnsafeStocktakeServiceServer interface {
+	mustEmbedUnimplementedStocktakeServiceServer()
+}
+
+func RegisterStocktakeServiceServer(s grpc.ServiceRegistrar, srv StocktakeServiceServer) {
+	s.RegisterService(&StocktakeService_ServiceDesc, srv)
+}
+
+func _StocktakeService_Open_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Stocktake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocktakeServiceServer).Open(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocktakeService_Open_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocktakeServiceServer).Open(ctx, req.(*Stocktake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocktakeService_GetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakeId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocktakeServiceServer).GetById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocktakeService_GetById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocktakeServiceServer).GetById(ctx, req.(*StocktakeId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocktakeService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocktakeServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocktakeService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocktakeServiceServer).GetList(ctx, req.(*StocktakeParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocktakeService_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocktakeServiceServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocktakeService_Count_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocktakeServiceServer).Count(ctx, req.(*CountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocktakeService_GetCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakeId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocktakeServiceServer).GetCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocktakeService_GetCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocktakeServiceServer).GetCounts(ctx, req.(*StocktakeId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocktakeService_GetVariance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakeId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocktakeServiceServer).GetVariance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocktakeService_GetVariance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocktakeServiceServer).GetVariance(ctx, req.(*StocktakeId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocktakeService_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocktakeServiceServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocktakeService_Approve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocktakeServiceServer).Approve(ctx, req.(*ApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocktakeService_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakeId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocktakeServiceServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocktakeService_Cancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocktakeServiceServer).Cancel(ctx, req.(*StocktakeId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StocktakeService_ServiceDesc is the grpc.ServiceDesc for StocktakeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StocktakeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "stocktakes.StocktakeService",
+	HandlerType: (*StocktakeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Open",
+			Handler:    _StocktakeService_Open_Handler,
+		},
+		{
+			MethodName: "GetById",
+			Handler:    _StocktakeService_GetById_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _StocktakeService_GetList_Handler,
+		},
+		{
+			MethodName: "Count",
+			Handler:    _StocktakeService_Count_Handler,
+		},
+		{
+			MethodName: "GetCounts",
+			Handler:    _StocktakeService_GetCounts_Handler,
+		},
+		{
+			MethodName: "GetVariance",
+			Handler:    _StocktakeService_GetVariance_Handler,
+		},
+		{
+			MethodName: "Approve",
+			Handler:    _StocktakeService_Approve_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _StocktakeService_Cancel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/stocktakes/stocktakes.proto",
+}
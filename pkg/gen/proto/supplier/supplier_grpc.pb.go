@@ -25,6 +25,7 @@ const (
 	SupplierService_Update_FullMethodName  = "/supplier.SupplierService/Update"
 	SupplierService_Delete_FullMethodName  = "/supplier.SupplierService/Delete"
 	SupplierService_GetList_FullMethodName = "/supplier.SupplierService/GetList"
+	SupplierService_Restore_FullMethodName = "/supplier.SupplierService/Restore"
 )
 
 // SupplierServiceClient is the client API for SupplierService service.
@@ -36,6 +37,7 @@ type SupplierServiceClient interface {
 	Update(ctx context.Context, in *Supplier, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Delete(ctx context.Context, in *SupplierId, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetList(ctx context.Context, in *SupplierCompanyId, opts ...grpc.CallOption) (*SupplierList, error)
+	Restore(ctx context.Context, in *SupplierId, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type supplierServiceClient struct {
@@ -96,6 +98,16 @@ func (c *supplierServiceClient) GetList(ctx context.Context, in *SupplierCompany
 	return out, nil
 }
 
+func (c *supplierServiceClient) Restore(ctx context.Context, in *SupplierId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SupplierService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SupplierServiceServer is the server API for SupplierService service.
 // All implementations should embed UnimplementedSupplierServiceServer
 // for forward compatibility
@@ -105,6 +117,7 @@ type SupplierServiceServer interface {
 	Update(context.Context, *Supplier) (*emptypb.Empty, error)
 	Delete(context.Context, *SupplierId) (*emptypb.Empty, error)
 	GetList(context.Context, *SupplierCompanyId) (*SupplierList, error)
+	Restore(context.Context, *SupplierId) (*emptypb.Empty, error)
 }
 
 // UnimplementedSupplierServiceServer should be embedded to have forward compatible implementations.
@@ -126,6 +139,9 @@ func (UnimplementedSupplierServiceServer) Delete(context.Context, *SupplierId) (
 func (UnimplementedSupplierServiceServer) GetList(context.Context, *SupplierCompanyId) (*SupplierList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
 }
+func (UnimplementedSupplierServiceServer) Restore(context.Context, *SupplierId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
 
 // UnsafeSupplierServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to SupplierServiceServer will
@@ -228,6 +244,24 @@ func _SupplierService_GetList_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SupplierService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplierServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplierService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplierServiceServer).Restore(ctx, req.(*SupplierId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SupplierService_ServiceDesc is the grpc.ServiceDesc for SupplierService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -255,6 +289,10 @@ var SupplierService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetList",
 			Handler:    _SupplierService_GetList_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _SupplierService_Restore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/supplier/supplier.proto",
@@ -0,0 +1,337 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             v3.20.3
+// source: proto/transfers/transfers.proto
+
+package transfers
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	TransferService_Create_FullMethodName       = "/transfers.TransferService/Create"
+	TransferService_GetById_FullMethodName      = "/transfers.TransferService/GetById"
+	TransferService_GetList_FullMethodName      = "/transfers.TransferService/GetList"
+	TransferService_Ship_FullMethodName         = "/transfers.TransferService/Ship"
+	TransferService_Receive_FullMethodName      = "/transfers.TransferService/Receive"
+	TransferService_Cancel_FullMethodName       = "/transfers.TransferService/Cancel"
+	TransferService_GetInTransit_FullMethodName = "/transfers.TransferService/GetInTransit"
+)
+
+// TransferServiceClient is the client API for TransferService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TransferServiceClient interface {
+	Create(ctx context.Context, in *TransferOrder, opts ...grpc.CallOption) (*TransferOrderId, error)
+	GetById(ctx context.Context, in *TransferOrderId, opts ...grpc.CallOption) (*TransferOrder, error)
+	GetList(ctx context.Context, in *TransferOrderParams, opts ...grpc.CallOption) (*TransferOrderList, error)
+	Ship(ctx context.Context, in *ShipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Receive(ctx context.Context, in *ReceiveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Cancel(ctx context.Context, in *TransferOrderId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetInTransit(ctx context.Context, in *InTransitParams, opts ...grpc.CallOption) (*InTransit, error)
+}
+
+type transferServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransferServiceClient(cc grpc.ClientConnInterface) TransferServiceClient {
+	return &transferServiceClient{cc}
+}
+
+func (c *transferServiceClient) Create(ctx context.Context, in *TransferOrder, opts ...grpc.CallOption) (*TransferOrderId, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferOrderId)
+	err := c.cc.Invoke(ctx, TransferService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) GetById(ctx context.Context, in *TransferOrderId, opts ...grpc.CallOption) (*TransferOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferOrder)
+	err := c.cc.Invoke(ctx, TransferService_GetById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) GetList(ctx context.Context, in *TransferOrderParams, opts ...grpc.CallOption) (*TransferOrderList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferOrderList)
+	err := c.cc.Invoke(ctx, TransferService_GetList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) Ship(ctx context.Context, in *ShipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TransferService_Ship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) Receive(ctx context.Context, in *ReceiveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TransferService_Receive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) Cancel(ctx context.Context, in *TransferOrderId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TransferService_Cancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) GetInTransit(ctx context.Context, in *InTransitParams, opts ...grpc.CallOption) (*InTransit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InTransit)
+	err := c.cc.Invoke(ctx, TransferService_GetInTransit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransferServiceServer is the server API for TransferService service.
+// All implementations should embed UnimplementedTransferServiceServer
+// for forward compatibility
+type TransferServiceServer interface {
+	Create(context.Context, *TransferOrder) (*TransferOrderId, error)
+	GetById(context.Context, *TransferOrderId) (*TransferOrder, error)
+	GetList(context.Context, *TransferOrderParams) (*TransferOrderList, error)
+	Ship(context.Context, *ShipRequest) (*emptypb.Empty, error)
+	Receive(context.Context, *ReceiveRequest) (*emptypb.Empty, error)
+	Cancel(context.Context, *TransferOrderId) (*emptypb.Empty, error)
+	GetInTransit(context.Context, *InTransitParams) (*InTransit, error)
+}
+
+// UnimplementedTransferServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedTransferServiceServer struct {
+}
+
+func (UnimplementedTransferServiceServer) Create(context.Context, *TransferOrder) (*TransferOrderId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTransferServiceServer) GetById(context.Context, *TransferOrderId) (*TransferOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetById not implemented")
+}
+func (UnimplementedTransferServiceServer) GetList(context.Context, *TransferOrderParams) (*TransferOrderList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedTransferServiceServer) Ship(context.Context, *ShipRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ship not implemented")
+}
+func (UnimplementedTransferServiceServer) Receive(context.Context, *ReceiveRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Receive not implemented")
+}
+func (UnimplementedTransferServiceServer) Cancel(context.Context, *TransferOrderId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (UnimplementedTransferServiceServer) GetInTransit(context.Context, *InTransitParams) (*InTransit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInTransit not implemented")
+}
+
+// UnsafeTransferServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransferServiceServer will
+// result in compilation errors.
+type UnsafeTransferServiceServer interface {
+	mustEmbedUnimplementedTransferServiceServer()
+}
+
+func RegisterTransferServiceServer(s grpc.ServiceRegistrar, srv TransferServiceServer) {
+	s.RegisterService(&TransferService_ServiceDesc, srv)
+}
+
+func _TransferService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).Create(ctx, req.(*TransferOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_GetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOrderId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).GetById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_GetById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).GetById(ctx, req.(*TransferOrderId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOrderParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).GetList(ctx, req.(*TransferOrderParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_Ship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).Ship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_Ship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).Ship(ctx, req.(*ShipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_Receive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).Receive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_Receive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).Receive(ctx, req.(*ReceiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOrderId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_Cancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).Cancel(ctx, req.(*TransferOrderId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_GetInTransit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InTransitParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).GetInTransit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_GetInTransit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).GetInTransit(ctx, req.(*InTransitParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TransferService_ServiceDesc is the grpc.ServiceDesc for TransferService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TransferService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "transfers.TransferService",
+	HandlerType: (*TransferServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TransferService_Create_Handler,
+		},
+		{
+			MethodName: "GetById",
+			Handler:    _TransferService_GetById_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _TransferService_GetList_Handler,
+		},
+		{
+			MethodName: "Ship",
+			Handler:    _TransferService_Ship_Handler,
+		},
+		{
+			MethodName: "Receive",
+			Handler:    _TransferService_Receive_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _TransferService_Cancel_Handler,
+		},
+		{
+			MethodName: "GetInTransit",
+			Handler:    _TransferService_GetInTransit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/transfers/transfers.proto",
+}
@@ -26,6 +26,12 @@ const (
 	WarehouseService_Delete_FullMethodName              = "/warehouse.WarehouseService/Delete"
 	WarehouseService_GetList_FullMethodName             = "/warehouse.WarehouseService/GetList"
 	WarehouseService_GetResponsibleUsers_FullMethodName = "/warehouse.WarehouseService/GetResponsibleUsers"
+	WarehouseService_Restore_FullMethodName             = "/warehouse.WarehouseService/Restore"
+	WarehouseService_CreateLocation_FullMethodName      = "/warehouse.WarehouseService/CreateLocation"
+	WarehouseService_GetLocation_FullMethodName         = "/warehouse.WarehouseService/GetLocation"
+	WarehouseService_UpdateLocation_FullMethodName      = "/warehouse.WarehouseService/UpdateLocation"
+	WarehouseService_DeleteLocation_FullMethodName      = "/warehouse.WarehouseService/DeleteLocation"
+	WarehouseService_GetListLocations_FullMethodName    = "/warehouse.WarehouseService/GetListLocations"
 )
 
 // WarehouseServiceClient is the client API for WarehouseService service.
@@ -38,6 +44,12 @@ type WarehouseServiceClient interface {
 	Delete(ctx context.Context, in *WarehouseId, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetList(ctx context.Context, in *WarehouseCompanyId, opts ...grpc.CallOption) (*WarehouseList, error)
 	GetResponsibleUsers(ctx context.Context, in *WarehouseCompanyId, opts ...grpc.CallOption) (*UserList, error)
+	Restore(ctx context.Context, in *WarehouseId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateLocation(ctx context.Context, in *StorageLocation, opts ...grpc.CallOption) (*LocationId, error)
+	GetLocation(ctx context.Context, in *LocationId, opts ...grpc.CallOption) (*StorageLocation, error)
+	UpdateLocation(ctx context.Context, in *StorageLocation, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteLocation(ctx context.Context, in *LocationId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetListLocations(ctx context.Context, in *LocationParams, opts ...grpc.CallOption) (*StorageLocationList, error)
 }
 
 type warehouseServiceClient struct {
@@ -108,6 +120,66 @@ func (c *warehouseServiceClient) GetResponsibleUsers(ctx context.Context, in *Wa
 	return out, nil
 }
 
+func (c *warehouseServiceClient) Restore(ctx context.Context, in *WarehouseId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WarehouseService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warehouseServiceClient) CreateLocation(ctx context.Context, in *StorageLocation, opts ...grpc.CallOption) (*LocationId, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LocationId)
+	err := c.cc.Invoke(ctx, WarehouseService_CreateLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warehouseServiceClient) GetLocation(ctx context.Context, in *LocationId, opts ...grpc.CallOption) (*StorageLocation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorageLocation)
+	err := c.cc.Invoke(ctx, WarehouseService_GetLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warehouseServiceClient) UpdateLocation(ctx context.Context, in *StorageLocation, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WarehouseService_UpdateLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warehouseServiceClient) DeleteLocation(ctx context.Context, in *LocationId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WarehouseService_DeleteLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warehouseServiceClient) GetListLocations(ctx context.Context, in *LocationParams, opts ...grpc.CallOption) (*StorageLocationList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorageLocationList)
+	err := c.cc.Invoke(ctx, WarehouseService_GetListLocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WarehouseServiceServer is the server API for WarehouseService service.
 // All implementations should embed UnimplementedWarehouseServiceServer
 // for forward compatibility
@@ -118,6 +190,12 @@ type WarehouseServiceServer interface {
 	Delete(context.Context, *WarehouseId) (*emptypb.Empty, error)
 	GetList(context.Context, *WarehouseCompanyId) (*WarehouseList, error)
 	GetResponsibleUsers(context.Context, *WarehouseCompanyId) (*UserList, error)
+	Restore(context.Context, *WarehouseId) (*emptypb.Empty, error)
+	CreateLocation(context.Context, *StorageLocation) (*LocationId, error)
+	GetLocation(context.Context, *LocationId) (*StorageLocation, error)
+	UpdateLocation(context.Context, *StorageLocation) (*emptypb.Empty, error)
+	DeleteLocation(context.Context, *LocationId) (*emptypb.Empty, error)
+	GetListLocations(context.Context, *LocationParams) (*StorageLocationList, error)
 }
 
 // UnimplementedWarehouseServiceServer should be embedded to have forward compatible implementations.
@@ -142,6 +220,24 @@ func (UnimplementedWarehouseServiceServer) GetList(context.Context, *WarehouseCo
 func (UnimplementedWarehouseServiceServer) GetResponsibleUsers(context.Context, *WarehouseCompanyId) (*UserList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResponsibleUsers not implemented")
 }
+func (UnimplementedWarehouseServiceServer) Restore(context.Context, *WarehouseId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedWarehouseServiceServer) CreateLocation(context.Context, *StorageLocation) (*LocationId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLocation not implemented")
+}
+func (UnimplementedWarehouseServiceServer) GetLocation(context.Context, *LocationId) (*StorageLocation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocation not implemented")
+}
+func (UnimplementedWarehouseServiceServer) UpdateLocation(context.Context, *StorageLocation) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLocation not implemented")
+}
+func (UnimplementedWarehouseServiceServer) DeleteLocation(context.Context, *LocationId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLocation not implemented")
+}
+func (UnimplementedWarehouseServiceServer) GetListLocations(context.Context, *LocationParams) (*StorageLocationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListLocations not implemented")
+}
 
 // UnsafeWarehouseServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to WarehouseServiceServer will
@@ -262,6 +358,114 @@ func _WarehouseService_GetResponsibleUsers_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WarehouseService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarehouseId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).Restore(ctx, req.(*WarehouseId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarehouseService_CreateLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageLocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).CreateLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_CreateLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).CreateLocation(ctx, req.(*StorageLocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarehouseService_GetLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocationId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).GetLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_GetLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).GetLocation(ctx, req.(*LocationId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarehouseService_UpdateLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageLocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).UpdateLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_UpdateLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).UpdateLocation(ctx, req.(*StorageLocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarehouseService_DeleteLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocationId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).DeleteLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_DeleteLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).DeleteLocation(ctx, req.(*LocationId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarehouseService_GetListLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocationParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).GetListLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_GetListLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).GetListLocations(ctx, req.(*LocationParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WarehouseService_ServiceDesc is the grpc.ServiceDesc for WarehouseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -293,6 +497,30 @@ var WarehouseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetResponsibleUsers",
 			Handler:    _WarehouseService_GetResponsibleUsers_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _WarehouseService_Restore_Handler,
+		},
+		{
+			MethodName: "CreateLocation",
+			Handler:    _WarehouseService_CreateLocation_Handler,
+		},
+		{
+			MethodName: "GetLocation",
+			Handler:    _WarehouseService_GetLocation_Handler,
+		},
+		{
+			MethodName: "UpdateLocation",
+			Handler:    _WarehouseService_UpdateLocation_Handler,
+		},
+		{
+			MethodName: "DeleteLocation",
+			Handler:    _WarehouseService_DeleteLocation_Handler,
+		},
+		{
+			MethodName: "GetListLocations",
+			Handler:    _WarehouseService_GetListLocations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/warehouse/warehouse.proto",
@@ -0,0 +1,100 @@
+package label
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-pdf/fpdf"
+	"github.com/skip2/go-qrcode"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+// Размеры этикетки в миллиметрах под термопринтер 58x40, одна этикетка на страницу
+const (
+	pageWidth  = 58.0
+	pageHeight = 40.0
+	margin     = 2.0
+	qrSide     = 26.0
+	textX      = margin + qrSide + 1.5
+	textWidth  = pageWidth - textX - margin
+	lineHeight = 3.2
+
+	qrPixels = 512 // разрешение QR-кода на этикетке
+	font     = "go"
+)
+
+// Label данные этикетки партии
+type Label struct {
+	Payload          string // Текст QR-кода
+	Name             string
+	Article          string
+	WarehouseSection string
+	Location         string
+}
+
+// QRCode PNG с QR-кодом payload стороной size пикселей
+func QRCode(payload string, size int) ([]byte, error) {
+	return qrcode.Encode(payload, qrcode.Medium, size)
+}
+
+// PDF документ для печати этикеток, этикетки печатаются в порядке labels
+func PDF(labels []Label) ([]byte, error) {
+	pdf := fpdf.NewCustom(&fpdf.InitType{
+		OrientationStr: "P",
+		UnitStr:        "mm",
+		Size:           fpdf.SizeType{Wd: pageWidth, Ht: pageHeight},
+	})
+	pdf.SetMargins(margin, margin, margin)
+	pdf.SetAutoPageBreak(false, 0)
+
+	// шрифты Go содержат кириллицу и встраиваются в документ, внешние файлы шрифтов не нужны
+	pdf.AddUTF8FontFromBytes(font, "", goregular.TTF)
+	pdf.AddUTF8FontFromBytes(font, "B", gobold.TTF)
+
+	for i, l := range labels {
+		png, err := QRCode(l.Payload, qrPixels)
+		if err != nil {
+			return nil, fmt.Errorf("label %d: %w", i, err)
+		}
+
+		pdf.AddPage()
+
+		image := fmt.Sprintf("qr-%d", i)
+		options := fpdf.ImageOptions{ImageType: "PNG"}
+		pdf.RegisterImageOptionsReader(image, options, bytes.NewReader(png))
+		pdf.ImageOptions(image, margin, margin, qrSide, qrSide, false, options, 0, "")
+
+		pdf.SetXY(textX, margin)
+		pdf.SetFont(font, "B", 8)
+		writeLines(pdf, l.Name, 4)
+
+		pdf.SetFont(font, "", 7)
+		writeLines(pdf, "Арт.: "+l.Article, 2)
+		writeLines(pdf, "Секция: "+l.WarehouseSection, 2)
+		writeLines(pdf, "Место: "+l.Location, 2)
+
+		if err = pdf.Error(); err != nil {
+			return nil, fmt.Errorf("label %d: %w", i, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeLines выводит текст в колонке справа от QR-кода, не больше maxLines строк
+func writeLines(pdf *fpdf.Fpdf, text string, maxLines int) {
+	lines := pdf.SplitText(text, textWidth)
+	if len(lines) > maxLines {
+		lines = lines[:maxLines]
+	}
+
+	for _, line := range lines {
+		pdf.SetX(textX)
+		pdf.CellFormat(textWidth, lineHeight, line, "", 1, "L", false, 0, "")
+	}
+}
@@ -0,0 +1,16 @@
+package mq
+
+import "strings"
+
+// Заголовки ответа NATS транспорта. Ошибка передается как google.rpc.Status в теле ответа,
+// поэтому клиент получает те же коды и детали, что и по grpc.
+const (
+	StatusHeader  = "Grpc-Status"
+	MessageHeader = "Grpc-Message"
+)
+
+// RPCSubject subject метода grpc в NATS транспорте:
+// "/materials.MaterialService/CreatePlanning" -> "<prefix>.materials.MaterialService.CreatePlanning"
+func RPCSubject(prefix, fullMethod string) string {
+	return prefix + "." + strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", ".")
+}
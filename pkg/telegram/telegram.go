@@ -1,14 +1,27 @@
 package telegram
 
 import (
+	"errors"
 	"fmt"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/rusystem/crm-warehouse/internal/config"
 	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"go.uber.org/zap"
+	"net"
+	"net/http"
 	"strconv"
+	"time"
 )
 
+// queueSize - размер очереди сообщений, при заполненной очереди новые сообщения отбрасываются
+const queueSize = 50
+
+// ErrQueueFull сообщение не принято: очередь отправки заполнена
+var ErrQueueFull = errors.New("telegram queue is full")
+
 type Message struct {
+	Type        string // тип сообщения, определяет шаблон
+	CompanyID   int64  // компания, по ней выбирается чат
 	Header      string
 	Datetime    string
 	Payload     string
@@ -16,24 +29,45 @@ type Message struct {
 	Ip          string
 	CompanyName string
 	Email       string
+	Data        interface{} // данные шаблона типа сообщения
 }
 
 type Telegram struct {
 	messages chan Message
 	cfg      *config.Config
 	bot      *tgbotapi.BotAPI
+	chatId   int64
 }
 
 func NewTelegram(cfg *config.Config) (*Telegram, error) {
-	bot, err := tgbotapi.NewBotAPI(cfg.Telegram.BotToken)
+	var chatId int64
+	if cfg.Telegram.ChatId != "" {
+		id, err := strconv.ParseInt(cfg.Telegram.ChatId, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid telegram chat id %q: %w", cfg.Telegram.ChatId, err)
+		}
+		chatId = id
+	}
+
+	if chatId == 0 && len(cfg.Telegram.CompanyChats) == 0 {
+		return nil, errors.New("telegram chat id is not set")
+	}
+
+	endpoint := cfg.Telegram.APIEndpoint
+	if endpoint == "" {
+		endpoint = tgbotapi.APIEndpoint
+	}
+
+	bot, err := tgbotapi.NewBotAPIWithAPIEndpoint(cfg.Telegram.BotToken, endpoint)
 	if err != nil {
 		return nil, err
 	}
 
 	t := &Telegram{
-		messages: make(chan Message, 50),
+		messages: make(chan Message, queueSize),
 		cfg:      cfg,
 		bot:      bot,
+		chatId:   chatId,
 	}
 
 	go t.sender()
@@ -41,27 +75,90 @@ func NewTelegram(cfg *config.Config) (*Telegram, error) {
 	return t, nil
 }
 
-func (t *Telegram) Send(msg Message) {
-	t.messages <- msg
+// Send ставит сообщение в очередь отправки и не блокирует вызывающего:
+// при заполненной очереди сообщение отбрасывается с предупреждением в логе и возвращается ErrQueueFull
+func (t *Telegram) Send(msg Message) error {
+	select {
+	case t.messages <- msg:
+		return nil
+	default:
+		logger.Warn("telegram: queue is full, message dropped", zap.String("type", msg.Type), zap.String("header", msg.Header))
+		return ErrQueueFull
+	}
 }
 
 func (t *Telegram) sender() {
-	chatId, err := strconv.Atoi(t.cfg.Telegram.ChatId)
-	if err != nil {
-		logger.Error(fmt.Sprintf("telegram: invalid chat id - %d", chatId))
-		return
+	// не чаще одного сообщения за Rate, ограничение Bot API - около 30 сообщений в секунду
+	var throttle <-chan time.Time
+	if t.cfg.Telegram.Rate > 0 {
+		ticker := time.NewTicker(t.cfg.Telegram.Rate)
+		defer ticker.Stop()
+		throttle = ticker.C
 	}
 
 	for message := range t.messages {
-		msg := tgbotapi.NewMessage(int64(chatId), getFormattedMessage(message))
+		chatId := t.chatFor(message.CompanyID)
+		if chatId == 0 {
+			logger.Warn("telegram: no chat for company, message dropped", zap.Int64("company_id", message.CompanyID))
+			continue
+		}
 
-		_, err = t.bot.Send(msg)
+		text, err := format(message)
 		if err != nil {
-			logger.Error(fmt.Sprintf("telegram sender: can`t to send to channel new message, message - %v", message))
+			logger.Error("telegram: failed to format message", zap.String("type", message.Type), zap.Error(err))
+			continue
+		}
+
+		if throttle != nil {
+			<-throttle
+		}
+
+		if err = t.send(tgbotapi.NewMessage(chatId, text)); err != nil {
+			logger.Error("telegram sender: can`t send message", zap.String("type", message.Type),
+				zap.Int64("chat_id", chatId), zap.Error(err))
 		}
 	}
 }
 
-func getFormattedMessage(message Message) string {
-	return "" //todo implement
+// chatFor чат компании из CompanyChats, для остальных - общий чат
+func (t *Telegram) chatFor(companyId int64) int64 {
+	if id, ok := t.cfg.Telegram.CompanyChats[companyId]; ok {
+		return id
+	}
+
+	return t.chatId
+}
+
+// send отправляет сообщение с повторами: на 429 ждет retry_after из ответа, на 5xx и сетевые ошибки - RetryDelay.
+// Остальные ошибки Bot API (неверный чат, токен, текст) повтором не исправить.
+func (t *Telegram) send(msg tgbotapi.MessageConfig) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		if _, err = t.bot.Send(msg); err == nil {
+			return nil
+		}
+
+		if attempt >= t.cfg.Telegram.MaxRetries || !retryable(err) {
+			return err
+		}
+
+		delay := t.cfg.Telegram.RetryDelay
+
+		var apiErr *tgbotapi.Error
+		if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+			delay = time.Duration(apiErr.RetryAfter) * time.Second
+		}
+
+		time.Sleep(delay)
+	}
+}
+
+func retryable(err error) bool {
+	var apiErr *tgbotapi.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == http.StatusTooManyRequests || apiErr.Code >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
 }
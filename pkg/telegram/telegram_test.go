@@ -0,0 +1,368 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/rusystem/crm-warehouse/internal/config"
+	"github.com/rusystem/crm-warehouse/pkg/domain"
+	"github.com/rusystem/crm-warehouse/pkg/logger"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMain(m *testing.M) {
+	logger.ZapLoggerInit()
+	os.Exit(m.Run())
+}
+
+// sentMessage сообщение, принятое фейковым Bot API
+type sentMessage struct {
+	chatId int64
+	text   string
+}
+
+// fakeBotAPI отвечает на getMe и sendMessage как Bot API
+type fakeBotAPI struct {
+	srv  *httptest.Server
+	sent chan sentMessage
+
+	mu      sync.Mutex
+	calls   int           // запросов sendMessage, включая неудачные
+	replies []string      // ответы на первые запросы sendMessage, дальше - успех
+	hold    chan struct{} // при заданном канале sendMessage ждет его закрытия
+	held    chan struct{} // получает сигнал, когда sendMessage начал ждать hold
+}
+
+func newFakeBotAPI(t *testing.T) *fakeBotAPI {
+	f := &fakeBotAPI{sent: make(chan sentMessage, 2*queueSize)}
+	f.srv = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.srv.Close)
+
+	return f
+}
+
+func (f *fakeBotAPI) serve(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/getMe"):
+		fmt.Fprint(w, `{"ok":true,"result":{"id":1,"is_bot":true,"first_name":"bot","username":"bot"}}`)
+	case strings.HasSuffix(r.URL.Path, "/sendMessage"):
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		f.mu.Lock()
+		f.calls++
+		var reply string
+		if len(f.replies) > 0 {
+			reply, f.replies = f.replies[0], f.replies[1:]
+		}
+		hold, held := f.hold, f.held
+		f.mu.Unlock()
+
+		if reply != "" {
+			fmt.Fprint(w, reply)
+			return
+		}
+
+		if hold != nil {
+			held <- struct{}{}
+			<-hold
+		}
+
+		chatId, _ := strconv.ParseInt(r.FormValue("chat_id"), 10, 64)
+		f.sent <- sentMessage{chatId: chatId, text: r.FormValue("text")}
+
+		fmt.Fprintf(w, `{"ok":true,"result":{"message_id":1,"date":0,"chat":{"id":%d,"type":"group"}}}`, chatId)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeBotAPI) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls
+}
+
+func (f *fakeBotAPI) wait(t *testing.T, timeout time.Duration) sentMessage {
+	t.Helper()
+
+	select {
+	case m := <-f.sent:
+		return m
+	case <-time.After(timeout):
+		t.Fatal("message was not delivered")
+		return sentMessage{}
+	}
+}
+
+func newTestTelegram(t *testing.T, f *fakeBotAPI, tg config.Telegram) *Telegram {
+	t.Helper()
+
+	tg.BotToken = "token"
+	tg.APIEndpoint = f.srv.URL + "/bot%s/%s"
+
+	bot, err := NewTelegram(&config.Config{Telegram: tg})
+	if err != nil {
+		t.Fatalf("NewTelegram: %v", err)
+	}
+
+	return bot
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		message Message
+		want    string
+	}{
+		{
+			name: "system",
+			message: Message{
+				Header:   "Ошибка",
+				Datetime: "2024-01-02 03:04:05",
+				Email:    "user@example.com",
+				Payload:  "текст",
+			},
+			want: "Ошибка\nВремя: 2024-01-02 03:04:05\nEmail: user@example.com\n\nтекст",
+		},
+		{
+			name:    "system without optional fields",
+			message: Message{Header: "Ошибка"},
+			want:    "Ошибка",
+		},
+		{
+			name: "low stock",
+			message: Message{
+				Type:     TypeLowStock,
+				Datetime: "2024-01-02 03:04:05",
+				Payload:  "Ответственные: Иван <ivan@example.com>",
+				Data: domain.LowStockAlert{
+					Name: "Болт", ItemID: 5, WarehouseID: 2, Quantity: 3, MinStockLevel: 10,
+				},
+			},
+			want: "Низкий остаток товара\nВремя: 2024-01-02 03:04:05\n\n" +
+				"Болт (товар 5) на складе 2: остаток 3, минимум 10\nОтветственные: Иван <ivan@example.com>",
+		},
+		{
+			name: "dead letter",
+			message: Message{
+				Type:     TypeDeadLetter,
+				Datetime: "2024-01-02 03:04:05",
+				Data:     DeadLetter{Subject: "purchase.requests", MessageID: "42", Reason: "invalid payload", Deliveries: 3},
+			},
+			want: "Сообщение не обработано и перенесено в dead letter\nВремя: 2024-01-02 03:04:05\n\n" +
+				"Subject: purchase.requests\nId: 42\nПопыток доставки: 3\nПричина: invalid payload",
+		},
+		{
+			name:    "unknown type as system",
+			message: Message{Type: "unknown", Header: "Заголовок"},
+			want:    "Заголовок",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := format(tt.message)
+			if err != nil {
+				t.Fatalf("format: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompanyRouting(t *testing.T) {
+	tests := []struct {
+		name      string
+		chatId    string
+		companyId int64
+		want      int64
+	}{
+		{"company chat", "100", 7, 700},
+		{"default chat", "100", 8, 100},
+		{"company chat without default", "", 7, 700},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeBotAPI(t)
+			bot := newTestTelegram(t, f, config.Telegram{ChatId: tt.chatId, CompanyChats: map[int64]int64{7: 700}})
+
+			if err := bot.Send(Message{CompanyID: tt.companyId, Header: "test"}); err != nil {
+				t.Fatalf("Send: %v", err)
+			}
+
+			if got := f.wait(t, 5*time.Second); got.chatId != tt.want || got.text != "test" {
+				t.Errorf("sent to chat %d with text %q, want chat %d", got.chatId, got.text, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompanyWithoutChatDropped(t *testing.T) {
+	f := newFakeBotAPI(t)
+	bot := newTestTelegram(t, f, config.Telegram{CompanyChats: map[int64]int64{7: 700}})
+
+	// сообщения отправляются по порядку: первое без чата отбрасывается, доходит только второе
+	for _, companyId := range []int64{8, 7} {
+		if err := bot.Send(Message{CompanyID: companyId, Header: strconv.FormatInt(companyId, 10)}); err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+	}
+
+	if got := f.wait(t, 5*time.Second); got.chatId != 700 || got.text != "7" {
+		t.Errorf("sent to chat %d with text %q, want chat 700 with text %q", got.chatId, got.text, "7")
+	}
+
+	if n := f.callCount(); n != 1 {
+		t.Errorf("sendMessage called %d times, want 1", n)
+	}
+}
+
+func TestSendRetries(t *testing.T) {
+	tests := []struct {
+		name       string
+		replies    []string
+		retryDelay time.Duration
+		wantCalls  int
+		delivered  bool
+	}{
+		{
+			// пауза берется из retry_after, а не из RetryDelay
+			name:       "429 waits retry_after",
+			replies:    []string{`{"ok":false,"error_code":429,"description":"Too Many Requests","parameters":{"retry_after":1}}`},
+			retryDelay: time.Hour,
+			wantCalls:  2,
+			delivered:  true,
+		},
+		{
+			name:       "5xx is retried",
+			replies:    []string{`{"ok":false,"error_code":502,"description":"Bad Gateway"}`},
+			retryDelay: 10 * time.Millisecond,
+			wantCalls:  2,
+			delivered:  true,
+		},
+		{
+			name: "retries are limited",
+			replies: []string{
+				`{"ok":false,"error_code":500,"description":"Internal Server Error"}`,
+				`{"ok":false,"error_code":500,"description":"Internal Server Error"}`,
+				`{"ok":false,"error_code":500,"description":"Internal Server Error"}`,
+			},
+			retryDelay: 10 * time.Millisecond,
+			wantCalls:  3,
+		},
+		{
+			name:       "4xx is not retried",
+			replies:    []string{`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`},
+			retryDelay: 10 * time.Millisecond,
+			wantCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeBotAPI(t)
+			f.replies = tt.replies
+
+			bot := newTestTelegram(t, f, config.Telegram{ChatId: "100", MaxRetries: 2, RetryDelay: tt.retryDelay})
+
+			if err := bot.send(tgbotapi.NewMessage(100, "test")); (err == nil) != tt.delivered {
+				t.Fatalf("send() error = %v, want delivered %v", err, tt.delivered)
+			}
+
+			if n := f.callCount(); n != tt.wantCalls {
+				t.Errorf("sendMessage called %d times, want %d", n, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"too many requests", &tgbotapi.Error{Code: http.StatusTooManyRequests}, true},
+		{"server error", &tgbotapi.Error{Code: http.StatusBadGateway}, true},
+		{"bad request", &tgbotapi.Error{Code: http.StatusBadRequest}, false},
+		{"forbidden", &tgbotapi.Error{Code: http.StatusForbidden}, false},
+		{"network error", &url.Error{Op: "Post", URL: "http://bot", Err: &net.OpError{Op: "dial", Err: errors.New("refused")}}, true},
+		{"other error", errors.New("unexpected end of JSON input"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryable(tt.err); got != tt.want {
+				t.Errorf("retryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSendQueueFull(t *testing.T) {
+	f := newFakeBotAPI(t)
+	f.hold, f.held = make(chan struct{}), make(chan struct{}, 1)
+
+	bot := newTestTelegram(t, f, config.Telegram{ChatId: "100"})
+
+	// первое сообщение занимает отправителя, пока Bot API не ответит
+	if err := bot.Send(Message{Header: "first"}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	select {
+	case <-f.held:
+	case <-time.After(5 * time.Second):
+		t.Fatal("sender did not start sending")
+	}
+
+	for i := 0; i < queueSize; i++ {
+		if err := bot.Send(Message{Header: "queued"}); err != nil {
+			t.Fatalf("Send %d: %v", i, err)
+		}
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- bot.Send(Message{Header: "dropped"}) }()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrQueueFull) {
+			t.Errorf("Send() error = %v, want %v", err, ErrQueueFull)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Send blocked on full queue")
+	}
+
+	// остальные запросы не ждут
+	f.mu.Lock()
+	hold := f.hold
+	f.hold = nil
+	f.mu.Unlock()
+	close(hold)
+
+	for i := 0; i <= queueSize; i++ {
+		if got := f.wait(t, 5*time.Second); got.text == "dropped" {
+			t.Fatal("dropped message was delivered")
+		}
+	}
+}
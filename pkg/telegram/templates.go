@@ -0,0 +1,60 @@
+package telegram
+
+import (
+	"strings"
+	"text/template"
+)
+
+// Типы сообщений, для каждого типа свой шаблон
+const (
+	TypeSystem     = ""            // системное сообщение: заголовок, реквизиты запроса и текст
+	TypeLowStock   = "low_stock"   // Data - domain.LowStockAlert
+	TypeDeadLetter = "dead_letter" // Data - DeadLetter
+)
+
+// DeadLetter данные сообщения о входящем сообщении, перенесенном в dead-letter subject
+type DeadLetter struct {
+	Subject    string // исходный subject
+	MessageID  string
+	Reason     string
+	Deliveries uint64
+}
+
+var templates = map[string]*template.Template{
+	TypeSystem: template.Must(template.New(TypeSystem).Parse(
+		`{{.Header}}` +
+			`{{with .Datetime}}{{"\n"}}Время: {{.}}{{end}}` +
+			`{{with .CompanyName}}{{"\n"}}Компания: {{.}}{{end}}` +
+			`{{with .Email}}{{"\n"}}Email: {{.}}{{end}}` +
+			`{{with .Ip}}{{"\n"}}IP: {{.}}{{end}}` +
+			`{{with .UserAgent}}{{"\n"}}User-Agent: {{.}}{{end}}` +
+			`{{with .Payload}}{{"\n\n"}}{{.}}{{end}}`)),
+
+	TypeLowStock: template.Must(template.New(TypeLowStock).Parse(
+		`Низкий остаток товара` +
+			`{{with .Datetime}}{{"\n"}}Время: {{.}}{{end}}` +
+			`{{with .Data}}{{"\n\n"}}{{.Name}} (товар {{.ItemID}}) на складе {{.WarehouseID}}: ` +
+			`остаток {{.Quantity}}, минимум {{.MinStockLevel}}{{end}}` +
+			`{{with .Payload}}{{"\n"}}{{.}}{{end}}`)),
+
+	TypeDeadLetter: template.Must(template.New(TypeDeadLetter).Parse(
+		`Сообщение не обработано и перенесено в dead letter` +
+			`{{with .Datetime}}{{"\n"}}Время: {{.}}{{end}}` +
+			`{{with .Data}}{{"\n\n"}}Subject: {{.Subject}}{{"\n"}}Id: {{.MessageID}}{{"\n"}}` +
+			`Попыток доставки: {{.Deliveries}}{{"\n"}}Причина: {{.Reason}}{{end}}`)),
+}
+
+// format собирает текст сообщения по шаблону его типа, неизвестный тип - как системное сообщение
+func format(message Message) (string, error) {
+	tmpl, ok := templates[message.Type]
+	if !ok {
+		tmpl = templates[TypeSystem]
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, message); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}